@@ -0,0 +1,781 @@
+package confluxmongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/homveloper/dukdakit/conflux"
+	"github.com/homveloper/dukdakit/diffit"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ============================================================================
+// MongoDB 기반 동시성 안전 레포지토리 구현
+// ============================================================================
+
+// 컴파일 타임 인터페이스 검사
+var _ conflux.FullRepository[any, conflux.MapFilter] = (*MongoRepository[any])(nil)
+
+// Collection 레포지토리가 사용하는 *mongo.Collection 메서드 집합
+type Collection interface {
+	FindOne(ctx context.Context, filter interface{}, opts ...*options.FindOneOptions) *mongo.SingleResult
+	Find(ctx context.Context, filter interface{}, opts ...*options.FindOptions) (*mongo.Cursor, error)
+	CountDocuments(ctx context.Context, filter interface{}, opts ...*options.CountOptions) (int64, error)
+	InsertOne(ctx context.Context, document interface{}, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error)
+	UpdateOne(ctx context.Context, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error)
+	DeleteOne(ctx context.Context, filter interface{}, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error)
+}
+
+// MongoRepository MongoDB 기반 낙관적 동시성 제어 레포지토리
+// MapFilter의 키는 BSON 필드 경로(예: "email", "profile.level")로 그대로 사용됩니다
+//
+// 업데이트는 문서 전체를 교체하지 않고, 저장된 문서와 새 엔터티의 BSON 표현을
+// diffit.Diff로 비교한 최소 $set/$unset 패치를 {_id, version} 조건으로 적용합니다
+// 다른 쓰기가 먼저 버전을 올렸다면 조건이 맞지 않아 버전 충돌로 처리됩니다
+//
+// 중복 검사와 삽입 사이의 경쟁을 막으려면 중복 검사 필드에 유니크 인덱스를 만들어야 합니다
+type MongoRepository[T any] struct {
+	collection     Collection
+	versionField   string // 버전 필드 이름
+	deletedAtField string // 소프트 삭제 시각 필드 이름
+	diffOptions    []diffit.Option
+	newEntityFn    func() T
+	clock          conflux.Clock
+}
+
+// MongoRepositoryConfig MongoDB 레포지토리 설정
+type MongoRepositoryConfig struct {
+	VersionField   string          // 버전 필드 이름 (기본값: "version")
+	DeletedAtField string          // 소프트 삭제 시각 필드 이름 (기본값: "deleted_at")
+	DiffOptions    []diffit.Option // 패치 계산 옵션 (기본 배열 전략은 ArrayReplace)
+	Clock          conflux.Clock   // 타임스탬프 기록용 시각 소스 (nil이면 시스템 시간)
+}
+
+// NewMongoRepository 새 MongoDB 레포지토리 생성
+func NewMongoRepository[T any](
+	collection Collection,
+	config *MongoRepositoryConfig,
+	newEntityFn func() T,
+) *MongoRepository[T] {
+	if config == nil {
+		config = &MongoRepositoryConfig{}
+	}
+
+	versionField := config.VersionField
+	if versionField == "" {
+		versionField = "version"
+	}
+	deletedAtField := config.DeletedAtField
+	if deletedAtField == "" {
+		deletedAtField = "deleted_at"
+	}
+
+	// 배열은 통째로 교체해야 arrayFilters 없이도 패치가 항상 적용 가능
+	diffOptions := append([]diffit.Option{diffit.WithArrayStrategy(diffit.ArrayReplace)}, config.DiffOptions...)
+
+	return &MongoRepository[T]{
+		collection:     collection,
+		versionField:   versionField,
+		deletedAtField: deletedAtField,
+		diffOptions:    diffOptions,
+		newEntityFn:    newEntityFn,
+		clock:          conflux.ClockOrSystem(config.Clock),
+	}
+}
+
+// ============================================================================
+// Repository 인터페이스 구현
+// ============================================================================
+
+// FindOneAndInsert 원자적 엔터티 생성
+// 유니크 인덱스 위반으로 삽입이 실패하면 이미 생성된 엔터티를 중복 결과로 반환합니다
+// 충돌한 문서가 소프트 삭제된 툼스톤이면 툼스톤을 새 엔터티로 대체합니다 (insertOverTombstone 참고)
+func (r *MongoRepository[T]) FindOneAndInsert(
+	ctx context.Context,
+	duplicateCheckFilter conflux.MapFilter,
+	createFunc conflux.CreateFunc[T],
+	options ...conflux.InsertOption,
+) (*conflux.InsertResult[T], error) {
+	// 필터 유효성 검증
+	if err := duplicateCheckFilter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	// 중복 검사
+	if existing, found, err := r.findDuplicate(ctx, duplicateCheckFilter); err != nil || found {
+		return existing, err
+	}
+
+	// 새 엔터티 생성
+	newEntity, err := createFunc.CreateFn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create entity: %w", err)
+	}
+
+	if err := r.insertEntity(ctx, newEntity); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			if existing, found, findErr := r.findDuplicate(ctx, duplicateCheckFilter); findErr != nil || found {
+				return existing, findErr
+			}
+			version, reviveErr := r.insertOverTombstone(ctx, duplicateCheckFilter, newEntity)
+			if reviveErr != nil {
+				return nil, reviveErr
+			}
+			if version > 0 {
+				return conflux.NewInsertResult(newEntity, version), nil
+			}
+		}
+		return nil, fmt.Errorf("failed to insert entity: %w", err)
+	}
+
+	return conflux.NewInsertResult(newEntity, 1), nil
+}
+
+// FindOneAndUpsert 원자적 엔터티 생성/업데이트
+// 조회와 쓰기 사이에 다른 쓰기가 끼어들면 UpsertConfig.MaxRetries만큼 다시 시도합니다
+func (r *MongoRepository[T]) FindOneAndUpsert(
+	ctx context.Context,
+	lookupFilter conflux.MapFilter,
+	upsertFunc conflux.UpsertFunc[T],
+	options ...conflux.UpsertOption,
+) (*conflux.UpsertResult[T], error) {
+	// 옵션 처리
+	config := conflux.NewUpsertConfig()
+	for _, opt := range options {
+		opt(config)
+	}
+
+	// 필터 유효성 검증
+	if err := lookupFilter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	attempts := max(config.MaxRetries, 0) + 1
+	for attempt := 0; attempt < attempts; attempt++ {
+		raw, err := r.findRaw(ctx, lookupFilter)
+		if err != nil && !errors.Is(err, conflux.ErrNotFound) {
+			return nil, err
+		}
+
+		if raw == nil {
+			// 새로 생성
+			newEntity, err := upsertFunc.CreateFn(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create entity: %w", err)
+			}
+
+			if err := r.insertEntity(ctx, newEntity); err != nil {
+				if !mongo.IsDuplicateKeyError(err) {
+					return nil, fmt.Errorf("failed to insert entity: %w", err)
+				}
+				// 툼스톤과 충돌했으면 대체, 아니면 동시에 생성된 것이므로 업데이트로 다시 시도
+				version, reviveErr := r.insertOverTombstone(ctx, lookupFilter, newEntity)
+				if reviveErr != nil {
+					return nil, reviveErr
+				}
+				if version > 0 {
+					return conflux.NewUpsertResult(newEntity, true, version), nil
+				}
+				continue
+			}
+			return conflux.NewUpsertResult(newEntity, true, 1), nil
+		}
+
+		// 기존 엔터티 업데이트
+		existing, version, err := r.decodeEntity(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		updatedEntity, err := upsertFunc.UpdateFn(ctx, existing)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update entity: %w", err)
+		}
+
+		applied, err := r.applyUpdate(ctx, raw, updatedEntity, version, nil)
+		if err != nil {
+			return nil, err
+		}
+		if applied {
+			return conflux.NewUpsertResult(updatedEntity, false, version+1), nil
+		}
+	}
+
+	return nil, fmt.Errorf("failed to upsert entity: concurrent modification after %d attempts", attempts)
+}
+
+// FindOneAndUpdate 원자적 엔터티 업데이트
+// OverwriteOnConflict이면 현재 버전을 기준으로 덮어쓰고, 그 외에는 버전 충돌 결과를 반환합니다
+func (r *MongoRepository[T]) FindOneAndUpdate(
+	ctx context.Context,
+	lookupFilter conflux.MapFilter,
+	expectedVersion int64,
+	updateFunc conflux.UpdateFunc[T],
+	options ...conflux.UpdateOption,
+) (*conflux.UpdateResult[T], error) {
+	// 옵션 처리
+	config := conflux.NewUpdateConfig()
+	for _, opt := range options {
+		opt(config)
+	}
+
+	// 필터 유효성 검증
+	if err := lookupFilter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	raw, err := r.findRaw(ctx, lookupFilter)
+	if errors.Is(err, conflux.ErrNotFound) {
+		return conflux.NewNotFoundResult[T](), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	existing, version, err := r.decodeEntity(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	// 버전 충돌 검사
+	if version != expectedVersion && config.OnConflict != conflux.OverwriteOnConflict {
+		return conflux.NewVersionConflictResult(existing, version), nil
+	}
+
+	updatedEntity, err := updateFunc.UpdateFn(ctx, existing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update entity: %w", err)
+	}
+
+	applied, err := r.applyUpdate(ctx, raw, updatedEntity, version, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !applied {
+		return r.updateConflict(ctx, lookupFilter)
+	}
+
+	return conflux.NewUpdateResult(updatedEntity, version+1), nil
+}
+
+// FindOneAndDelete 원자적 엔터티 삭제
+// {_id, version} 조건으로 삭제하므로 확인과 삭제 사이에 다른 쓰기가 끼어들면 버전 충돌로 처리됩니다
+// 소프트 삭제 시 deleted_at이 기록되고 버전이 증가하며, 툼스톤은 이후 조회에서 제외됩니다
+func (r *MongoRepository[T]) FindOneAndDelete(
+	ctx context.Context,
+	lookupFilter conflux.MapFilter,
+	expectedVersion int64,
+	options ...conflux.DeleteOption,
+) (*conflux.DeleteResult[T], error) {
+	// 옵션 처리
+	config := conflux.NewDeleteConfig()
+	for _, opt := range options {
+		opt(config)
+	}
+
+	// 필터 유효성 검증
+	if err := lookupFilter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	raw, err := r.findRaw(ctx, lookupFilter)
+	if errors.Is(err, conflux.ErrNotFound) {
+		return conflux.NewDeleteNotFoundResult[T](), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	existing, version, err := r.decodeEntity(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	// 버전 충돌 검사
+	if version != expectedVersion {
+		return conflux.NewDeleteConflictResult(existing, version), nil
+	}
+
+	now := r.clock.Now()
+
+	if !config.Soft {
+		result, err := r.collection.DeleteOne(ctx, r.versionedFilter(raw, version))
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete entity: %w", err)
+		}
+		if result.DeletedCount == 0 {
+			return r.deleteConflict(ctx, lookupFilter)
+		}
+		return conflux.NewDeleteResult(existing, version, now, false), nil
+	}
+
+	// 툼스톤: deleted-at 기록 및 버전 증가
+	if config.TombstoneUpdate != nil {
+		config.TombstoneUpdate(existing)
+	}
+	if deletable, ok := any(existing).(conflux.SoftDeletable); ok {
+		deletedAt := now
+		deletable.SetDeletedAt(&deletedAt)
+	}
+
+	applied, err := r.applyUpdate(ctx, raw, existing, version, &now)
+	if err != nil {
+		return nil, err
+	}
+	if !applied {
+		return r.deleteConflict(ctx, lookupFilter)
+	}
+
+	return conflux.NewDeleteResult(existing, version+1, now, true), nil
+}
+
+// NewEntity 새 엔터티 인스턴스 생성
+func (r *MongoRepository[T]) NewEntity() T {
+	if r.newEntityFn != nil {
+		return r.newEntityFn()
+	}
+
+	var entity T
+	return entity
+}
+
+// ============================================================================
+// ReadRepository 인터페이스 구현
+// ============================================================================
+
+// FindOne 필터 조건으로 엔터티 조회
+func (r *MongoRepository[T]) FindOne(ctx context.Context, filter conflux.MapFilter) (T, error) {
+	var empty T
+
+	if err := filter.Validate(); err != nil {
+		return empty, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	raw, err := r.findRaw(ctx, filter)
+	if err != nil {
+		return empty, err
+	}
+
+	entity, _, err := r.decodeEntity(raw)
+	return entity, err
+}
+
+// Exists 엔터티 존재 여부 확인
+func (r *MongoRepository[T]) Exists(ctx context.Context, filter conflux.MapFilter) (bool, error) {
+	if err := filter.Validate(); err != nil {
+		return false, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	count, err := r.collection.CountDocuments(ctx, r.liveFilter(filter), options.Count().SetLimit(1))
+	if err != nil {
+		return false, fmt.Errorf("failed to check existence: %w", err)
+	}
+	return count > 0, nil
+}
+
+// GetVersion 엔터티의 현재 버전 조회
+func (r *MongoRepository[T]) GetVersion(ctx context.Context, filter conflux.MapFilter) (int64, error) {
+	if err := filter.Validate(); err != nil {
+		return 0, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	raw, err := r.findRaw(ctx, filter, options.FindOne().SetProjection(bson.M{r.versionField: 1}))
+	if err != nil {
+		return 0, err
+	}
+	return r.documentVersion(raw), nil
+}
+
+// ============================================================================
+// BatchRepository 인터페이스 구현
+// ============================================================================
+
+// FindMany 필터 조건으로 여러 엔터티들 조회
+func (r *MongoRepository[T]) FindMany(ctx context.Context, filter conflux.MapFilter, limit int) ([]T, error) {
+	opts := options.Find()
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	return r.find(ctx, filter, opts)
+}
+
+// InsertMany 여러 엔터티를 배치 삽입
+// 각 항목은 FindOneAndInsert와 같은 규칙으로 처리되며, 중복은 결과의 Duplicate로 구분됩니다
+func (r *MongoRepository[T]) InsertMany(
+	ctx context.Context,
+	duplicateCheckFilters []conflux.MapFilter,
+	createFuncs []conflux.CreateFunc[T],
+) ([]*conflux.InsertResult[T], error) {
+	if len(duplicateCheckFilters) != len(createFuncs) {
+		return nil, fmt.Errorf("filters and create functions length mismatch: %d != %d", len(duplicateCheckFilters), len(createFuncs))
+	}
+
+	results := make([]*conflux.InsertResult[T], 0, len(createFuncs))
+	for i, createFunc := range createFuncs {
+		result, err := r.FindOneAndInsert(ctx, duplicateCheckFilters[i], createFunc)
+		if err != nil {
+			return results, fmt.Errorf("failed to insert entity %d: %w", i, err)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// ============================================================================
+// QueryRepository 인터페이스 구현
+// ============================================================================
+
+// FindByFilter 필터 조건으로 엔터티들을 페이지 단위로 조회
+func (r *MongoRepository[T]) FindByFilter(ctx context.Context, filter conflux.MapFilter, limit int, offset int) ([]T, error) {
+	opts := options.Find()
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	if offset > 0 {
+		opts.SetSkip(int64(offset))
+	}
+	return r.find(ctx, filter, opts)
+}
+
+// CountByFilter 필터 조건에 맞는 엔터티 개수 반환
+func (r *MongoRepository[T]) CountByFilter(ctx context.Context, filter conflux.MapFilter) (int64, error) {
+	count, err := r.collection.CountDocuments(ctx, r.liveFilter(filter))
+	if err != nil {
+		return 0, fmt.Errorf("failed to count entities: %w", err)
+	}
+	return count, nil
+}
+
+// FindWithSort 정렬 필드(BSON 경로) 기준으로 엔터티들 조회
+func (r *MongoRepository[T]) FindWithSort(ctx context.Context, filter conflux.MapFilter, sortBy string, ascending bool, limit int) ([]T, error) {
+	direction := -1
+	if ascending {
+		direction = 1
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: sortBy, Value: direction}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	return r.find(ctx, filter, opts)
+}
+
+// ============================================================================
+// MongoDB 전용 헬퍼 메서드들
+// ============================================================================
+
+// liveFilter 소프트 삭제된 문서를 제외하는 필터
+func (r *MongoRepository[T]) liveFilter(filter conflux.MapFilter) bson.M {
+	conditions := bson.A{bson.M{r.deletedAtField: nil}}
+	if len(filter) > 0 {
+		conditions = append(conditions, bson.M(filter))
+	}
+	return bson.M{"$and": conditions}
+}
+
+// versionedFilter 문서 ID와 버전이 모두 일치해야 하는 조건부 쓰기 필터
+func (r *MongoRepository[T]) versionedFilter(raw bson.Raw, version int64) bson.D {
+	return bson.D{
+		{Key: "_id", Value: raw.Lookup("_id")},
+		{Key: r.versionField, Value: version},
+	}
+}
+
+// findRaw 필터에 맞는 살아 있는 문서 하나 조회
+func (r *MongoRepository[T]) findRaw(ctx context.Context, filter conflux.MapFilter, opts ...*options.FindOneOptions) (bson.Raw, error) {
+	raw, err := r.collection.FindOne(ctx, r.liveFilter(filter), opts...).Raw()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, conflux.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find entity: %w", err)
+	}
+	return raw, nil
+}
+
+// find 필터에 맞는 살아 있는 문서들 조회
+func (r *MongoRepository[T]) find(ctx context.Context, filter conflux.MapFilter, opts *options.FindOptions) ([]T, error) {
+	cursor, err := r.collection.Find(ctx, r.liveFilter(filter), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find entities: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var entities []T
+	for cursor.Next(ctx) {
+		entity, _, err := r.decodeEntity(cursor.Current)
+		if err != nil {
+			return nil, err
+		}
+		entities = append(entities, entity)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate entities: %w", err)
+	}
+	return entities, nil
+}
+
+// findDuplicate 중복 검사 필터에 맞는 엔터티가 있으면 중복 결과 반환
+func (r *MongoRepository[T]) findDuplicate(ctx context.Context, filter conflux.MapFilter) (*conflux.InsertResult[T], bool, error) {
+	raw, err := r.findRaw(ctx, filter)
+	if errors.Is(err, conflux.ErrNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to check duplicate: %w", err)
+	}
+
+	existing, version, err := r.decodeEntity(raw)
+	if err != nil {
+		return nil, false, err
+	}
+	return conflux.NewDuplicateInsertResult(existing, version), true, nil
+}
+
+// insertEntity 버전 1과 생성 시각을 기록하고 문서 삽입
+func (r *MongoRepository[T]) insertEntity(ctx context.Context, entity T) error {
+	now := r.clock.Now()
+	setEntityVersion(entity, 1)
+	if timestamped, ok := any(entity).(conflux.Timestamped); ok {
+		timestamped.SetCreatedAt(now)
+		timestamped.SetUpdatedAt(now)
+	}
+
+	doc, err := r.toDocument(entity, 1, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.collection.InsertOne(ctx, doc)
+	return err
+}
+
+// insertOverTombstone 유니크 인덱스와 충돌한 삽입이 소프트 삭제된 문서 때문이면 툼스톤을 새 엔터티로 대체
+// 툼스톤은 조회와 중복 검사에서 제외되지만 인덱스에는 남아 있으므로, 그대로 두면 같은 키로 다시 만들 수 없습니다
+//   - 같은 _id의 툼스톤은 {_id, version} 조건의 UpdateOne으로 되살립니다 (deleted_at 제거, 버전은 툼스톤 버전 + 1)
+//   - 다른 _id의 툼스톤은 {_id, version} 조건으로 지운 뒤 다시 삽입합니다 (버전 1)
+//
+// 반환값: 새 엔터티의 버전 (대체할 툼스톤이 없거나 다른 쓰기와의 경쟁에서 지면 0)
+func (r *MongoRepository[T]) insertOverTombstone(ctx context.Context, filter conflux.MapFilter, entity T) (int64, error) {
+	doc, err := r.toDocument(entity, 1, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	conditions := bson.A{bson.M(filter)}
+	id, hasID := doc["_id"]
+	if hasID {
+		conditions = append(conditions, bson.M{"_id": id})
+	}
+	tombstoneFilter := bson.M{"$and": bson.A{
+		bson.M{r.deletedAtField: bson.M{"$ne": nil}},
+		bson.M{"$or": conditions},
+	}}
+
+	raw, err := r.collection.FindOne(ctx, tombstoneFilter).Raw()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to find tombstone: %w", err)
+	}
+	version := r.documentVersion(raw)
+
+	var tombstone bson.M
+	if err := bson.Unmarshal(raw, &tombstone); err != nil {
+		return 0, fmt.Errorf("failed to decode tombstone: %w", err)
+	}
+
+	if hasID && !reflect.DeepEqual(tombstone["_id"], id) {
+		result, err := r.collection.DeleteOne(ctx, r.versionedFilter(raw, version))
+		if err != nil {
+			return 0, fmt.Errorf("failed to purge tombstone: %w", err)
+		}
+		if result.DeletedCount == 0 {
+			return 0, nil
+		}
+		if err := r.insertEntity(ctx, entity); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return 0, nil
+			}
+			return 0, fmt.Errorf("failed to insert entity: %w", err)
+		}
+		return 1, nil
+	}
+
+	if timestamped, ok := any(entity).(conflux.Timestamped); ok {
+		timestamped.SetCreatedAt(r.clock.Now())
+	}
+	applied, err := r.applyUpdate(ctx, raw, entity, version, nil)
+	if err != nil || !applied {
+		return 0, err
+	}
+	return version + 1, nil
+}
+
+// applyUpdate 저장된 문서와 새 엔터티의 차이를 {_id, version} 조건으로 적용
+// deletedAt이 nil이 아니면 툼스톤으로 기록합니다
+// 반환값: 조건이 맞아 적용되었는지 여부 (false면 다른 쓰기가 먼저 버전을 올림)
+func (r *MongoRepository[T]) applyUpdate(ctx context.Context, raw bson.Raw, entity T, version int64, deletedAt *time.Time) (bool, error) {
+	newVersion := version + 1
+	setEntityVersion(entity, newVersion)
+	if timestamped, ok := any(entity).(conflux.Timestamped); ok {
+		timestamped.SetUpdatedAt(r.clock.Now())
+	}
+
+	newDoc, err := r.toDocument(entity, newVersion, deletedAt)
+	if err != nil {
+		return false, err
+	}
+
+	var oldDoc bson.M
+	if err := bson.Unmarshal(raw, &oldDoc); err != nil {
+		return false, fmt.Errorf("failed to decode stored document: %w", err)
+	}
+
+	update, updateOpts := r.buildPatch(oldDoc, newDoc)
+	result, err := r.collection.UpdateOne(ctx, r.versionedFilter(raw, version), update, updateOpts)
+	if err != nil {
+		return false, fmt.Errorf("failed to update entity: %w", err)
+	}
+	return result.MatchedCount > 0, nil
+}
+
+// buildPatch diffit으로 최소 $set/$unset 패치 계산
+// 필드 타입이 바뀌어 비교할 수 없으면 최상위 필드 단위 패치로 대신합니다
+func (r *MongoRepository[T]) buildPatch(oldDoc, newDoc bson.M) (any, *options.UpdateOptions) {
+	// _id는 변경할 수 없고 새 엔터티에는 없을 수 있으므로 비교에서 제외
+	delete(oldDoc, "_id")
+	delete(newDoc, "_id")
+
+	patch, err := diffit.Diff(oldDoc, newDoc, r.diffOptions...)
+	if err != nil || patch.IsEmpty() {
+		return topLevelPatch(oldDoc, newDoc), options.Update()
+	}
+
+	updateOpts := options.Update()
+	if patch.HasArrayFilters() {
+		updateOpts.SetArrayFilters(options.ArrayFilters{Filters: patch.ArrayFilters()})
+	}
+	return patch.Operations(), updateOpts
+}
+
+// toDocument 엔터티를 저장된 문서와 같은 형태의 bson.M으로 변환
+// BSON으로 직렬화한 뒤 다시 읽어 저장소에서 읽은 문서와 값 타입을 맞춥니다
+func (r *MongoRepository[T]) toDocument(entity T, version int64, deletedAt *time.Time) (bson.M, error) {
+	data, err := bson.Marshal(entity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode entity: %w", err)
+	}
+
+	var doc bson.M
+	if err := bson.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to encode entity: %w", err)
+	}
+
+	doc[r.versionField] = version
+	if deletedAt != nil {
+		doc[r.deletedAtField] = primitive.NewDateTimeFromTime(*deletedAt)
+	} else {
+		delete(doc, r.deletedAtField)
+	}
+	return doc, nil
+}
+
+// decodeEntity 문서를 엔터티로 변환하고 저장된 버전을 함께 반환
+func (r *MongoRepository[T]) decodeEntity(raw bson.Raw) (T, int64, error) {
+	entity := r.NewEntity()
+
+	var err error
+	if value := reflect.ValueOf(entity); value.Kind() == reflect.Ptr && !value.IsNil() {
+		err = bson.Unmarshal(raw, entity)
+	} else {
+		err = bson.Unmarshal(raw, &entity)
+	}
+	if err != nil {
+		var empty T
+		return empty, 0, fmt.Errorf("failed to decode entity: %w", err)
+	}
+
+	version := r.documentVersion(raw)
+	setEntityVersion(entity, version)
+	return entity, version, nil
+}
+
+// documentVersion 문서에 저장된 버전 (없으면 0)
+func (r *MongoRepository[T]) documentVersion(raw bson.Raw) int64 {
+	value, err := raw.LookupErr(r.versionField)
+	if err != nil {
+		return 0
+	}
+	version, _ := value.AsInt64OK()
+	return version
+}
+
+// updateConflict 조건부 업데이트가 적용되지 않은 뒤 현재 상태로 결과 생성
+func (r *MongoRepository[T]) updateConflict(ctx context.Context, filter conflux.MapFilter) (*conflux.UpdateResult[T], error) {
+	raw, err := r.findRaw(ctx, filter)
+	if errors.Is(err, conflux.ErrNotFound) {
+		return conflux.NewNotFoundResult[T](), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	current, version, err := r.decodeEntity(raw)
+	if err != nil {
+		return nil, err
+	}
+	return conflux.NewVersionConflictResult(current, version), nil
+}
+
+// deleteConflict 조건부 삭제가 적용되지 않은 뒤 현재 상태로 결과 생성
+func (r *MongoRepository[T]) deleteConflict(ctx context.Context, filter conflux.MapFilter) (*conflux.DeleteResult[T], error) {
+	raw, err := r.findRaw(ctx, filter)
+	if errors.Is(err, conflux.ErrNotFound) {
+		return conflux.NewDeleteNotFoundResult[T](), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	current, version, err := r.decodeEntity(raw)
+	if err != nil {
+		return nil, err
+	}
+	return conflux.NewDeleteConflictResult(current, version), nil
+}
+
+// topLevelPatch 최상위 필드 단위 $set/$unset 패치
+func topLevelPatch(oldDoc, newDoc bson.M) bson.M {
+	set := bson.M{}
+	for key, value := range newDoc {
+		set[key] = value
+	}
+
+	unset := bson.M{}
+	for key := range oldDoc {
+		if _, ok := newDoc[key]; !ok {
+			unset[key] = ""
+		}
+	}
+
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+	return update
+}
+
+// setEntityVersion Versioned 엔터티에 버전 기록
+func setEntityVersion[T any](entity T, version int64) {
+	if versioned, ok := any(entity).(conflux.Versioned); ok {
+		versioned.SetVersion(version)
+	}
+}
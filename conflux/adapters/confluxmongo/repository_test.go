@@ -0,0 +1,569 @@
+package confluxmongo
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/homveloper/dukdakit/conflux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ============================================================================
+// In-memory collection double
+// ============================================================================
+
+// memoryCollection evaluates the subset of the query language the repository emits
+type memoryCollection struct {
+	docs        []bson.M
+	unique      []string // Fields with a unique index besides _id
+	updates     []bson.M // Update documents received by UpdateOne, for assertions
+	beforeWrite func()   // Runs once before the next UpdateOne/DeleteOne to simulate a concurrent writer
+}
+
+func (c *memoryCollection) FindOne(ctx context.Context, filter interface{}, opts ...*options.FindOneOptions) *mongo.SingleResult {
+	for _, doc := range c.docs {
+		if matches(doc, normalize(filter)) {
+			return mongo.NewSingleResultFromDocument(doc, nil, nil)
+		}
+	}
+	return mongo.NewSingleResultFromDocument(bson.D{}, mongo.ErrNoDocuments, nil)
+}
+
+func (c *memoryCollection) Find(ctx context.Context, filter interface{}, opts ...*options.FindOptions) (*mongo.Cursor, error) {
+	var matched []bson.M
+	for _, doc := range c.docs {
+		if matches(doc, normalize(filter)) {
+			matched = append(matched, doc)
+		}
+	}
+
+	opt := options.MergeFindOptions(opts...)
+	if opt.Sort != nil {
+		order := opt.Sort.(bson.D)
+		sort.SliceStable(matched, func(i, j int) bool {
+			cmp := compareValues(lookup(matched[i], order[0].Key), lookup(matched[j], order[0].Key))
+			if order[0].Value.(int) < 0 {
+				return cmp > 0
+			}
+			return cmp < 0
+		})
+	}
+	if opt.Skip != nil {
+		skip := min(int(*opt.Skip), len(matched))
+		matched = matched[skip:]
+	}
+	if opt.Limit != nil && int(*opt.Limit) < len(matched) {
+		matched = matched[:*opt.Limit]
+	}
+
+	docs := make([]interface{}, len(matched))
+	for i, doc := range matched {
+		docs[i] = doc
+	}
+	return mongo.NewCursorFromDocuments(docs, nil, nil)
+}
+
+func (c *memoryCollection) CountDocuments(ctx context.Context, filter interface{}, opts ...*options.CountOptions) (int64, error) {
+	var count int64
+	for _, doc := range c.docs {
+		if matches(doc, normalize(filter)) {
+			count++
+		}
+	}
+	if opt := options.MergeCountOptions(opts...); opt.Limit != nil && count > *opt.Limit {
+		count = *opt.Limit
+	}
+	return count, nil
+}
+
+func (c *memoryCollection) InsertOne(ctx context.Context, document interface{}, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	doc := normalize(document)
+	if _, ok := doc["_id"]; !ok {
+		doc["_id"] = primitive.NewObjectID()
+	}
+
+	for _, existing := range c.docs {
+		for _, field := range append([]string{"_id"}, c.unique...) {
+			if compareValues(lookup(existing, field), lookup(doc, field)) == 0 {
+				return nil, mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 11000, Message: "duplicate key"}}}
+			}
+		}
+	}
+
+	c.docs = append(c.docs, doc)
+	return &mongo.InsertOneResult{InsertedID: doc["_id"]}, nil
+}
+
+func (c *memoryCollection) UpdateOne(ctx context.Context, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	c.runBeforeWrite()
+
+	ops := normalize(update)
+	c.updates = append(c.updates, ops)
+
+	for _, doc := range c.docs {
+		if !matches(doc, normalize(filter)) {
+			continue
+		}
+		for path, value := range asDocument(ops["$set"]) {
+			setPath(doc, path, value)
+		}
+		for path := range asDocument(ops["$unset"]) {
+			unsetPath(doc, path)
+		}
+		return &mongo.UpdateResult{MatchedCount: 1, ModifiedCount: 1}, nil
+	}
+	return &mongo.UpdateResult{}, nil
+}
+
+func (c *memoryCollection) DeleteOne(ctx context.Context, filter interface{}, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error) {
+	c.runBeforeWrite()
+
+	for i, doc := range c.docs {
+		if matches(doc, normalize(filter)) {
+			c.docs = append(c.docs[:i], c.docs[i+1:]...)
+			return &mongo.DeleteResult{DeletedCount: 1}, nil
+		}
+	}
+	return &mongo.DeleteResult{}, nil
+}
+
+func (c *memoryCollection) runBeforeWrite() {
+	if hook := c.beforeWrite; hook != nil {
+		c.beforeWrite = nil
+		hook()
+	}
+}
+
+// normalize round-trips a value through BSON so values have the same types as stored documents
+func normalize(value interface{}) bson.M {
+	data, err := bson.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+	var doc bson.M
+	if err := bson.Unmarshal(data, &doc); err != nil {
+		panic(err)
+	}
+	return doc
+}
+
+func asDocument(value interface{}) bson.M {
+	doc, _ := value.(bson.M)
+	return doc
+}
+
+func matches(doc bson.M, filter bson.M) bool {
+	for key, cond := range filter {
+		if key == "$and" {
+			for _, sub := range cond.(bson.A) {
+				if !matches(doc, sub.(bson.M)) {
+					return false
+				}
+			}
+			continue
+		}
+		if key == "$or" {
+			matched := false
+			for _, sub := range cond.(bson.A) {
+				matched = matched || matches(doc, sub.(bson.M))
+			}
+			if !matched {
+				return false
+			}
+			continue
+		}
+		if ops, ok := cond.(bson.M); ok {
+			if ne, ok := ops["$ne"]; ok {
+				if compareValues(lookup(doc, key), ne) == 0 {
+					return false
+				}
+				continue
+			}
+		}
+		if compareValues(lookup(doc, key), cond) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func lookup(doc bson.M, path string) interface{} {
+	var current interface{} = doc
+	for _, part := range strings.Split(path, ".") {
+		m, ok := current.(bson.M)
+		if !ok {
+			return nil
+		}
+		current = m[part]
+	}
+	return current
+}
+
+func setPath(doc bson.M, path string, value interface{}) {
+	parts := strings.Split(path, ".")
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := doc[part].(bson.M)
+		if !ok {
+			next = bson.M{}
+			doc[part] = next
+		}
+		doc = next
+	}
+	doc[parts[len(parts)-1]] = value
+}
+
+func unsetPath(doc bson.M, path string) {
+	parts := strings.Split(path, ".")
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := doc[part].(bson.M)
+		if !ok {
+			return
+		}
+		doc = next
+	}
+	delete(doc, parts[len(parts)-1])
+}
+
+func compareValues(a, b interface{}) int {
+	if a == nil || b == nil {
+		if a == nil && b == nil {
+			return 0
+		}
+		return 1
+	}
+
+	if av, ok := toFloat(a); ok {
+		bv, ok := toFloat(b)
+		if !ok {
+			return 1
+		}
+		switch {
+		case av < bv:
+			return -1
+		case av > bv:
+			return 1
+		}
+		return 0
+	}
+
+	if as, ok := a.(string); ok {
+		bs, ok := b.(string)
+		if !ok {
+			return 1
+		}
+		return strings.Compare(as, bs)
+	}
+
+	if reflect.DeepEqual(a, b) {
+		return 0
+	}
+	return 1
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// ============================================================================
+// Fixtures
+// ============================================================================
+
+type Player struct {
+	conflux.BaseEntity `bson:",inline"`
+	ID                 string   `bson:"player_id"`
+	Name               string   `bson:"name"`
+	Level              int      `bson:"level"`
+	Guild              string   `bson:"guild,omitempty"`
+	Items              []string `bson:"items"`
+}
+
+type fixedClock struct{ now time.Time }
+
+func (c fixedClock) Now() time.Time { return c.now }
+
+var testNow = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newRepository(collection *memoryCollection) *MongoRepository[*Player] {
+	return NewMongoRepository[*Player](collection, &MongoRepositoryConfig{Clock: fixedClock{now: testNow}},
+		func() *Player { return &Player{} })
+}
+
+func byPlayer(id string) conflux.MapFilter {
+	return conflux.NewMapFilter().And("player_id", id)
+}
+
+func createPlayer(id string, level int) conflux.CreateFunc[*Player] {
+	return conflux.NewCreateFunc(func(ctx context.Context) (*Player, error) {
+		return &Player{ID: id, Name: "player-" + id, Level: level, Guild: "red", Items: []string{"sword"}}, nil
+	})
+}
+
+func levelUp(ctx context.Context, existing *Player) (*Player, error) {
+	existing.Level++
+	return existing, nil
+}
+
+func seedPlayers(t *testing.T, repo *MongoRepository[*Player], levels ...int) {
+	for i, level := range levels {
+		_, err := repo.FindOneAndInsert(context.Background(), byPlayer(fmt.Sprintf("p%d", i+1)), createPlayer(fmt.Sprintf("p%d", i+1), level))
+		require.NoError(t, err)
+	}
+}
+
+// ============================================================================
+// Repository tests
+// ============================================================================
+
+func TestMongoRepository_InsertAndDuplicate(t *testing.T) {
+	collection := &memoryCollection{}
+	repo := newRepository(collection)
+	ctx := context.Background()
+
+	result, err := repo.FindOneAndInsert(ctx, byPlayer("p1"), createPlayer("p1", 1))
+	require.NoError(t, err)
+	assert.True(t, result.IsSuccess())
+	assert.Equal(t, int64(1), result.Version)
+
+	require.Len(t, collection.docs, 1)
+	assert.Equal(t, int64(1), collection.docs[0]["version"])
+	assert.Equal(t, "p1", collection.docs[0]["player_id"])
+
+	duplicate, err := repo.FindOneAndInsert(ctx, byPlayer("p1"), createPlayer("p1", 5))
+	require.NoError(t, err)
+	assert.True(t, duplicate.IsDuplicate())
+	assert.Equal(t, 1, duplicate.Entity.Level)
+}
+
+func TestMongoRepository_InsertRaceReturnsDuplicate(t *testing.T) {
+	// 유니크 인덱스가 중복 검사 이후에 끼어든 삽입을 막음
+	collection := &memoryCollection{unique: []string{"player_id"}}
+	repo := newRepository(collection)
+	ctx := context.Background()
+
+	racing := conflux.NewCreateFunc(func(ctx context.Context) (*Player, error) {
+		_, err := repo.FindOneAndInsert(ctx, byPlayer("p1"), createPlayer("p1", 9))
+		require.NoError(t, err)
+		return &Player{ID: "p1", Level: 1}, nil
+	})
+
+	result, err := repo.FindOneAndInsert(ctx, byPlayer("p1"), racing)
+	require.NoError(t, err)
+	assert.True(t, result.IsDuplicate())
+	assert.Equal(t, 9, result.Entity.Level)
+	assert.Len(t, collection.docs, 1)
+}
+
+func TestMongoRepository_UpdateAppliesMinimalPatch(t *testing.T) {
+	collection := &memoryCollection{}
+	repo := newRepository(collection)
+	ctx := context.Background()
+	seedPlayers(t, repo, 1)
+
+	result, err := repo.FindOneAndUpdate(ctx, byPlayer("p1"), 1, conflux.NewUpdateFunc(func(ctx context.Context, existing *Player) (*Player, error) {
+		existing.Level = 2
+		existing.Guild = ""
+		return existing, nil
+	}))
+	require.NoError(t, err)
+	require.True(t, result.IsSuccess())
+	assert.Equal(t, int64(2), result.Version)
+
+	// 바뀐 필드만 $set, omitempty로 사라진 필드는 $unset
+	require.Len(t, collection.updates, 1)
+	set := asDocument(collection.updates[0]["$set"])
+	assert.ElementsMatch(t, []string{"level", "version"}, keys(set))
+	assert.Equal(t, []string{"guild"}, keys(asDocument(collection.updates[0]["$unset"])))
+
+	stored, err := repo.FindOne(ctx, byPlayer("p1"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, stored.Level)
+	assert.Equal(t, "", stored.Guild)
+	assert.Equal(t, []string{"sword"}, stored.Items)
+	assert.Equal(t, int64(2), stored.Version)
+}
+
+func TestMongoRepository_UpdateVersionConflict(t *testing.T) {
+	collection := &memoryCollection{}
+	repo := newRepository(collection)
+	ctx := context.Background()
+	seedPlayers(t, repo, 1)
+
+	// 기대 버전이 다르면 쓰지 않음
+	stale, err := repo.FindOneAndUpdate(ctx, byPlayer("p1"), 7, conflux.NewUpdateFunc(levelUp))
+	require.NoError(t, err)
+	assert.True(t, stale.HasVersionConflict())
+	assert.Equal(t, int64(1), stale.Version)
+
+	// 읽은 뒤 다른 쓰기가 먼저 버전을 올리면 {_id, version} 조건이 맞지 않음
+	collection.beforeWrite = func() {
+		_, err := repo.FindOneAndUpdate(ctx, byPlayer("p1"), 1, conflux.NewUpdateFunc(levelUp))
+		require.NoError(t, err)
+	}
+	raced, err := repo.FindOneAndUpdate(ctx, byPlayer("p1"), 1, conflux.NewUpdateFunc(levelUp))
+	require.NoError(t, err)
+	assert.True(t, raced.HasVersionConflict())
+	assert.Equal(t, int64(2), raced.Version)
+	assert.Equal(t, 2, raced.Entity.Level)
+
+	missing, err := repo.FindOneAndUpdate(ctx, byPlayer("ghost"), 1, conflux.NewUpdateFunc(levelUp))
+	require.NoError(t, err)
+	assert.True(t, missing.IsNotFound())
+}
+
+func TestMongoRepository_UpsertRetriesOnRace(t *testing.T) {
+	collection := &memoryCollection{}
+	repo := newRepository(collection)
+	ctx := context.Background()
+	upsert := conflux.NewUpsertFunc(createPlayer("p1", 1).CreateFn, levelUp)
+
+	created, err := repo.FindOneAndUpsert(ctx, byPlayer("p1"), upsert)
+	require.NoError(t, err)
+	assert.True(t, created.Created)
+
+	collection.beforeWrite = func() {
+		_, err := repo.FindOneAndUpdate(ctx, byPlayer("p1"), 1, conflux.NewUpdateFunc(levelUp))
+		require.NoError(t, err)
+	}
+	updated, err := repo.FindOneAndUpsert(ctx, byPlayer("p1"), upsert)
+	require.NoError(t, err)
+	assert.False(t, updated.Created)
+	assert.Equal(t, int64(3), updated.Version)
+	assert.Equal(t, 3, updated.Entity.Level)
+}
+
+func TestMongoRepository_Delete(t *testing.T) {
+	collection := &memoryCollection{}
+	repo := newRepository(collection)
+	ctx := context.Background()
+	seedPlayers(t, repo, 1, 2)
+
+	conflict, err := repo.FindOneAndDelete(ctx, byPlayer("p1"), 3)
+	require.NoError(t, err)
+	assert.True(t, conflict.HasVersionConflict())
+
+	// 소프트 삭제: 툼스톤은 남지만 조회에서 제외됨
+	soft, err := repo.FindOneAndDelete(ctx, byPlayer("p1"), 1, conflux.WithSoftDelete())
+	require.NoError(t, err)
+	assert.True(t, soft.IsSoftDeleted())
+	assert.Equal(t, int64(2), soft.Version)
+	assert.Len(t, collection.docs, 2)
+	assert.Equal(t, primitive.NewDateTimeFromTime(testNow), collection.docs[0]["deleted_at"])
+
+	_, err = repo.FindOne(ctx, byPlayer("p1"))
+	assert.ErrorIs(t, err, conflux.ErrNotFound)
+	count, err := repo.CountByFilter(ctx, conflux.NewMapFilter())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// 하드 삭제
+	hard, err := repo.FindOneAndDelete(ctx, byPlayer("p2"), 1)
+	require.NoError(t, err)
+	assert.True(t, hard.IsSuccess())
+	assert.Len(t, collection.docs, 1)
+
+	missing, err := repo.FindOneAndDelete(ctx, byPlayer("p2"), 1)
+	require.NoError(t, err)
+	assert.True(t, missing.IsNotFound())
+}
+
+func TestMongoRepository_InsertRevivesTombstone(t *testing.T) {
+	// 소프트 삭제된 문서도 유니크 인덱스에는 남아 있으므로 같은 키로 삽입하면 툼스톤을 되살림
+	collection := &memoryCollection{unique: []string{"player_id"}}
+	repo := newRepository(collection)
+	ctx := context.Background()
+	seedPlayers(t, repo, 1)
+
+	_, err := repo.FindOneAndDelete(ctx, byPlayer("p1"), 1, conflux.WithSoftDelete())
+	require.NoError(t, err)
+
+	revived, err := repo.FindOneAndInsert(ctx, byPlayer("p1"), createPlayer("p1", 7))
+	require.NoError(t, err)
+	require.True(t, revived.IsSuccess())
+	assert.Equal(t, int64(3), revived.Version)
+
+	require.Len(t, collection.docs, 1)
+	assert.NotContains(t, collection.docs[0], "deleted_at")
+	stored, err := repo.FindOne(ctx, byPlayer("p1"))
+	require.NoError(t, err)
+	assert.Equal(t, 7, stored.Level)
+	assert.Equal(t, int64(3), stored.Version)
+
+	// 업서트도 툼스톤을 생성으로 대체
+	_, err = repo.FindOneAndDelete(ctx, byPlayer("p1"), 3, conflux.WithSoftDelete())
+	require.NoError(t, err)
+	upserted, err := repo.FindOneAndUpsert(ctx, byPlayer("p1"), conflux.NewUpsertFunc(createPlayer("p1", 2).CreateFn, levelUp))
+	require.NoError(t, err)
+	assert.True(t, upserted.WasCreated())
+	assert.Equal(t, int64(5), upserted.Version)
+	assert.Len(t, collection.docs, 1)
+	assert.NotContains(t, collection.docs[0], "deleted_at")
+}
+
+func TestMongoRepository_Queries(t *testing.T) {
+	collection := &memoryCollection{}
+	repo := newRepository(collection)
+	ctx := context.Background()
+	seedPlayers(t, repo, 5, 3, 9, 1)
+
+	version, err := repo.GetVersion(ctx, byPlayer("p3"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), version)
+
+	exists, err := repo.Exists(ctx, byPlayer("p4"))
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	sorted, err := repo.FindWithSort(ctx, conflux.NewMapFilter(), "level", false, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []int{9, 5, 3}, levels(sorted))
+
+	page, err := repo.FindByFilter(ctx, conflux.NewMapFilter().And("guild", "red"), 2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []int{3, 9}, levels(page))
+
+	many, err := repo.FindMany(ctx, conflux.NewMapFilter().And("level", 1), 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1}, levels(many))
+
+	results, err := repo.InsertMany(ctx,
+		[]conflux.MapFilter{byPlayer("p1"), byPlayer("p5")},
+		[]conflux.CreateFunc[*Player]{createPlayer("p1", 1), createPlayer("p5", 4)})
+	require.NoError(t, err)
+	assert.True(t, results[0].IsDuplicate())
+	assert.True(t, results[1].IsSuccess())
+}
+
+func keys(doc bson.M) []string {
+	var result []string
+	for key := range doc {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func levels(players []*Player) []int {
+	result := make([]int, len(players))
+	for i, p := range players {
+		result[i] = p.Level
+	}
+	return result
+}
@@ -320,6 +320,176 @@ func TestRedisRepository_FindOneAndUpdate_VersionConflict(t *testing.T) {
 	assert.Equal(t, int64(1), result.GetVersion()) // 실제 현재 버전
 }
 
+func TestRedisRepository_FindOneAndDelete_SoftDelete(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping Redis integration test in short mode")
+	}
+
+	client := setupRedisClient()
+	defer client.Close()
+
+	ctx := context.Background()
+	defer cleanupRedis(client, ctx)
+
+	repo := confluxredis.NewRedisRepository[*User](client, &confluxredis.RedisRepositoryConfig{
+		KeyPrefix: "mail:",
+	}, func() *User {
+		return &User{}
+	})
+
+	lookupFilter := conflux.NewRedisFilter("mail:mail1")
+	_, err := repo.FindOneAndUpsert(ctx, lookupFilter, conflux.NewUpsertFunc(
+		func(ctx context.Context) (*User, error) {
+			return &User{ID: "mail1", Status: "unread"}, nil
+		}, nil))
+	require.NoError(t, err)
+
+	// 잘못된 버전으로는 삭제되지 않음
+	conflict, err := repo.FindOneAndDelete(ctx, lookupFilter, 5, conflux.WithSoftDelete())
+	require.NoError(t, err)
+	assert.True(t, conflict.HasVersionConflict())
+	assert.Equal(t, int64(1), conflict.GetVersion())
+
+	// Act
+	result, err := repo.FindOneAndDelete(ctx, lookupFilter, 1, conflux.WithSoftDelete())
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, result.IsSoftDeleted())
+	assert.Equal(t, int64(2), result.GetVersion())
+	assert.True(t, result.GetEntity().IsDeleted())
+
+	exists, err := repo.Exists(ctx, lookupFilter)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// 툼스톤은 저장소에 남아 있음
+	deletedAt, err := client.HExists(ctx, "mail:mail1", "deleted_at").Result()
+	require.NoError(t, err)
+	assert.True(t, deletedAt)
+}
+
+func TestRedisRepository_Transaction_MoveCredits(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping Redis integration test in short mode")
+	}
+
+	client := setupRedisClient()
+	defer client.Close()
+
+	ctx := context.Background()
+	defer cleanupRedis(client, ctx)
+
+	repo := confluxredis.NewRedisRepository[*User](client, &confluxredis.RedisRepositoryConfig{
+		KeyPrefix: "player:",
+	}, func() *User {
+		return &User{}
+	})
+
+	for _, id := range []string{"a", "b"} {
+		id := id
+		_, err := repo.FindOneAndUpsert(ctx, conflux.NewRedisFilter("player:"+id), conflux.NewUpsertFunc(
+			func(ctx context.Context) (*User, error) {
+				return &User{ID: id, Credits: 100}, nil
+			}, nil))
+		require.NoError(t, err)
+	}
+
+	move := func(delta int) conflux.UpdateFunc[*User] {
+		return conflux.NewUpdateFunc(func(ctx context.Context, existing *User) (*User, error) {
+			existing.Credits += delta
+			return existing, nil
+		})
+	}
+
+	// 성공: 두 키가 함께 커밋됨
+	tx, err := repo.BeginTransaction(ctx)
+	require.NoError(t, err)
+	txRepo := repo.WithTransaction(tx)
+
+	_, err = txRepo.FindOneAndUpdate(ctx, conflux.NewRedisFilter("player:a"), 1, move(-30))
+	require.NoError(t, err)
+	_, err = txRepo.FindOneAndUpdate(ctx, conflux.NewRedisFilter("player:b"), 1, move(30))
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit(ctx))
+
+	a, err := repo.FindOne(ctx, conflux.NewRedisFilter("player:a"))
+	require.NoError(t, err)
+	b, err := repo.FindOne(ctx, conflux.NewRedisFilter("player:b"))
+	require.NoError(t, err)
+	assert.Equal(t, 70, a.Credits)
+	assert.Equal(t, 130, b.Credits)
+
+	// 충돌: 커밋 전에 B가 바뀌면 A도 적용되지 않음
+	tx, err = repo.BeginTransaction(ctx)
+	require.NoError(t, err)
+	txRepo = repo.WithTransaction(tx)
+
+	_, err = txRepo.FindOneAndUpdate(ctx, conflux.NewRedisFilter("player:a"), 2, move(-30))
+	require.NoError(t, err)
+	_, err = txRepo.FindOneAndUpdate(ctx, conflux.NewRedisFilter("player:b"), 2, move(30))
+	require.NoError(t, err)
+
+	_, err = repo.FindOneAndUpdate(ctx, conflux.NewRedisFilter("player:b"), 2, move(1))
+	require.NoError(t, err)
+
+	var conflictErr *conflux.ConflictError
+	require.ErrorAs(t, tx.Commit(ctx), &conflictErr)
+
+	a, err = repo.FindOne(ctx, conflux.NewRedisFilter("player:a"))
+	require.NoError(t, err)
+	assert.Equal(t, 70, a.Credits)
+	version, err := repo.GetVersion(ctx, conflux.NewRedisFilter("player:a"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), version)
+}
+
+func TestRedisRepository_Transaction_PatternInsertConflict(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping Redis integration test in short mode")
+	}
+
+	client := setupRedisClient()
+	defer client.Close()
+
+	ctx := context.Background()
+	defer cleanupRedis(client, ctx)
+
+	repo := confluxredis.NewRedisRepository[*User](client, &confluxredis.RedisRepositoryConfig{
+		KeyPrefix: "guild:",
+	}, func() *User {
+		return &User{}
+	})
+
+	// 두 트랜잭션이 같은 패턴으로 중복을 검사하고 서로 다른 키를 생성
+	anyMember := conflux.NewRedisFilter("guild:*")
+	create := func(id string) conflux.CreateFunc[*User] {
+		return conflux.NewCreateFunc(func(ctx context.Context) (*User, error) {
+			return &User{ID: id}, nil
+		})
+	}
+
+	first, err := repo.BeginTransaction(ctx)
+	require.NoError(t, err)
+	second, err := repo.BeginTransaction(ctx)
+	require.NoError(t, err)
+
+	_, err = repo.WithTransaction(first).FindOneAndInsert(ctx, anyMember, create("leader"))
+	require.NoError(t, err)
+	_, err = repo.WithTransaction(second).FindOneAndInsert(ctx, anyMember, create("rival"))
+	require.NoError(t, err)
+
+	require.NoError(t, first.Commit(ctx))
+
+	// 나중 커밋은 중복으로 충돌하고 아무것도 적용하지 않음
+	var conflictErr *conflux.ConflictError
+	require.ErrorAs(t, second.Commit(ctx), &conflictErr)
+
+	exists, err := repo.Exists(ctx, conflux.NewRedisFilter("guild:rival"))
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
 // ============================================================================
 // Redis 필터 기능 테스트
 // ============================================================================
@@ -3,8 +3,10 @@ package confluxredis
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -23,12 +25,14 @@ type RedisRepository[T any] struct {
 	keyPrefix   string        // 키 접두사 (예: "user:", "product:")
 	ttl         time.Duration // 기본 TTL (0이면 영구 보존)
 	newEntityFn func() T
+	clock       conflux.Clock
 }
 
 // RedisRepositoryConfig Redis 레포지토리 설정
 type RedisRepositoryConfig struct {
 	KeyPrefix string        // Redis 키 접두사
 	TTL       time.Duration // 기본 TTL
+	Clock     conflux.Clock // 타임스탬프 기록용 시각 소스 (nil이면 시스템 시간)
 }
 
 // NewRedisRepository 새 Redis 레포지토리 생성
@@ -49,6 +53,7 @@ func NewRedisRepository[T any](
 		keyPrefix:   config.KeyPrefix,
 		ttl:         config.TTL,
 		newEntityFn: newEntityFn,
+		clock:       conflux.ClockOrSystem(config.Clock),
 	}
 }
 
@@ -57,10 +62,12 @@ func NewRedisRepository[T any](
 // ============================================================================
 
 // FindOneAndInsert 원자적 엔터티 생성
+// options는 conflux.Repository 호환을 위해 받으며 Redis 어댑터에서는 아직 사용하지 않습니다
 func (r *RedisRepository[T]) FindOneAndInsert(
 	ctx context.Context,
 	duplicateCheckFilter *conflux.RedisFilter,
 	createFunc conflux.CreateFunc[T],
+	options ...conflux.InsertOption,
 ) (*conflux.InsertResult[T], error) {
 	// 필터 유효성 검증
 	if err := duplicateCheckFilter.Validate(); err != nil {
@@ -116,10 +123,12 @@ func (r *RedisRepository[T]) FindOneAndInsert(
 }
 
 // FindOneAndUpsert 원자적 엔터티 생성/업데이트
+// options는 conflux.Repository 호환을 위해 받으며 Redis 어댑터에서는 아직 사용하지 않습니다
 func (r *RedisRepository[T]) FindOneAndUpsert(
 	ctx context.Context,
 	lookupFilter *conflux.RedisFilter,
 	upsertFunc conflux.UpsertFunc[T],
+	options ...conflux.UpsertOption,
 ) (*conflux.UpsertResult[T], error) {
 	// 필터 유효성 검증
 	if err := lookupFilter.Validate(); err != nil {
@@ -183,11 +192,13 @@ func (r *RedisRepository[T]) FindOneAndUpsert(
 }
 
 // FindOneAndUpdate 원자적 엔터티 업데이트
+// options는 conflux.Repository 호환을 위해 받으며 Redis 어댑터에서는 아직 사용하지 않습니다
 func (r *RedisRepository[T]) FindOneAndUpdate(
 	ctx context.Context,
 	lookupFilter *conflux.RedisFilter,
 	expectedVersion int64,
 	updateFunc conflux.UpdateFunc[T],
+	options ...conflux.UpdateOption,
 ) (*conflux.UpdateResult[T], error) {
 	// 필터 유효성 검증
 	if err := lookupFilter.Validate(); err != nil {
@@ -246,6 +257,117 @@ func (r *RedisRepository[T]) FindOneAndUpdate(
 	return result, nil
 }
 
+// FindOneAndDelete 원자적 엔터티 삭제
+// 엔터티 키를 WATCH한 상태에서 버전을 확인하고 MULTI/EXEC로 삭제하므로
+// 확인과 삭제 사이에 다른 쓰기가 끼어들면 버전 충돌로 처리됩니다
+// 소프트 삭제 시 해시에 deleted_at이 기록되고, 툼스톤은 이후 조회에서 제외됩니다
+func (r *RedisRepository[T]) FindOneAndDelete(
+	ctx context.Context,
+	lookupFilter *conflux.RedisFilter,
+	expectedVersion int64,
+	options ...conflux.DeleteOption,
+) (*conflux.DeleteResult[T], error) {
+	// 옵션 처리
+	config := conflux.NewDeleteConfig()
+	for _, opt := range options {
+		opt(config)
+	}
+
+	// 필터 유효성 검증
+	if err := lookupFilter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	var result *conflux.DeleteResult[T]
+
+	txf := func(tx *redis.Tx) error {
+		keys, err := r.findKeysByFilterTx(ctx, tx, lookupFilter)
+		if err != nil {
+			return fmt.Errorf("failed to find keys: %w", err)
+		}
+		if len(keys) == 0 {
+			result = conflux.NewDeleteNotFoundResult[T]()
+			return nil
+		}
+
+		// 실제 엔터티 키를 감시하여 버전 확인 이후의 변경을 감지
+		key := keys[0]
+		if err := tx.Watch(ctx, key).Err(); err != nil {
+			return fmt.Errorf("failed to watch entity: %w", err)
+		}
+
+		existing, currentVersion, err := r.loadEntity(ctx, tx, key)
+		if err != nil {
+			if isNotFoundError(err) {
+				result = conflux.NewDeleteNotFoundResult[T]()
+				return nil
+			}
+			return err
+		}
+
+		// 버전 충돌 검사
+		if currentVersion != expectedVersion {
+			result = conflux.NewDeleteConflictResult(existing, currentVersion)
+			return nil
+		}
+
+		now := r.clock.Now()
+
+		if !config.Soft {
+			_, err := tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+				pipe.Del(ctx, key)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			result = conflux.NewDeleteResult(existing, currentVersion, now, false)
+			return nil
+		}
+
+		// 툼스톤: deleted-at 기록 및 버전 증가
+		if config.TombstoneUpdate != nil {
+			config.TombstoneUpdate(existing)
+		}
+		newVersion := expectedVersion + 1
+		if versioned, ok := any(existing).(conflux.Versioned); ok {
+			versioned.SetVersion(newVersion)
+		}
+		if timestamped, ok := any(existing).(conflux.Timestamped); ok {
+			timestamped.SetUpdatedAt(now)
+		}
+		if deletable, ok := any(existing).(conflux.SoftDeletable); ok {
+			deletable.SetDeletedAt(&now)
+		}
+
+		if err := r.writeEntity(ctx, tx, key, existing, newVersion, now); err != nil {
+			return err
+		}
+
+		result = conflux.NewDeleteResult(existing, newVersion, now, true)
+		return nil
+	}
+
+	watchKeys := r.getWatchKeys(lookupFilter)
+	if err := r.client.Watch(ctx, txf, watchKeys...); err != nil {
+		// 감시 중인 키가 변경된 경우 최신 상태로 충돌 결과 반환
+		if errors.Is(err, redis.TxFailedErr) {
+			current, findErr := r.findByFilter(ctx, lookupFilter)
+			if findErr != nil {
+				if isNotFoundError(findErr) {
+					return conflux.NewDeleteNotFoundResult[T](), nil
+				}
+				return nil, fmt.Errorf("failed to reload entity: %w", findErr)
+			}
+			version, _ := r.getEntityVersion(ctx, current)
+			return conflux.NewDeleteConflictResult(current, version), nil
+		}
+		return nil, fmt.Errorf("failed to delete entity: %w", err)
+	}
+
+	return result, nil
+}
+
 // NewEntity 새 엔터티 인스턴스 생성
 func (r *RedisRepository[T]) NewEntity() T {
 	if r.newEntityFn != nil {
@@ -338,14 +460,14 @@ func (r *RedisRepository[T]) findByFilter(ctx context.Context, filter *conflux.R
 	}
 
 	if len(keys) == 0 {
-		return empty, fmt.Errorf("entity not found")
+		return empty, conflux.ErrNotFound
 	}
 
 	// 첫 번째 매칭 키로 엔터티 조회
 	entityData, err := r.client.HGet(ctx, keys[0], "data").Result()
 	if err != nil {
 		if err == redis.Nil {
-			return empty, fmt.Errorf("entity not found")
+			return empty, conflux.ErrNotFound
 		}
 		return empty, fmt.Errorf("failed to get entity data: %w", err)
 	}
@@ -368,14 +490,14 @@ func (r *RedisRepository[T]) findByFilterTx(ctx context.Context, tx *redis.Tx, f
 	}
 
 	if len(keys) == 0 {
-		return empty, fmt.Errorf("entity not found")
+		return empty, conflux.ErrNotFound
 	}
 
 	// 첫 번째 매칭 키로 엔터티 조회
 	entityData, err := tx.HGet(ctx, keys[0], "data").Result()
 	if err != nil {
 		if err == redis.Nil {
-			return empty, fmt.Errorf("entity not found")
+			return empty, conflux.ErrNotFound
 		}
 		return empty, fmt.Errorf("failed to get entity data: %w", err)
 	}
@@ -409,20 +531,72 @@ func (r *RedisRepository[T]) findKeysByFilterTx(ctx context.Context, tx *redis.T
 
 // findKeysByFilterCmdable Redis Cmdable로 필터 조건에 맞는 키들 조회
 func (r *RedisRepository[T]) findKeysByFilterCmdable(ctx context.Context, cmdable redis.Cmdable, filter *conflux.RedisFilter) ([]string, error) {
-	var pattern string
+	keys, err := cmdable.Keys(ctx, r.filterPattern(filter)).Result()
+	if err != nil || len(keys) == 0 {
+		return keys, err
+	}
+
+	return r.excludeTombstones(ctx, cmdable, keys)
+}
 
+// filterPattern 필터에 해당하는 Redis 키 패턴
+func (r *RedisRepository[T]) filterPattern(filter *conflux.RedisFilter) string {
 	if filter.Pattern != "" {
-		pattern = filter.Pattern
-	} else if filter.KeyPrefix != "" {
-		pattern = filter.KeyPrefix + "*"
-		if filter.Suffix != "" {
-			pattern = filter.KeyPrefix + "*" + filter.Suffix
+		return filter.Pattern
+	}
+	if filter.KeyPrefix != "" {
+		return filter.KeyPrefix + "*" + filter.Suffix
+	}
+	return r.keyPrefix + "*"
+}
+
+// excludeTombstones 소프트 삭제된(deleted_at이 기록된) 키들을 제외
+func (r *RedisRepository[T]) excludeTombstones(ctx context.Context, cmdable redis.Cmdable, keys []string) ([]string, error) {
+	pipe := cmdable.Pipeline()
+	cmds := make([]*redis.BoolCmd, len(keys))
+	for i, key := range keys {
+		cmds[i] = pipe.HExists(ctx, key, "deleted_at")
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("failed to check tombstones: %w", err)
+	}
+
+	live := keys[:0]
+	for i, cmd := range cmds {
+		if !cmd.Val() {
+			live = append(live, keys[i])
 		}
-	} else {
-		pattern = r.keyPrefix + "*"
+	}
+	return live, nil
+}
+
+// loadEntity 키에 저장된 엔터티와 버전을 함께 조회
+func (r *RedisRepository[T]) loadEntity(ctx context.Context, cmdable redis.Cmdable, key string) (T, int64, error) {
+	var empty T
+
+	values, err := cmdable.HMGet(ctx, key, "data", "version").Result()
+	if err != nil {
+		return empty, 0, fmt.Errorf("failed to get entity data: %w", err)
+	}
+
+	data, ok := values[0].(string)
+	if !ok {
+		return empty, 0, conflux.ErrNotFound
+	}
+
+	var entity T
+	if err := json.Unmarshal([]byte(data), &entity); err != nil {
+		return empty, 0, fmt.Errorf("failed to unmarshal entity: %w", err)
 	}
 
-	return cmdable.Keys(ctx, pattern).Result()
+	raw, _ := values[1].(string)
+	version, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return empty, 0, fmt.Errorf("invalid entity version %q: %w", raw, err)
+	}
+
+	return entity, version, nil
 }
 
 // storeEntity 엔터티를 Redis에 저장
@@ -433,29 +607,45 @@ func (r *RedisRepository[T]) storeEntity(ctx context.Context, cmdable redis.Cmda
 		return fmt.Errorf("failed to extract entity ID: %w", err)
 	}
 
-	key := r.keyPrefix + id
+	return r.writeEntity(ctx, cmdable, r.keyPrefix+id, entity, version, time.Time{})
+}
 
+// writeEntity 엔터티를 지정한 키에 저장
+// deletedAt이 0이 아니면 툼스톤으로 기록하고, 0이면 이전 툼스톤 표시를 제거합니다
+func (r *RedisRepository[T]) writeEntity(ctx context.Context, cmdable redis.Cmdable, key string, entity T, version int64, deletedAt time.Time) error {
 	// 엔터티 직렬화
 	entityData, err := json.Marshal(entity)
 	if err != nil {
 		return fmt.Errorf("failed to marshal entity: %w", err)
 	}
 
-	// Redis Hash로 저장 (데이터와 메타데이터 분리)
 	pipe := cmdable.TxPipeline()
+	r.queueWrite(ctx, pipe, key, entityData, version, deletedAt)
+
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// queueWrite 직렬화된 엔터티 저장 명령을 파이프라인에 추가
+func (r *RedisRepository[T]) queueWrite(ctx context.Context, pipe redis.Pipeliner, key string, entityData []byte, version int64, deletedAt time.Time) {
+	// Redis Hash로 저장 (데이터와 메타데이터 분리)
+	now := r.clock.Now()
 	pipe.HSet(ctx, key, map[string]interface{}{
 		"data":       string(entityData),
 		"version":    version,
-		"created_at": time.Now().Unix(),
-		"updated_at": time.Now().Unix(),
+		"created_at": now.Unix(),
+		"updated_at": now.Unix(),
 	})
 
+	if deletedAt.IsZero() {
+		pipe.HDel(ctx, key, "deleted_at")
+	} else {
+		pipe.HSet(ctx, key, "deleted_at", deletedAt.Unix())
+	}
+
 	if r.ttl > 0 {
 		pipe.Expire(ctx, key, r.ttl)
 	}
-
-	_, err = pipe.Exec(ctx)
-	return err
 }
 
 // getEntitiesByKeys 키 목록으로 엔터티들 일괄 조회
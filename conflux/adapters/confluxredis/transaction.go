@@ -0,0 +1,523 @@
+package confluxredis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/homveloper/dukdakit/conflux"
+	"github.com/redis/go-redis/v9"
+)
+
+// 컴파일 타임 인터페이스 검사
+var _ conflux.TransactionalRepository[any, *conflux.RedisFilter] = (*RedisRepository[any])(nil)
+
+// ============================================================================
+// TransactionalRepository 인터페이스 구현
+// ============================================================================
+
+// redisTransaction 스테이징된 쓰기와 관찰한 버전을 보관하는 트랜잭션
+// Commit 시 트랜잭션이 읽거나 쓴 모든 키를 WATCH하고 버전을 다시 확인한 뒤
+// MULTI/EXEC로 모든 쓰기를 한 번에 적용합니다
+type redisTransaction[T any] struct {
+	mu       sync.Mutex
+	repo     *RedisRepository[T]
+	staged   map[string]*stagedWrite[T] // Redis 키 -> 스테이징된 쓰기
+	observed map[string]int64           // Redis 키 -> 처음 접근 시의 버전 (없으면 0)
+	inserts  []stagedInsert             // 중복 검사를 통과한 생성 (Commit 시 다시 검사)
+	active   bool
+}
+
+// stagedInsert 필터로 중복이 없음을 확인하고 스테이징한 생성
+// 패턴 필터는 생성하는 키 외의 키에도 맞을 수 있어 키 버전만으로는 중복을 막을 수 없으므로
+// Commit에서 필터를 다시 실행합니다
+type stagedInsert struct {
+	key    string
+	filter *conflux.RedisFilter
+}
+
+// stagedWrite 트랜잭션 내에서 스테이징된 쓰기
+type stagedWrite[T any] struct {
+	entity    T
+	version   int64
+	deletedAt time.Time // 0이 아니면 툼스톤
+	remove    bool      // 하드 삭제
+}
+
+// live 조회 대상이 되는 쓰기인지 확인
+func (w *stagedWrite[T]) live() bool {
+	return !w.remove && w.deletedAt.IsZero()
+}
+
+// BeginTransaction 새 트랜잭션 시작
+func (r *RedisRepository[T]) BeginTransaction(ctx context.Context) (conflux.TransactionContext, error) {
+	return &redisTransaction[T]{
+		repo:     r,
+		staged:   make(map[string]*stagedWrite[T]),
+		observed: make(map[string]int64),
+		active:   true,
+	}, nil
+}
+
+// WithTransaction 트랜잭션 내에서 동작하는 레포지토리 반환
+// 다른 레포지토리의 트랜잭션이 전달되면 모든 연산이 ErrInvalidTransaction을 반환합니다
+func (r *RedisRepository[T]) WithTransaction(tx conflux.TransactionContext) conflux.Repository[T, *conflux.RedisFilter] {
+	redisTx, ok := tx.(*redisTransaction[T])
+	if !ok || redisTx.repo != r {
+		redisTx = nil
+	}
+	return &txRepository[T]{repo: r, tx: redisTx}
+}
+
+// Commit 스테이징된 쓰기를 원자적으로 적용
+// 관찰한 버전이 바뀌었거나, 생성 시 중복 검사한 필터에 다른 키가 생겼거나, EXEC 전에 감시 중인 키가 변경되면
+// *conflux.ConflictError를 반환하고 아무것도 적용하지 않습니다
+func (t *redisTransaction[T]) Commit(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.active {
+		return conflux.ErrTransactionClosed
+	}
+	t.active = false
+
+	if len(t.staged) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(t.observed))
+	for key := range t.observed {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	// 엔터티 직렬화는 WATCH 전에 끝내서 감시 구간을 짧게 유지
+	payloads := make(map[string][]byte, len(t.staged))
+	for key, write := range t.staged {
+		if write.remove {
+			continue
+		}
+		data, err := json.Marshal(write.entity)
+		if err != nil {
+			return fmt.Errorf("failed to marshal entity: %w", err)
+		}
+		payloads[key] = data
+	}
+
+	txf := func(tx *redis.Tx) error {
+		if err := t.checkInserts(ctx, tx); err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			actual, err := tx.HGet(ctx, key, "version").Int64()
+			if err == redis.Nil {
+				actual = 0
+			} else if err != nil {
+				return fmt.Errorf("failed to get entity version: %w", err)
+			}
+
+			if expected := t.observed[key]; actual != expected {
+				return conflux.NewConflictError(expected, actual,
+					fmt.Sprintf("version conflict on key %q: expected %d, got %d", key, expected, actual))
+			}
+		}
+
+		_, err := tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			for key, write := range t.staged {
+				if write.remove {
+					pipe.Del(ctx, key)
+					continue
+				}
+				t.repo.queueWrite(ctx, pipe, key, payloads[key], write.version, write.deletedAt)
+			}
+			return nil
+		})
+		return err
+	}
+
+	err := t.repo.client.Watch(ctx, txf, keys...)
+	if errors.Is(err, redis.TxFailedErr) {
+		return conflux.NewConflictError(0, 0, "transaction aborted: watched keys were modified concurrently")
+	}
+	return err
+}
+
+// Rollback 스테이징된 쓰기를 모두 버림
+func (t *redisTransaction[T]) Rollback(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.active {
+		return conflux.ErrTransactionClosed
+	}
+	t.active = false
+	t.staged = nil
+	t.inserts = nil
+	return nil
+}
+
+// IsActive 트랜잭션이 커밋/롤백 전인지 확인
+func (t *redisTransaction[T]) IsActive() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.active
+}
+
+// ============================================================================
+// 트랜잭션 레포지토리
+// ============================================================================
+
+// txRepository 트랜잭션에 쓰기를 스테이징하는 Repository 구현
+// 조회는 Redis의 현재 상태 위에 스테이징된 쓰기를 겹쳐서 봅니다
+type txRepository[T any] struct {
+	repo *RedisRepository[T]
+	tx   *redisTransaction[T]
+}
+
+// FindOneAndInsert 트랜잭션 내 엔터티 생성
+func (r *txRepository[T]) FindOneAndInsert(
+	ctx context.Context,
+	duplicateCheckFilter *conflux.RedisFilter,
+	createFunc conflux.CreateFunc[T],
+	options ...conflux.InsertOption,
+) (*conflux.InsertResult[T], error) {
+	if err := duplicateCheckFilter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	t, err := r.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer t.mu.Unlock()
+
+	_, existing, found, err := t.find(ctx, duplicateCheckFilter)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return conflux.NewDuplicateInsertResult(existing.entity, existing.version), nil
+	}
+
+	newEntity, err := createFunc.CreateFn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create entity: %w", err)
+	}
+
+	if err := t.stageCreate(ctx, newEntity, duplicateCheckFilter); err != nil {
+		return nil, err
+	}
+
+	return conflux.NewInsertResult(newEntity, 1), nil
+}
+
+// FindOneAndUpsert 트랜잭션 내 엔터티 생성/업데이트
+func (r *txRepository[T]) FindOneAndUpsert(
+	ctx context.Context,
+	lookupFilter *conflux.RedisFilter,
+	upsertFunc conflux.UpsertFunc[T],
+	options ...conflux.UpsertOption,
+) (*conflux.UpsertResult[T], error) {
+	if err := lookupFilter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	t, err := r.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer t.mu.Unlock()
+
+	key, existing, found, err := t.find(ctx, lookupFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		newEntity, err := upsertFunc.CreateFn(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create entity: %w", err)
+		}
+
+		if err := t.stageCreate(ctx, newEntity, lookupFilter); err != nil {
+			return nil, err
+		}
+
+		return conflux.NewUpsertResult(newEntity, true, 1), nil
+	}
+
+	updatedEntity, err := upsertFunc.UpdateFn(ctx, existing.entity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update entity: %w", err)
+	}
+
+	newVersion := existing.version + 1
+	t.stage(key, &stagedWrite[T]{entity: updatedEntity, version: newVersion})
+	return conflux.NewUpsertResult(updatedEntity, false, newVersion), nil
+}
+
+// FindOneAndUpdate 트랜잭션 내 엔터티 업데이트
+func (r *txRepository[T]) FindOneAndUpdate(
+	ctx context.Context,
+	lookupFilter *conflux.RedisFilter,
+	expectedVersion int64,
+	updateFunc conflux.UpdateFunc[T],
+	options ...conflux.UpdateOption,
+) (*conflux.UpdateResult[T], error) {
+	config := conflux.NewUpdateConfig()
+	for _, opt := range options {
+		opt(config)
+	}
+
+	if err := lookupFilter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	t, err := r.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer t.mu.Unlock()
+
+	key, existing, found, err := t.find(ctx, lookupFilter)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return conflux.NewNotFoundResult[T](), nil
+	}
+
+	if existing.version != expectedVersion && config.OnConflict != conflux.OverwriteOnConflict {
+		return conflux.NewVersionConflictResult(existing.entity, existing.version), nil
+	}
+
+	updatedEntity, err := updateFunc.UpdateFn(ctx, existing.entity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update entity: %w", err)
+	}
+
+	newVersion := existing.version + 1
+	t.stage(key, &stagedWrite[T]{entity: updatedEntity, version: newVersion})
+	return conflux.NewUpdateResult(updatedEntity, newVersion), nil
+}
+
+// FindOneAndDelete 트랜잭션 내 엔터티 삭제
+func (r *txRepository[T]) FindOneAndDelete(
+	ctx context.Context,
+	lookupFilter *conflux.RedisFilter,
+	expectedVersion int64,
+	options ...conflux.DeleteOption,
+) (*conflux.DeleteResult[T], error) {
+	config := conflux.NewDeleteConfig()
+	for _, opt := range options {
+		opt(config)
+	}
+
+	if err := lookupFilter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	t, err := r.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer t.mu.Unlock()
+
+	key, existing, found, err := t.find(ctx, lookupFilter)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return conflux.NewDeleteNotFoundResult[T](), nil
+	}
+
+	if existing.version != expectedVersion {
+		return conflux.NewDeleteConflictResult(existing.entity, existing.version), nil
+	}
+
+	now := r.repo.clock.Now()
+
+	if !config.Soft {
+		t.stage(key, &stagedWrite[T]{entity: existing.entity, version: existing.version, remove: true})
+		return conflux.NewDeleteResult(existing.entity, existing.version, now, false), nil
+	}
+
+	tombstone := existing.entity
+	if config.TombstoneUpdate != nil {
+		config.TombstoneUpdate(tombstone)
+	}
+	newVersion := existing.version + 1
+	if versioned, ok := any(tombstone).(conflux.Versioned); ok {
+		versioned.SetVersion(newVersion)
+	}
+	if timestamped, ok := any(tombstone).(conflux.Timestamped); ok {
+		timestamped.SetUpdatedAt(now)
+	}
+	if deletable, ok := any(tombstone).(conflux.SoftDeletable); ok {
+		deletable.SetDeletedAt(&now)
+	}
+
+	t.stage(key, &stagedWrite[T]{entity: tombstone, version: newVersion, deletedAt: now})
+	return conflux.NewDeleteResult(tombstone, newVersion, now, true), nil
+}
+
+// NewEntity 새 엔터티 인스턴스 생성
+func (r *txRepository[T]) NewEntity() T {
+	return r.repo.NewEntity()
+}
+
+// lock 트랜잭션을 잠그고 활성 상태인지 확인
+// 성공하면 호출자가 t.mu.Unlock을 호출해야 합니다
+func (r *txRepository[T]) lock() (*redisTransaction[T], error) {
+	if r.tx == nil {
+		return nil, conflux.ErrInvalidTransaction
+	}
+
+	r.tx.mu.Lock()
+	if !r.tx.active {
+		r.tx.mu.Unlock()
+		return nil, conflux.ErrTransactionClosed
+	}
+	return r.tx, nil
+}
+
+// ============================================================================
+// 트랜잭션 헬퍼 메서드들 (t.mu를 잡은 상태에서 호출)
+// ============================================================================
+
+// find Redis의 현재 상태 위에 스테이징된 쓰기를 겹쳐서 필터에 맞는 엔터티 조회
+// Redis에서 읽은 엔터티는 그 버전을 관찰 목록에 기록합니다
+func (t *redisTransaction[T]) find(ctx context.Context, filter *conflux.RedisFilter) (string, *stagedWrite[T], bool, error) {
+	keys, err := t.repo.findKeysByFilter(ctx, filter)
+	if err != nil {
+		return "", nil, false, fmt.Errorf("failed to find keys: %w", err)
+	}
+
+	for _, key := range keys {
+		if write, ok := t.staged[key]; ok {
+			if write.live() {
+				return key, write, true, nil
+			}
+			continue
+		}
+
+		entity, version, err := t.repo.loadEntity(ctx, t.repo.client, key)
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return "", nil, false, err
+		}
+
+		if _, seen := t.observed[key]; !seen {
+			t.observed[key] = version
+		}
+		return key, &stagedWrite[T]{entity: entity, version: version}, true, nil
+	}
+
+	// 트랜잭션 안에서 새로 생성되거나 되살아난 엔터티
+	pattern := t.repo.filterPattern(filter)
+	stagedKeys := make([]string, 0, len(t.staged))
+	for key := range t.staged {
+		stagedKeys = append(stagedKeys, key)
+	}
+	sort.Strings(stagedKeys)
+
+	for _, key := range stagedKeys {
+		if write := t.staged[key]; write.live() {
+			if matched, _ := path.Match(pattern, key); matched {
+				return key, write, true, nil
+			}
+		}
+	}
+
+	return "", nil, false, nil
+}
+
+// stageCreate 새 엔터티를 버전 1로 스테이징하고 Commit에서 다시 검사할 필터를 기록
+func (t *redisTransaction[T]) stageCreate(ctx context.Context, entity T, filter *conflux.RedisFilter) error {
+	id, err := t.repo.extractID(entity)
+	if err != nil {
+		return fmt.Errorf("failed to extract entity ID: %w", err)
+	}
+
+	key := t.repo.keyPrefix + id
+	if err := t.observe(ctx, key); err != nil {
+		return err
+	}
+
+	now := t.repo.clock.Now()
+	if versioned, ok := any(entity).(conflux.Versioned); ok {
+		versioned.SetVersion(1)
+	}
+	if timestamped, ok := any(entity).(conflux.Timestamped); ok {
+		timestamped.SetCreatedAt(now)
+		timestamped.SetUpdatedAt(now)
+	}
+
+	t.stage(key, &stagedWrite[T]{entity: entity, version: 1})
+	t.inserts = append(t.inserts, stagedInsert{key: key, filter: filter})
+	return nil
+}
+
+// checkInserts 생성 시 비어 있던 필터에 다른 쓰기가 만든 키가 있는지 WATCH 안에서 다시 검사
+// 이 트랜잭션이 스테이징한 키는 제외합니다
+// KEYS로 찾은 새 키는 감시 대상이 아니므로 검사와 EXEC 사이에 생성된 키까지 막지는 못합니다
+func (t *redisTransaction[T]) checkInserts(ctx context.Context, tx *redis.Tx) error {
+	for _, insert := range t.inserts {
+		keys, err := t.repo.findKeysByFilterTx(ctx, tx, insert.filter)
+		if err != nil {
+			return fmt.Errorf("failed to find keys: %w", err)
+		}
+
+		for _, key := range keys {
+			if _, staged := t.staged[key]; staged {
+				continue
+			}
+
+			version, err := tx.HGet(ctx, key, "version").Int64()
+			if err != nil && err != redis.Nil {
+				return fmt.Errorf("failed to get entity version: %w", err)
+			}
+			return conflux.NewConflictError(0, version,
+				fmt.Sprintf("duplicate key %q committed for insert of %q", key, insert.key))
+		}
+	}
+	return nil
+}
+
+// stage 쓰기를 스테이징하고 엔터티의 버전 필드를 맞춤
+func (t *redisTransaction[T]) stage(key string, write *stagedWrite[T]) {
+	if !write.remove {
+		if versioned, ok := any(write.entity).(conflux.Versioned); ok {
+			versioned.SetVersion(write.version)
+		}
+		if timestamped, ok := any(write.entity).(conflux.Timestamped); ok && write.deletedAt.IsZero() {
+			timestamped.SetUpdatedAt(t.repo.clock.Now())
+		}
+	}
+	t.staged[key] = write
+}
+
+// observe 키의 현재 버전을 처음 접근할 때 한 번만 기록 (툼스톤 포함, 없으면 0)
+func (t *redisTransaction[T]) observe(ctx context.Context, key string) error {
+	if _, seen := t.observed[key]; seen {
+		return nil
+	}
+
+	version, err := t.repo.client.HGet(ctx, key, "version").Int64()
+	if err == redis.Nil {
+		version = 0
+	} else if err != nil {
+		return fmt.Errorf("failed to get entity version: %w", err)
+	}
+
+	t.observed[key] = version
+	return nil
+}
@@ -0,0 +1,201 @@
+package confluxsql
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/homveloper/dukdakit/conflux"
+)
+
+// ============================================================================
+// SQL 방언
+// ============================================================================
+
+// Dialect 데이터베이스 엔진별 SQL 차이
+// 식별자는 항상 QuoteIdent를, 값은 항상 바인드 파라미터를 거칩니다
+type Dialect interface {
+	// Name 방언 이름 (예: "sqlite")
+	Name() string
+
+	// Placeholder n번째 인자(1부터)의 바인드 파라미터
+	Placeholder(n int) string
+
+	// QuoteIdent 식별자 인용
+	QuoteIdent(name string) string
+
+	// InsertIgnore 유니크 제약과 충돌하면 아무것도 하지 않는 INSERT 문
+	// columns와 placeholders는 이미 인용/바인딩된 상태입니다
+	InsertIgnore(table string, columns []string, placeholders []string) string
+
+	// LimitOffset 페이지 절 (limit <= 0이면 제한 없음, 앞에 공백 포함)
+	LimitOffset(limit, offset int) string
+}
+
+var (
+	// SQLite ? 파라미터, "큰따옴표" 식별자, INSERT ... ON CONFLICT DO NOTHING
+	SQLite Dialect = sqliteDialect{}
+
+	// PostgreSQL $1 파라미터, "큰따옴표" 식별자, INSERT ... ON CONFLICT DO NOTHING
+	PostgreSQL Dialect = postgresDialect{}
+
+	// MySQL ? 파라미터, `백틱` 식별자, INSERT IGNORE
+	MySQL Dialect = mysqlDialect{}
+)
+
+type sqliteDialect struct{}
+
+func (sqliteDialect) Name() string                  { return "sqlite" }
+func (sqliteDialect) Placeholder(int) string        { return "?" }
+func (sqliteDialect) QuoteIdent(name string) string { return quoteIdent(name, `"`) }
+func (sqliteDialect) InsertIgnore(table string, columns, placeholders []string) string {
+	return insertStatement("INSERT INTO", table, columns, placeholders) + " ON CONFLICT DO NOTHING"
+}
+func (sqliteDialect) LimitOffset(limit, offset int) string {
+	return limitOffset(limit, offset, "-1")
+}
+
+type postgresDialect struct{}
+
+func (postgresDialect) Name() string                  { return "postgresql" }
+func (postgresDialect) Placeholder(n int) string      { return fmt.Sprintf("$%d", n) }
+func (postgresDialect) QuoteIdent(name string) string { return quoteIdent(name, `"`) }
+func (postgresDialect) InsertIgnore(table string, columns, placeholders []string) string {
+	return insertStatement("INSERT INTO", table, columns, placeholders) + " ON CONFLICT DO NOTHING"
+}
+func (postgresDialect) LimitOffset(limit, offset int) string {
+	return limitOffset(limit, offset, "")
+}
+
+type mysqlDialect struct{}
+
+func (mysqlDialect) Name() string                  { return "mysql" }
+func (mysqlDialect) Placeholder(int) string        { return "?" }
+func (mysqlDialect) QuoteIdent(name string) string { return quoteIdent(name, "`") }
+func (mysqlDialect) InsertIgnore(table string, columns, placeholders []string) string {
+	return insertStatement("INSERT IGNORE INTO", table, columns, placeholders)
+}
+func (mysqlDialect) LimitOffset(limit, offset int) string {
+	return limitOffset(limit, offset, "18446744073709551615")
+}
+
+// quoteIdent 점으로 구분된 각 부분을 인용하고 내부 인용 문자는 두 번 씀
+func quoteIdent(name, quote string) string {
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		parts[i] = quote + strings.ReplaceAll(part, quote, quote+quote) + quote
+	}
+	return strings.Join(parts, ".")
+}
+
+// insertStatement INSERT 문 본문
+func insertStatement(verb, table string, columns, placeholders []string) string {
+	return fmt.Sprintf("%s %s (%s) VALUES (%s)", verb, table, strings.Join(columns, ", "), strings.Join(placeholders, ", "))
+}
+
+// limitOffset LIMIT/OFFSET 절
+// OFFSET만 쓸 수 없는 엔진은 unbounded를 LIMIT 값으로 사용합니다 (빈 문자열이면 LIMIT 생략)
+func limitOffset(limit, offset int, unbounded string) string {
+	var sb strings.Builder
+	switch {
+	case limit > 0:
+		fmt.Fprintf(&sb, " LIMIT %d", limit)
+	case offset > 0 && unbounded != "":
+		sb.WriteString(" LIMIT " + unbounded)
+	}
+	if offset > 0 {
+		fmt.Fprintf(&sb, " OFFSET %d", offset)
+	}
+	return sb.String()
+}
+
+// ============================================================================
+// 쿼리 빌더와 SQLFilter 매핑
+// ============================================================================
+
+// queryBuilder 방언에 맞게 파라미터 번호를 매기며 쿼리를 조립
+type queryBuilder struct {
+	dialect Dialect
+	sb      strings.Builder
+	args    []any
+}
+
+func newQueryBuilder(dialect Dialect) *queryBuilder {
+	return &queryBuilder{dialect: dialect}
+}
+
+// write 쿼리 조각 추가
+func (b *queryBuilder) write(s string) {
+	b.sb.WriteString(s)
+}
+
+// ident 인용된 식별자 추가
+func (b *queryBuilder) ident(name string) {
+	b.sb.WriteString(b.dialect.QuoteIdent(name))
+}
+
+// arg 값을 바인딩하고 파라미터 추가
+func (b *queryBuilder) arg(value any) {
+	b.args = append(b.args, value)
+	b.sb.WriteString(b.dialect.Placeholder(len(b.args)))
+}
+
+// String 조립된 쿼리
+func (b *queryBuilder) String() string {
+	return b.sb.String()
+}
+
+// filter SQLFilter를 방언에 맞게 추가
+//   - ? 파라미터는 방언의 파라미터로 바뀝니다 (작은따옴표 문자열 안의 ?는 그대로 둠)
+//   - 슬라이스 인자는 IN 목록용으로 펼쳐집니다 ("sku IN ?" + []string{"a", "b"} → "sku IN (?, ?)")
+//   - []byte와 driver.Valuer는 펼치지 않고 그대로 바인딩합니다
+func (b *queryBuilder) filter(filter *conflux.SQLFilter) error {
+	args := filter.Args
+	next := 0
+	inString := false
+
+	for _, r := range filter.Query {
+		switch {
+		case r == '\'':
+			inString = !inString
+			b.sb.WriteRune(r)
+		case r == '?' && !inString:
+			if next >= len(args) {
+				return fmt.Errorf("SQL filter has more placeholders than arguments (%d)", len(args))
+			}
+			b.bindFilterArg(args[next])
+			next++
+		default:
+			b.sb.WriteRune(r)
+		}
+	}
+
+	if next != len(args) {
+		return fmt.Errorf("SQL filter has %d placeholders but %d arguments", next, len(args))
+	}
+	return nil
+}
+
+// bindFilterArg 필터 인자 하나를 바인딩 (슬라이스는 괄호 목록으로 펼침)
+func (b *queryBuilder) bindFilterArg(value any) {
+	if _, ok := value.(driver.Valuer); !ok {
+		v := reflect.ValueOf(value)
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8 {
+			if v.Len() == 0 {
+				b.write("(NULL)")
+				return
+			}
+			b.write("(")
+			for i := 0; i < v.Len(); i++ {
+				if i > 0 {
+					b.write(", ")
+				}
+				b.arg(v.Index(i).Interface())
+			}
+			b.write(")")
+			return
+		}
+	}
+	b.arg(value)
+}
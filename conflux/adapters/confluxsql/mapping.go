@@ -0,0 +1,238 @@
+package confluxsql
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ============================================================================
+// 엔터티 ↔ 컬럼 매핑
+// ============================================================================
+
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+	valuerType  = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+)
+
+// column 엔터티 필드 하나에 대응하는 컬럼
+type column struct {
+	name  string
+	index []int
+	json  bool // 슬라이스/맵/구조체는 JSON 텍스트로 저장
+}
+
+// entityMapping 엔터티 타입의 컬럼 매핑
+// 버전과 소프트 삭제 컬럼은 레포지토리가 직접 관리하므로 매핑에서 제외됩니다
+type entityMapping struct {
+	structType reflect.Type
+	columns    []column
+	byName     map[string]int
+	id         int // columns 안의 ID 컬럼 위치
+}
+
+// newEntityMapping 구조체 태그로 컬럼 매핑 생성
+//   - `db:"name"` 태그가 있으면 그 이름, 없으면 필드 이름의 snake_case
+//   - `db:"-"` 필드와 비공개 필드는 제외
+//   - 태그 없는 임베디드 구조체(conflux.BaseEntity 등)는 펼쳐서 매핑
+func newEntityMapping(entityType reflect.Type, idColumn string, managed ...string) (*entityMapping, error) {
+	for entityType.Kind() == reflect.Ptr {
+		entityType = entityType.Elem()
+	}
+	if entityType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("entity type %s is not a struct", entityType)
+	}
+
+	mapping := &entityMapping{
+		structType: entityType,
+		byName:     make(map[string]int),
+		id:         -1,
+	}
+	mapping.collect(entityType, nil, managed)
+
+	id, ok := mapping.byName[idColumn]
+	if !ok {
+		return nil, fmt.Errorf("entity type %s has no field for ID column %q", entityType, idColumn)
+	}
+	mapping.id = id
+	return mapping, nil
+}
+
+// collect 구조체 필드를 재귀적으로 컬럼에 추가
+func (m *entityMapping) collect(structType reflect.Type, parent []int, managed []string) {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		index := append(append([]int(nil), parent...), i)
+		tag := strings.Split(field.Tag.Get("db"), ",")[0]
+		if tag == "-" {
+			continue
+		}
+
+		if field.Anonymous && tag == "" && field.Type.Kind() == reflect.Struct && !isScalar(field.Type) {
+			m.collect(field.Type, index, managed)
+			continue
+		}
+
+		name := tag
+		if name == "" {
+			name = toSnakeCase(field.Name)
+		}
+		if containsString(managed, name) {
+			continue
+		}
+		if _, exists := m.byName[name]; exists {
+			continue // 바깥 필드가 우선
+		}
+
+		m.byName[name] = len(m.columns)
+		m.columns = append(m.columns, column{
+			name:  name,
+			index: index,
+			json:  !isScalar(field.Type),
+		})
+	}
+}
+
+// names 컬럼 이름 목록
+func (m *entityMapping) names() []string {
+	names := make([]string, len(m.columns))
+	for i, col := range m.columns {
+		names[i] = col.name
+	}
+	return names
+}
+
+// values 엔터티의 컬럼 값들 (JSON 컬럼은 직렬화)
+func (m *entityMapping) values(entity any) ([]any, error) {
+	v := reflect.Indirect(reflect.ValueOf(entity))
+
+	values := make([]any, len(m.columns))
+	for i, col := range m.columns {
+		field := v.FieldByIndex(col.index)
+		if !col.json {
+			values[i] = field.Interface()
+			continue
+		}
+
+		data, err := json.Marshal(field.Interface())
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode column %s: %w", col.name, err)
+		}
+		values[i] = string(data)
+	}
+	return values, nil
+}
+
+// scanTargets 엔터티 필드를 가리키는 Scan 대상들
+func (m *entityMapping) scanTargets(entity any) []any {
+	v := reflect.Indirect(reflect.ValueOf(entity))
+
+	targets := make([]any, len(m.columns))
+	for i, col := range m.columns {
+		field := v.FieldByIndex(col.index).Addr()
+		if col.json {
+			targets[i] = &jsonColumn{target: field.Interface()}
+		} else {
+			targets[i] = field.Interface()
+		}
+	}
+	return targets
+}
+
+// idValue 엔터티의 ID 컬럼 값
+func (m *entityMapping) idValue(entity any) any {
+	v := reflect.Indirect(reflect.ValueOf(entity))
+	return v.FieldByIndex(m.columns[m.id].index).Interface()
+}
+
+// jsonColumn JSON 텍스트 컬럼을 필드로 역직렬화하는 Scanner
+type jsonColumn struct {
+	target any
+}
+
+// Scan sql.Scanner 구현
+func (c *jsonColumn) Scan(src any) error {
+	var data []byte
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fmt.Errorf("unsupported JSON column value %T", src)
+	}
+	return json.Unmarshal(data, c.target)
+}
+
+// isScalar 드라이버가 직접 다룰 수 있는 타입인지
+func isScalar(t reflect.Type) bool {
+	if t == timeType || reflect.PointerTo(t).Implements(scannerType) || t.Implements(valuerType) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return isScalar(t.Elem())
+	case reflect.Slice:
+		return t.Elem().Kind() == reflect.Uint8
+	case reflect.Struct, reflect.Map, reflect.Array, reflect.Interface:
+		return false
+	default:
+		return true
+	}
+}
+
+// valuesEqual 컬럼 값 비교 (시각은 Equal, 바이트는 내용 비교)
+func valuesEqual(a, b any) bool {
+	switch av := a.(type) {
+	case time.Time:
+		bv, ok := b.(time.Time)
+		return ok && av.Equal(bv)
+	case *time.Time:
+		bv, ok := b.(*time.Time)
+		return ok && (av == nil) == (bv == nil) && (av == nil || av.Equal(*bv))
+	case []byte:
+		bv, ok := b.([]byte)
+		return ok && bytes.Equal(av, bv)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// toSnakeCase CamelCase를 snake_case로 변환 (약어는 한 단어로: UserID → user_id)
+func toSnakeCase(s string) string {
+	runes := []rune(s)
+
+	var sb strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prevLower := unicode.IsLower(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || (unicode.IsUpper(runes[i-1]) && nextLower) {
+				sb.WriteByte('_')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	return sb.String()
+}
+
+func containsString(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,838 @@
+package confluxsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/homveloper/dukdakit/conflux"
+)
+
+// ============================================================================
+// database/sql 기반 동시성 안전 레포지토리 구현
+// ============================================================================
+
+// 컴파일 타임 인터페이스 검사
+var _ conflux.FullRepository[any, *conflux.SQLFilter] = (*SQLRepository[any])(nil)
+
+// DB 레포지토리가 사용하는 *sql.DB 메서드 집합 (*sql.Tx도 만족합니다)
+type DB interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// SQLRepository database/sql 기반 낙관적 동시성 제어 레포지토리
+// SQLFilter의 Query는 WHERE 절 조각으로 사용되며 ?는 방언의 파라미터로 바뀝니다
+//
+// 테이블은 엔터티 필드에 대응하는 컬럼 외에 버전 컬럼(정수)과 소프트 삭제 시각 컬럼(NULL 허용)을 가져야 합니다
+// 업데이트는 바뀐 컬럼만 SET 하며 UPDATE ... WHERE id = ? AND version = ? 조건으로 적용됩니다
+// 다른 쓰기가 먼저 버전을 올렸다면 영향받은 행이 없어 버전 충돌로 처리됩니다
+//
+// 중복 검사와 삽입 사이의 경쟁을 막으려면 중복 검사 컬럼에 UNIQUE 제약을 만들어야 합니다
+// 삽입은 INSERT ... ON CONFLICT DO NOTHING으로 수행되어 제약 위반이 중복 결과가 됩니다
+// 제약과 충돌한 행이 소프트 삭제된 툼스톤이면 그 행을 새 엔터티로 되살립니다
+type SQLRepository[T any] struct {
+	db              DB
+	dialect         Dialect
+	table           string // 인용된 테이블 이름
+	idColumn        string
+	versionColumn   string
+	deletedAtColumn string
+	mapping         *entityMapping
+	newEntityFn     func() T
+	clock           conflux.Clock
+}
+
+// SQLRepositoryConfig SQL 레포지토리 설정
+type SQLRepositoryConfig struct {
+	Table           string        // 테이블 이름 (필수)
+	IDColumn        string        // 행을 식별하는 컬럼 (기본값: "id")
+	VersionColumn   string        // 버전 컬럼 (기본값: "version")
+	DeletedAtColumn string        // 소프트 삭제 시각 컬럼 (기본값: "deleted_at")
+	Dialect         Dialect       // SQL 방언 (기본값: SQLite)
+	Clock           conflux.Clock // 타임스탬프 기록용 시각 소스 (nil이면 시스템 시간)
+}
+
+// storedRow 저장소에서 읽은 행
+// values는 변경된 컬럼만 업데이트하기 위한 비교 기준입니다
+type storedRow[T any] struct {
+	entity  T
+	version int64
+	values  []any
+}
+
+// NewSQLRepository 새 SQL 레포지토리 생성
+// 엔터티 타입에 ID 컬럼에 대응하는 필드가 없으면 에러를 반환합니다
+func NewSQLRepository[T any](
+	db DB,
+	config *SQLRepositoryConfig,
+	newEntityFn func() T,
+) (*SQLRepository[T], error) {
+	if config == nil || config.Table == "" {
+		return nil, errors.New("SQL repository requires a table name")
+	}
+
+	idColumn := config.IDColumn
+	if idColumn == "" {
+		idColumn = "id"
+	}
+	versionColumn := config.VersionColumn
+	if versionColumn == "" {
+		versionColumn = "version"
+	}
+	deletedAtColumn := config.DeletedAtColumn
+	if deletedAtColumn == "" {
+		deletedAtColumn = "deleted_at"
+	}
+	dialect := config.Dialect
+	if dialect == nil {
+		dialect = SQLite
+	}
+
+	entityType := reflect.TypeOf((*T)(nil)).Elem()
+	if entityType.Kind() == reflect.Interface && newEntityFn != nil {
+		entityType = reflect.TypeOf(newEntityFn())
+	}
+	if entityType == nil {
+		return nil, errors.New("cannot determine entity type")
+	}
+
+	mapping, err := newEntityMapping(entityType, idColumn, versionColumn, deletedAtColumn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SQLRepository[T]{
+		db:              db,
+		dialect:         dialect,
+		table:           dialect.QuoteIdent(config.Table),
+		idColumn:        idColumn,
+		versionColumn:   versionColumn,
+		deletedAtColumn: deletedAtColumn,
+		mapping:         mapping,
+		newEntityFn:     newEntityFn,
+		clock:           conflux.ClockOrSystem(config.Clock),
+	}, nil
+}
+
+// ============================================================================
+// Repository 인터페이스 구현
+// ============================================================================
+
+// FindOneAndInsert 원자적 엔터티 생성
+// UNIQUE 제약 때문에 삽입되지 않으면 이미 생성된 엔터티를 중복 결과로 반환합니다
+// 충돌한 행이 툼스톤이면 되살린 버전으로 삽입 결과를 반환합니다 (reviveTombstone 참고)
+func (r *SQLRepository[T]) FindOneAndInsert(
+	ctx context.Context,
+	duplicateCheckFilter *conflux.SQLFilter,
+	createFunc conflux.CreateFunc[T],
+	options ...conflux.InsertOption,
+) (*conflux.InsertResult[T], error) {
+	// 필터 유효성 검증
+	if err := validateFilter(duplicateCheckFilter); err != nil {
+		return nil, err
+	}
+
+	// 중복 검사
+	if existing, found, err := r.findDuplicate(ctx, duplicateCheckFilter); err != nil || found {
+		return existing, err
+	}
+
+	// 새 엔터티 생성
+	newEntity, err := createFunc.CreateFn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create entity: %w", err)
+	}
+
+	inserted, err := r.insertEntity(ctx, newEntity)
+	if err != nil {
+		return nil, err
+	}
+	if !inserted {
+		// 검사 이후 다른 쓰기가 먼저 삽입함
+		if existing, found, err := r.findDuplicate(ctx, duplicateCheckFilter); err != nil || found {
+			return existing, err
+		}
+		version, err := r.reviveTombstone(ctx, duplicateCheckFilter, newEntity)
+		if err != nil {
+			return nil, err
+		}
+		if version > 0 {
+			return conflux.NewInsertResult(newEntity, version), nil
+		}
+		return nil, errors.New("failed to insert entity: conflicts with a row outside the duplicate check filter")
+	}
+
+	return conflux.NewInsertResult(newEntity, 1), nil
+}
+
+// FindOneAndUpsert 원자적 엔터티 생성/업데이트
+// 조회와 쓰기 사이에 다른 쓰기가 끼어들면 UpsertConfig.MaxRetries만큼 다시 시도합니다
+func (r *SQLRepository[T]) FindOneAndUpsert(
+	ctx context.Context,
+	lookupFilter *conflux.SQLFilter,
+	upsertFunc conflux.UpsertFunc[T],
+	options ...conflux.UpsertOption,
+) (*conflux.UpsertResult[T], error) {
+	// 옵션 처리
+	config := conflux.NewUpsertConfig()
+	for _, opt := range options {
+		opt(config)
+	}
+
+	// 필터 유효성 검증
+	if err := validateFilter(lookupFilter); err != nil {
+		return nil, err
+	}
+
+	attempts := max(config.MaxRetries, 0) + 1
+	for attempt := 0; attempt < attempts; attempt++ {
+		row, err := r.findRow(ctx, lookupFilter)
+		if err != nil && !errors.Is(err, conflux.ErrNotFound) {
+			return nil, err
+		}
+
+		if row == nil {
+			// 새로 생성
+			newEntity, err := upsertFunc.CreateFn(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create entity: %w", err)
+			}
+
+			inserted, err := r.insertEntity(ctx, newEntity)
+			if err != nil {
+				return nil, err
+			}
+			if !inserted {
+				// 툼스톤과 충돌했으면 되살리고, 아니면 동시에 생성된 것이므로 업데이트로 다시 시도
+				version, err := r.reviveTombstone(ctx, lookupFilter, newEntity)
+				if err != nil {
+					return nil, err
+				}
+				if version > 0 {
+					return conflux.NewUpsertResult(newEntity, true, version), nil
+				}
+				continue
+			}
+			return conflux.NewUpsertResult(newEntity, true, 1), nil
+		}
+
+		// 기존 엔터티 업데이트
+		updatedEntity, err := upsertFunc.UpdateFn(ctx, row.entity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update entity: %w", err)
+		}
+
+		applied, err := r.applyUpdate(ctx, row, updatedEntity, nil)
+		if err != nil {
+			return nil, err
+		}
+		if applied {
+			return conflux.NewUpsertResult(updatedEntity, false, row.version+1), nil
+		}
+	}
+
+	return nil, fmt.Errorf("failed to upsert entity: concurrent modification after %d attempts", attempts)
+}
+
+// FindOneAndUpdate 원자적 엔터티 업데이트
+// OverwriteOnConflict이면 현재 버전을 기준으로 덮어쓰고, 그 외에는 버전 충돌 결과를 반환합니다
+func (r *SQLRepository[T]) FindOneAndUpdate(
+	ctx context.Context,
+	lookupFilter *conflux.SQLFilter,
+	expectedVersion int64,
+	updateFunc conflux.UpdateFunc[T],
+	options ...conflux.UpdateOption,
+) (*conflux.UpdateResult[T], error) {
+	// 옵션 처리
+	config := conflux.NewUpdateConfig()
+	for _, opt := range options {
+		opt(config)
+	}
+
+	// 필터 유효성 검증
+	if err := validateFilter(lookupFilter); err != nil {
+		return nil, err
+	}
+
+	row, err := r.findRow(ctx, lookupFilter)
+	if errors.Is(err, conflux.ErrNotFound) {
+		return conflux.NewNotFoundResult[T](), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 버전 충돌 검사
+	if row.version != expectedVersion && config.OnConflict != conflux.OverwriteOnConflict {
+		return conflux.NewVersionConflictResult(row.entity, row.version), nil
+	}
+
+	updatedEntity, err := updateFunc.UpdateFn(ctx, row.entity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update entity: %w", err)
+	}
+
+	applied, err := r.applyUpdate(ctx, row, updatedEntity, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !applied {
+		return r.updateConflict(ctx, lookupFilter)
+	}
+
+	return conflux.NewUpdateResult(updatedEntity, row.version+1), nil
+}
+
+// FindOneAndDelete 원자적 엔터티 삭제
+// DELETE ... WHERE id = ? AND version = ? 조건으로 삭제하므로 확인과 삭제 사이에 다른 쓰기가 끼어들면 버전 충돌로 처리됩니다
+// 소프트 삭제 시 deleted_at이 기록되고 버전이 증가하며, 툼스톤은 이후 조회에서 제외됩니다
+func (r *SQLRepository[T]) FindOneAndDelete(
+	ctx context.Context,
+	lookupFilter *conflux.SQLFilter,
+	expectedVersion int64,
+	options ...conflux.DeleteOption,
+) (*conflux.DeleteResult[T], error) {
+	// 옵션 처리
+	config := conflux.NewDeleteConfig()
+	for _, opt := range options {
+		opt(config)
+	}
+
+	// 필터 유효성 검증
+	if err := validateFilter(lookupFilter); err != nil {
+		return nil, err
+	}
+
+	row, err := r.findRow(ctx, lookupFilter)
+	if errors.Is(err, conflux.ErrNotFound) {
+		return conflux.NewDeleteNotFoundResult[T](), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 버전 충돌 검사
+	if row.version != expectedVersion {
+		return conflux.NewDeleteConflictResult(row.entity, row.version), nil
+	}
+
+	now := r.clock.Now()
+
+	if !config.Soft {
+		b := newQueryBuilder(r.dialect)
+		b.write("DELETE FROM " + r.table)
+		r.versionedWhere(b, row)
+
+		applied, err := r.exec(ctx, b)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete entity: %w", err)
+		}
+		if !applied {
+			return r.deleteConflict(ctx, lookupFilter)
+		}
+		return conflux.NewDeleteResult(row.entity, row.version, now, false), nil
+	}
+
+	// 툼스톤: deleted-at 기록 및 버전 증가
+	if config.TombstoneUpdate != nil {
+		config.TombstoneUpdate(row.entity)
+	}
+	if deletable, ok := any(row.entity).(conflux.SoftDeletable); ok {
+		deletedAt := now
+		deletable.SetDeletedAt(&deletedAt)
+	}
+
+	applied, err := r.applyUpdate(ctx, row, row.entity, &now)
+	if err != nil {
+		return nil, err
+	}
+	if !applied {
+		return r.deleteConflict(ctx, lookupFilter)
+	}
+
+	return conflux.NewDeleteResult(row.entity, row.version+1, now, true), nil
+}
+
+// NewEntity 새 엔터티 인스턴스 생성
+func (r *SQLRepository[T]) NewEntity() T {
+	if r.newEntityFn != nil {
+		return r.newEntityFn()
+	}
+
+	var entity T
+	return entity
+}
+
+// ============================================================================
+// ReadRepository 인터페이스 구현
+// ============================================================================
+
+// FindOne 필터 조건으로 엔터티 조회
+func (r *SQLRepository[T]) FindOne(ctx context.Context, filter *conflux.SQLFilter) (T, error) {
+	var empty T
+
+	row, err := r.findRow(ctx, filter)
+	if err != nil {
+		return empty, err
+	}
+	return row.entity, nil
+}
+
+// Exists 엔터티 존재 여부 확인
+func (r *SQLRepository[T]) Exists(ctx context.Context, filter *conflux.SQLFilter) (bool, error) {
+	b := newQueryBuilder(r.dialect)
+	b.write("SELECT 1 FROM " + r.table)
+	if err := r.liveWhere(b, filter); err != nil {
+		return false, err
+	}
+	b.write(r.dialect.LimitOffset(1, 0))
+
+	var one int
+	err := r.db.QueryRowContext(ctx, b.String(), b.args...).Scan(&one)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check existence: %w", err)
+	}
+	return true, nil
+}
+
+// GetVersion 엔터티의 현재 버전 조회
+func (r *SQLRepository[T]) GetVersion(ctx context.Context, filter *conflux.SQLFilter) (int64, error) {
+	b := newQueryBuilder(r.dialect)
+	b.write("SELECT ")
+	b.ident(r.versionColumn)
+	b.write(" FROM " + r.table)
+	if err := r.liveWhere(b, filter); err != nil {
+		return 0, err
+	}
+	b.write(r.dialect.LimitOffset(1, 0))
+
+	var version int64
+	err := r.db.QueryRowContext(ctx, b.String(), b.args...).Scan(&version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, conflux.ErrNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get version: %w", err)
+	}
+	return version, nil
+}
+
+// ============================================================================
+// BatchRepository 인터페이스 구현
+// ============================================================================
+
+// FindMany 필터 조건으로 여러 엔터티들 조회
+func (r *SQLRepository[T]) FindMany(ctx context.Context, filter *conflux.SQLFilter, limit int) ([]T, error) {
+	return r.find(ctx, filter, "", limit, 0)
+}
+
+// InsertMany 여러 엔터티를 배치 삽입
+// 각 항목은 FindOneAndInsert와 같은 규칙으로 처리되며, 중복은 결과의 Duplicate로 구분됩니다
+func (r *SQLRepository[T]) InsertMany(
+	ctx context.Context,
+	duplicateCheckFilters []*conflux.SQLFilter,
+	createFuncs []conflux.CreateFunc[T],
+) ([]*conflux.InsertResult[T], error) {
+	if len(duplicateCheckFilters) != len(createFuncs) {
+		return nil, fmt.Errorf("filters and create functions length mismatch: %d != %d", len(duplicateCheckFilters), len(createFuncs))
+	}
+
+	results := make([]*conflux.InsertResult[T], 0, len(createFuncs))
+	for i, createFunc := range createFuncs {
+		result, err := r.FindOneAndInsert(ctx, duplicateCheckFilters[i], createFunc)
+		if err != nil {
+			return results, fmt.Errorf("failed to insert entity %d: %w", i, err)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// ============================================================================
+// QueryRepository 인터페이스 구현
+// ============================================================================
+
+// FindByFilter 필터 조건으로 엔터티들을 페이지 단위로 조회
+func (r *SQLRepository[T]) FindByFilter(ctx context.Context, filter *conflux.SQLFilter, limit int, offset int) ([]T, error) {
+	return r.find(ctx, filter, "", limit, offset)
+}
+
+// CountByFilter 필터 조건에 맞는 엔터티 개수 반환
+func (r *SQLRepository[T]) CountByFilter(ctx context.Context, filter *conflux.SQLFilter) (int64, error) {
+	b := newQueryBuilder(r.dialect)
+	b.write("SELECT COUNT(*) FROM " + r.table)
+	if err := r.liveWhere(b, filter); err != nil {
+		return 0, err
+	}
+
+	var count int64
+	if err := r.db.QueryRowContext(ctx, b.String(), b.args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count entities: %w", err)
+	}
+	return count, nil
+}
+
+// FindWithSort 정렬 컬럼 기준으로 엔터티들 조회
+// sortBy는 매핑된 컬럼이나 버전 컬럼이어야 합니다
+func (r *SQLRepository[T]) FindWithSort(ctx context.Context, filter *conflux.SQLFilter, sortBy string, ascending bool, limit int) ([]T, error) {
+	if _, ok := r.mapping.byName[sortBy]; !ok && sortBy != r.versionColumn {
+		return nil, fmt.Errorf("unknown sort column %q", sortBy)
+	}
+
+	orderBy := r.dialect.QuoteIdent(sortBy) + " DESC"
+	if ascending {
+		orderBy = r.dialect.QuoteIdent(sortBy) + " ASC"
+	}
+	return r.find(ctx, filter, orderBy, limit, 0)
+}
+
+// ============================================================================
+// SQL 전용 헬퍼 메서드들
+// ============================================================================
+
+// validateFilter 쓰기 연산의 필터는 비어 있을 수 없음
+func validateFilter(filter *conflux.SQLFilter) error {
+	if filter == nil {
+		return errors.New("invalid filter: SQL filter cannot be nil")
+	}
+	if err := filter.Validate(); err != nil {
+		return fmt.Errorf("invalid filter: %w", err)
+	}
+	return nil
+}
+
+// liveWhere 소프트 삭제된 행을 제외하는 WHERE 절 (nil이나 빈 필터는 모든 행)
+func (r *SQLRepository[T]) liveWhere(b *queryBuilder, filter *conflux.SQLFilter) error {
+	b.write(" WHERE ")
+	b.ident(r.deletedAtColumn)
+	b.write(" IS NULL")
+
+	if filter == nil || strings.TrimSpace(filter.Query) == "" {
+		return nil
+	}
+
+	b.write(" AND (")
+	if err := b.filter(filter); err != nil {
+		return fmt.Errorf("invalid filter: %w", err)
+	}
+	b.write(")")
+	return nil
+}
+
+// versionedWhere ID와 버전이 모두 일치해야 하는 조건부 쓰기 WHERE 절
+func (r *SQLRepository[T]) versionedWhere(b *queryBuilder, row *storedRow[T]) {
+	b.write(" WHERE ")
+	b.ident(r.idColumn)
+	b.write(" = ")
+	b.arg(row.values[r.mapping.id])
+	b.write(" AND ")
+	b.ident(r.versionColumn)
+	b.write(" = ")
+	b.arg(row.version)
+}
+
+// selectColumns 매핑된 컬럼과 버전 컬럼을 읽는 SELECT 절
+func (r *SQLRepository[T]) selectColumns(b *queryBuilder) {
+	b.write("SELECT ")
+	for _, name := range r.mapping.names() {
+		b.ident(name)
+		b.write(", ")
+	}
+	b.ident(r.versionColumn)
+	b.write(" FROM " + r.table)
+}
+
+// findRow 필터에 맞는 살아 있는 행 하나 조회
+func (r *SQLRepository[T]) findRow(ctx context.Context, filter *conflux.SQLFilter) (*storedRow[T], error) {
+	b := newQueryBuilder(r.dialect)
+	r.selectColumns(b)
+	if err := r.liveWhere(b, filter); err != nil {
+		return nil, err
+	}
+	b.write(r.dialect.LimitOffset(1, 0))
+
+	row, err := r.scanRow(r.db.QueryRowContext(ctx, b.String(), b.args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, conflux.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find entity: %w", err)
+	}
+	return row, nil
+}
+
+// find 필터에 맞는 살아 있는 행들 조회
+func (r *SQLRepository[T]) find(ctx context.Context, filter *conflux.SQLFilter, orderBy string, limit, offset int) ([]T, error) {
+	b := newQueryBuilder(r.dialect)
+	r.selectColumns(b)
+	if err := r.liveWhere(b, filter); err != nil {
+		return nil, err
+	}
+	if orderBy != "" {
+		b.write(" ORDER BY " + orderBy)
+	}
+	b.write(r.dialect.LimitOffset(limit, offset))
+
+	rows, err := r.db.QueryContext(ctx, b.String(), b.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find entities: %w", err)
+	}
+	defer rows.Close()
+
+	var entities []T
+	for rows.Next() {
+		row, err := r.scanRow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan entity: %w", err)
+		}
+		entities = append(entities, row.entity)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate entities: %w", err)
+	}
+	return entities, nil
+}
+
+// scanRow 행을 엔터티로 읽고 비교용 컬럼 값을 함께 보관
+func (r *SQLRepository[T]) scanRow(scanner interface{ Scan(dest ...any) error }) (*storedRow[T], error) {
+	entity := r.NewEntity()
+
+	// 포인터 엔터티는 그대로, 값 엔터티는 주소를 통해 채움
+	target := any(&entity)
+	if value := reflect.ValueOf(entity); value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			entity = reflect.New(r.mapping.structType).Interface().(T)
+		}
+		target = entity
+	}
+
+	var version int64
+	if err := scanner.Scan(append(r.mapping.scanTargets(target), &version)...); err != nil {
+		return nil, err
+	}
+	setEntityVersion(entity, version)
+
+	values, err := r.mapping.values(target)
+	if err != nil {
+		return nil, err
+	}
+	return &storedRow[T]{entity: entity, version: version, values: values}, nil
+}
+
+// findDuplicate 중복 검사 필터에 맞는 엔터티가 있으면 중복 결과 반환
+func (r *SQLRepository[T]) findDuplicate(ctx context.Context, filter *conflux.SQLFilter) (*conflux.InsertResult[T], bool, error) {
+	row, err := r.findRow(ctx, filter)
+	if errors.Is(err, conflux.ErrNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to check duplicate: %w", err)
+	}
+	return conflux.NewDuplicateInsertResult(row.entity, row.version), true, nil
+}
+
+// insertEntity 버전 1과 생성 시각을 기록하고 INSERT ... ON CONFLICT DO NOTHING 실행
+// 반환값: 삽입되었는지 여부 (false면 UNIQUE 제약과 충돌)
+func (r *SQLRepository[T]) insertEntity(ctx context.Context, entity T) (bool, error) {
+	now := r.clock.Now()
+	setEntityVersion(entity, 1)
+	if timestamped, ok := any(entity).(conflux.Timestamped); ok {
+		timestamped.SetCreatedAt(now)
+		timestamped.SetUpdatedAt(now)
+	}
+
+	values, err := r.mapping.values(entity)
+	if err != nil {
+		return false, err
+	}
+	values = append(values, int64(1))
+
+	names := append(r.mapping.names(), r.versionColumn)
+	columns := make([]string, len(names))
+	placeholders := make([]string, len(names))
+	for i, name := range names {
+		columns[i] = r.dialect.QuoteIdent(name)
+		placeholders[i] = r.dialect.Placeholder(i + 1)
+	}
+
+	result, err := r.db.ExecContext(ctx, r.dialect.InsertIgnore(r.table, columns, placeholders), values...)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert entity: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to insert entity: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// applyUpdate 저장된 행과 새 엔터티의 차이를 UPDATE ... WHERE id = ? AND version = ? 로 적용
+// deletedAt이 nil이 아니면 툼스톤으로 기록합니다
+// 반환값: 조건이 맞아 적용되었는지 여부 (false면 다른 쓰기가 먼저 버전을 올림)
+func (r *SQLRepository[T]) applyUpdate(ctx context.Context, row *storedRow[T], entity T, deletedAt *time.Time) (bool, error) {
+	newVersion := row.version + 1
+	setEntityVersion(entity, newVersion)
+	if timestamped, ok := any(entity).(conflux.Timestamped); ok {
+		timestamped.SetUpdatedAt(r.clock.Now())
+	}
+
+	values, err := r.mapping.values(entity)
+	if err != nil {
+		return false, err
+	}
+	if !valuesEqual(row.values[r.mapping.id], values[r.mapping.id]) {
+		return false, fmt.Errorf("failed to update entity: %s column cannot be changed", r.idColumn)
+	}
+
+	b := newQueryBuilder(r.dialect)
+	b.write("UPDATE " + r.table + " SET ")
+	b.ident(r.versionColumn)
+	b.write(" = ")
+	b.arg(newVersion)
+	for i, col := range r.mapping.columns {
+		if valuesEqual(row.values[i], values[i]) {
+			continue
+		}
+		b.write(", ")
+		b.ident(col.name)
+		b.write(" = ")
+		b.arg(values[i])
+	}
+	if deletedAt != nil {
+		b.write(", ")
+		b.ident(r.deletedAtColumn)
+		b.write(" = ")
+		b.arg(*deletedAt)
+	}
+	r.versionedWhere(b, row)
+
+	applied, err := r.exec(ctx, b)
+	if err != nil {
+		return false, fmt.Errorf("failed to update entity: %w", err)
+	}
+	return applied, nil
+}
+
+// reviveTombstone 삽입을 막은 툼스톤을 새 엔터티로 되살림
+// 툼스톤은 조회와 중복 검사에서 제외되지만 UNIQUE 제약에는 남아 있어, 그대로 두면 같은 키로 다시 만들 수 없습니다
+// 필터나 새 엔터티의 ID에 맞는 툼스톤을 UPDATE ... SET ..., deleted_at = NULL WHERE id = ? AND version = ? 로 덮어씁니다
+// 버전은 툼스톤 버전 + 1이므로 삭제 전 버전을 들고 있던 쓰기는 버전 충돌이 됩니다
+// 반환값: 되살린 엔터티의 버전 (툼스톤이 없거나 다른 쓰기가 먼저 바꿨으면 0)
+func (r *SQLRepository[T]) reviveTombstone(ctx context.Context, filter *conflux.SQLFilter, entity T) (int64, error) {
+	values, err := r.mapping.values(entity)
+	if err != nil {
+		return 0, err
+	}
+
+	b := newQueryBuilder(r.dialect)
+	r.selectColumns(b)
+	b.write(" WHERE ")
+	b.ident(r.deletedAtColumn)
+	b.write(" IS NOT NULL AND ((")
+	if err := b.filter(filter); err != nil {
+		return 0, fmt.Errorf("invalid filter: %w", err)
+	}
+	b.write(") OR ")
+	b.ident(r.idColumn)
+	b.write(" = ")
+	b.arg(values[r.mapping.id])
+	b.write(")")
+	b.write(r.dialect.LimitOffset(1, 0))
+
+	row, err := r.scanRow(r.db.QueryRowContext(ctx, b.String(), b.args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to find tombstone: %w", err)
+	}
+
+	newVersion := row.version + 1
+	setEntityVersion(entity, newVersion)
+	if timestamped, ok := any(entity).(conflux.Timestamped); ok {
+		now := r.clock.Now()
+		timestamped.SetCreatedAt(now)
+		timestamped.SetUpdatedAt(now)
+	}
+	if values, err = r.mapping.values(entity); err != nil {
+		return 0, err
+	}
+
+	u := newQueryBuilder(r.dialect)
+	u.write("UPDATE " + r.table + " SET ")
+	u.ident(r.versionColumn)
+	u.write(" = ")
+	u.arg(newVersion)
+	for i, col := range r.mapping.columns {
+		u.write(", ")
+		u.ident(col.name)
+		u.write(" = ")
+		u.arg(values[i])
+	}
+	u.write(", ")
+	u.ident(r.deletedAtColumn)
+	u.write(" = NULL")
+	r.versionedWhere(u, row)
+
+	applied, err := r.exec(ctx, u)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revive entity: %w", err)
+	}
+	if !applied {
+		return 0, nil
+	}
+	return newVersion, nil
+}
+
+// exec 쓰기 실행 후 영향받은 행이 있는지 반환
+func (r *SQLRepository[T]) exec(ctx context.Context, b *queryBuilder) (bool, error) {
+	result, err := r.db.ExecContext(ctx, b.String(), b.args...)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// updateConflict 조건부 업데이트가 적용되지 않은 뒤 현재 상태로 결과 생성
+func (r *SQLRepository[T]) updateConflict(ctx context.Context, filter *conflux.SQLFilter) (*conflux.UpdateResult[T], error) {
+	row, err := r.findRow(ctx, filter)
+	if errors.Is(err, conflux.ErrNotFound) {
+		return conflux.NewNotFoundResult[T](), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return conflux.NewVersionConflictResult(row.entity, row.version), nil
+}
+
+// deleteConflict 조건부 삭제가 적용되지 않은 뒤 현재 상태로 결과 생성
+func (r *SQLRepository[T]) deleteConflict(ctx context.Context, filter *conflux.SQLFilter) (*conflux.DeleteResult[T], error) {
+	row, err := r.findRow(ctx, filter)
+	if errors.Is(err, conflux.ErrNotFound) {
+		return conflux.NewDeleteNotFoundResult[T](), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return conflux.NewDeleteConflictResult(row.entity, row.version), nil
+}
+
+// setEntityVersion Versioned 엔터티에 버전 기록
+func setEntityVersion[T any](entity T, version int64) {
+	if versioned, ok := any(entity).(conflux.Versioned); ok {
+		versioned.SetVersion(version)
+	}
+}
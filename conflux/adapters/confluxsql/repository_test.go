@@ -0,0 +1,503 @@
+package confluxsql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/homveloper/dukdakit/conflux"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// SQLite fixture
+// ============================================================================
+
+const purchaseSchema = `
+CREATE TABLE purchases (
+	id         TEXT PRIMARY KEY,
+	player_id  TEXT NOT NULL,
+	sku        TEXT NOT NULL,
+	amount     INTEGER NOT NULL,
+	tags       TEXT NOT NULL,
+	version    INTEGER NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	deleted_at DATETIME,
+	UNIQUE (player_id, sku)
+)`
+
+// recordingDB records UPDATE/DELETE statements and can run a hook before the next one
+type recordingDB struct {
+	*sql.DB
+	writes      []string
+	beforeWrite func() // Runs once before the next UPDATE/DELETE to simulate a concurrent writer
+}
+
+func (db *recordingDB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	if !strings.HasPrefix(query, "INSERT") {
+		if hook := db.beforeWrite; hook != nil {
+			db.beforeWrite = nil
+			hook()
+		}
+		db.writes = append(db.writes, query)
+	}
+	return db.DB.ExecContext(ctx, query, args...)
+}
+
+type Purchase struct {
+	conflux.BaseEntity
+	ID       string
+	PlayerID string
+	SKU      string `db:"sku"`
+	Amount   int
+	Tags     []string
+	Note     string `db:"-"`
+}
+
+type fixedClock struct{ now time.Time }
+
+func (c fixedClock) Now() time.Time { return c.now }
+
+var testNow = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newRepository(t *testing.T) (*SQLRepository[*Purchase], *recordingDB) {
+	sqlDB, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1) // 인메모리 DB는 커넥션마다 따로 생김
+	t.Cleanup(func() { sqlDB.Close() })
+
+	_, err = sqlDB.Exec(purchaseSchema)
+	require.NoError(t, err)
+
+	db := &recordingDB{DB: sqlDB}
+	repo, err := NewSQLRepository[*Purchase](db, &SQLRepositoryConfig{Table: "purchases", Clock: fixedClock{now: testNow}},
+		func() *Purchase { return &Purchase{} })
+	require.NoError(t, err)
+	return repo, db
+}
+
+func byID(id string) *conflux.SQLFilter {
+	return conflux.NewSQLFilter("id = ?", id)
+}
+
+func bySKU(playerID, sku string) *conflux.SQLFilter {
+	return conflux.NewSQLFilter("player_id = ?", playerID).And("sku = ?", sku)
+}
+
+func createPurchase(id, sku string, amount int) conflux.CreateFunc[*Purchase] {
+	return conflux.NewCreateFunc(func(ctx context.Context) (*Purchase, error) {
+		return &Purchase{ID: id, PlayerID: "alice", SKU: sku, Amount: amount, Tags: []string{"store"}}, nil
+	})
+}
+
+func addAmount(ctx context.Context, existing *Purchase) (*Purchase, error) {
+	existing.Amount++
+	return existing, nil
+}
+
+func seedPurchases(t *testing.T, repo *SQLRepository[*Purchase], amounts ...int) {
+	for i, amount := range amounts {
+		id := fmt.Sprintf("p%d", i+1)
+		_, err := repo.FindOneAndInsert(context.Background(), byID(id), createPurchase(id, "sku-"+id, amount))
+		require.NoError(t, err)
+	}
+}
+
+// ============================================================================
+// Repository tests
+// ============================================================================
+
+func TestSQLRepository_InsertAndDuplicate(t *testing.T) {
+	repo, db := newRepository(t)
+	ctx := context.Background()
+
+	result, err := repo.FindOneAndInsert(ctx, bySKU("alice", "gem-pack"), createPurchase("p1", "gem-pack", 100))
+	require.NoError(t, err)
+	assert.True(t, result.IsSuccess())
+	assert.Equal(t, int64(1), result.Version)
+
+	var version int64
+	var tags string
+	require.NoError(t, db.QueryRow(`SELECT version, tags FROM purchases WHERE id = 'p1'`).Scan(&version, &tags))
+	assert.Equal(t, int64(1), version)
+	assert.Equal(t, `["store"]`, tags)
+
+	duplicate, err := repo.FindOneAndInsert(ctx, bySKU("alice", "gem-pack"), createPurchase("p2", "gem-pack", 500))
+	require.NoError(t, err)
+	assert.True(t, duplicate.IsDuplicate())
+	assert.Equal(t, "p1", duplicate.Entity.ID)
+	assert.Equal(t, 100, duplicate.Entity.Amount)
+	assert.Equal(t, []string{"store"}, duplicate.Entity.Tags)
+	assert.True(t, testNow.Equal(duplicate.Entity.CreatedAt))
+}
+
+func TestSQLRepository_InsertRaceReturnsDuplicate(t *testing.T) {
+	// UNIQUE 제약이 중복 검사 이후에 끼어든 삽입을 막음
+	repo, db := newRepository(t)
+	ctx := context.Background()
+
+	racing := conflux.NewCreateFunc(func(ctx context.Context) (*Purchase, error) {
+		_, err := repo.FindOneAndInsert(ctx, bySKU("alice", "gem-pack"), createPurchase("p1", "gem-pack", 9))
+		require.NoError(t, err)
+		return &Purchase{ID: "p2", PlayerID: "alice", SKU: "gem-pack", Amount: 1}, nil
+	})
+
+	result, err := repo.FindOneAndInsert(ctx, bySKU("alice", "gem-pack"), racing)
+	require.NoError(t, err)
+	assert.True(t, result.IsDuplicate())
+	assert.Equal(t, 9, result.Entity.Amount)
+
+	var count int
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM purchases`).Scan(&count))
+	assert.Equal(t, 1, count)
+}
+
+func TestSQLRepository_UpdateSetsChangedColumnsOnly(t *testing.T) {
+	repo, db := newRepository(t)
+	ctx := context.Background()
+	seedPurchases(t, repo, 1)
+
+	result, err := repo.FindOneAndUpdate(ctx, byID("p1"), 1, conflux.NewUpdateFunc(func(ctx context.Context, existing *Purchase) (*Purchase, error) {
+		existing.Amount = 2
+		existing.Tags = append(existing.Tags, "refunded")
+		return existing, nil
+	}))
+	require.NoError(t, err)
+	require.True(t, result.IsSuccess())
+	assert.Equal(t, int64(2), result.Version)
+
+	// 고정 시계라 updated_at은 바뀌지 않음
+	require.Len(t, db.writes, 1)
+	assert.Equal(t, `UPDATE "purchases" SET "version" = ?, "amount" = ?, "tags" = ? WHERE "id" = ? AND "version" = ?`, db.writes[0])
+
+	stored, err := repo.FindOne(ctx, byID("p1"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, stored.Amount)
+	assert.Equal(t, []string{"store", "refunded"}, stored.Tags)
+	assert.Equal(t, int64(2), stored.Version)
+
+	_, err = repo.FindOneAndUpdate(ctx, byID("p1"), 2, conflux.NewUpdateFunc(func(ctx context.Context, existing *Purchase) (*Purchase, error) {
+		existing.ID = "p9"
+		return existing, nil
+	}))
+	assert.Error(t, err)
+}
+
+func TestSQLRepository_UpdateVersionConflict(t *testing.T) {
+	repo, db := newRepository(t)
+	ctx := context.Background()
+	seedPurchases(t, repo, 1)
+
+	// 기대 버전이 다르면 쓰지 않음
+	stale, err := repo.FindOneAndUpdate(ctx, byID("p1"), 7, conflux.NewUpdateFunc(addAmount))
+	require.NoError(t, err)
+	assert.True(t, stale.HasVersionConflict())
+	assert.Equal(t, int64(1), stale.Version)
+
+	// 읽은 뒤 다른 쓰기가 먼저 버전을 올리면 WHERE id = ? AND version = ? 조건이 맞지 않음
+	db.beforeWrite = func() {
+		_, err := repo.FindOneAndUpdate(ctx, byID("p1"), 1, conflux.NewUpdateFunc(addAmount))
+		require.NoError(t, err)
+	}
+	raced, err := repo.FindOneAndUpdate(ctx, byID("p1"), 1, conflux.NewUpdateFunc(addAmount))
+	require.NoError(t, err)
+	assert.True(t, raced.HasVersionConflict())
+	assert.Equal(t, int64(2), raced.Version)
+	assert.Equal(t, 2, raced.Entity.Amount)
+
+	// 충돌을 무시하고 현재 버전 위에 덮어쓰기
+	overwritten, err := repo.FindOneAndUpdate(ctx, byID("p1"), 1, conflux.NewUpdateFunc(addAmount),
+		conflux.WithUpdateConflictStrategy(conflux.OverwriteOnConflict))
+	require.NoError(t, err)
+	assert.True(t, overwritten.IsSuccess())
+	assert.Equal(t, int64(3), overwritten.Version)
+
+	missing, err := repo.FindOneAndUpdate(ctx, byID("ghost"), 1, conflux.NewUpdateFunc(addAmount))
+	require.NoError(t, err)
+	assert.True(t, missing.IsNotFound())
+}
+
+func TestSQLRepository_UpsertRetriesOnRace(t *testing.T) {
+	repo, db := newRepository(t)
+	ctx := context.Background()
+	upsert := conflux.NewUpsertFunc(createPurchase("p1", "gem-pack", 1).CreateFn, addAmount)
+
+	created, err := repo.FindOneAndUpsert(ctx, byID("p1"), upsert)
+	require.NoError(t, err)
+	assert.True(t, created.Created)
+
+	db.beforeWrite = func() {
+		_, err := repo.FindOneAndUpdate(ctx, byID("p1"), 1, conflux.NewUpdateFunc(addAmount))
+		require.NoError(t, err)
+	}
+	updated, err := repo.FindOneAndUpsert(ctx, byID("p1"), upsert)
+	require.NoError(t, err)
+	assert.False(t, updated.Created)
+	assert.Equal(t, int64(3), updated.Version)
+	assert.Equal(t, 3, updated.Entity.Amount)
+}
+
+func TestSQLRepository_UpsertCreateRaceFallsBackToUpdate(t *testing.T) {
+	repo, _ := newRepository(t)
+	ctx := context.Background()
+
+	// 조회 이후 다른 쓰기가 먼저 생성하면 ON CONFLICT DO NOTHING으로 삽입되지 않고 업데이트로 재시도
+	racing := conflux.NewUpsertFunc(func(ctx context.Context) (*Purchase, error) {
+		_, err := repo.FindOneAndInsert(ctx, byID("p1"), createPurchase("p1", "gem-pack", 10))
+		require.NoError(t, err)
+		return &Purchase{ID: "p1", PlayerID: "alice", SKU: "gem-pack", Amount: 1}, nil
+	}, addAmount)
+
+	result, err := repo.FindOneAndUpsert(ctx, byID("p1"), racing)
+	require.NoError(t, err)
+	assert.False(t, result.Created)
+	assert.Equal(t, int64(2), result.Version)
+	assert.Equal(t, 11, result.Entity.Amount)
+}
+
+func TestSQLRepository_Delete(t *testing.T) {
+	repo, db := newRepository(t)
+	ctx := context.Background()
+	seedPurchases(t, repo, 1, 2)
+
+	conflict, err := repo.FindOneAndDelete(ctx, byID("p1"), 3)
+	require.NoError(t, err)
+	assert.True(t, conflict.HasVersionConflict())
+
+	// 소프트 삭제: 툼스톤은 남지만 조회에서 제외됨
+	soft, err := repo.FindOneAndDelete(ctx, byID("p1"), 1, conflux.WithSoftDelete())
+	require.NoError(t, err)
+	assert.True(t, soft.IsSoftDeleted())
+	assert.Equal(t, int64(2), soft.Version)
+	require.NotNil(t, soft.Entity.DeletedAt)
+
+	var deletedAt time.Time
+	require.NoError(t, db.QueryRow(`SELECT deleted_at FROM purchases WHERE id = 'p1'`).Scan(&deletedAt))
+	assert.True(t, testNow.Equal(deletedAt))
+
+	_, err = repo.FindOne(ctx, byID("p1"))
+	assert.ErrorIs(t, err, conflux.ErrNotFound)
+	count, err := repo.CountByFilter(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// 하드 삭제
+	hard, err := repo.FindOneAndDelete(ctx, byID("p2"), 1)
+	require.NoError(t, err)
+	assert.True(t, hard.IsSuccess())
+	assert.Equal(t, `DELETE FROM "purchases" WHERE "id" = ? AND "version" = ?`, db.writes[len(db.writes)-1])
+
+	missing, err := repo.FindOneAndDelete(ctx, byID("p2"), 1)
+	require.NoError(t, err)
+	assert.True(t, missing.IsNotFound())
+}
+
+func TestSQLRepository_InsertRevivesTombstone(t *testing.T) {
+	// 툼스톤도 UNIQUE 제약에는 남아 있으므로 ON CONFLICT DO NOTHING에 묻히지 않고 되살려야 함
+	repo, db := newRepository(t)
+	ctx := context.Background()
+	seedPurchases(t, repo, 1)
+
+	_, err := repo.FindOneAndDelete(ctx, byID("p1"), 1, conflux.WithSoftDelete())
+	require.NoError(t, err)
+
+	// 같은 ID로 다시 삽입
+	revived, err := repo.FindOneAndInsert(ctx, byID("p1"), createPurchase("p1", "sku-p1", 7))
+	require.NoError(t, err)
+	require.True(t, revived.IsSuccess())
+	assert.Equal(t, int64(3), revived.Version)
+
+	stored, err := repo.FindOne(ctx, byID("p1"))
+	require.NoError(t, err)
+	assert.Equal(t, 7, stored.Amount)
+	assert.Equal(t, int64(3), stored.Version)
+	assert.Nil(t, stored.DeletedAt)
+
+	// 다른 ID지만 같은 (player_id, sku)로 다시 삽입하면 툼스톤 행이 새 ID를 가짐
+	_, err = repo.FindOneAndDelete(ctx, byID("p1"), 3, conflux.WithSoftDelete())
+	require.NoError(t, err)
+	replaced, err := repo.FindOneAndInsert(ctx, bySKU("alice", "sku-p1"), createPurchase("p9", "sku-p1", 9))
+	require.NoError(t, err)
+	require.True(t, replaced.IsSuccess())
+	assert.Equal(t, int64(5), replaced.Version)
+
+	var rows int
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM purchases WHERE deleted_at IS NULL AND id = 'p9'`).Scan(&rows))
+	assert.Equal(t, 1, rows)
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM purchases`).Scan(&rows))
+	assert.Equal(t, 1, rows)
+
+	// 업서트도 툼스톤을 생성으로 되살림
+	_, err = repo.FindOneAndDelete(ctx, byID("p9"), 5, conflux.WithSoftDelete())
+	require.NoError(t, err)
+	upserted, err := repo.FindOneAndUpsert(ctx, byID("p9"), conflux.NewUpsertFunc(createPurchase("p9", "sku-p1", 2).CreateFn, addAmount))
+	require.NoError(t, err)
+	assert.True(t, upserted.WasCreated())
+	assert.Equal(t, int64(7), upserted.Version)
+	assert.Equal(t, 2, upserted.Entity.Amount)
+}
+
+func TestSQLRepository_Queries(t *testing.T) {
+	repo, _ := newRepository(t)
+	ctx := context.Background()
+	seedPurchases(t, repo, 5, 3, 9, 1)
+
+	version, err := repo.GetVersion(ctx, byID("p3"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), version)
+
+	_, err = repo.GetVersion(ctx, byID("ghost"))
+	assert.ErrorIs(t, err, conflux.ErrNotFound)
+
+	exists, err := repo.Exists(ctx, byID("p4"))
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	sorted, err := repo.FindWithSort(ctx, nil, "amount", false, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []int{9, 5, 3}, amounts(sorted))
+
+	_, err = repo.FindWithSort(ctx, nil, "amount; DROP TABLE purchases", true, 0)
+	assert.Error(t, err)
+
+	page, err := repo.FindByFilter(ctx, conflux.NewSQLFilter("player_id = ?", "alice"), 2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []int{3, 9}, amounts(page))
+
+	// 오프셋만 지정해도 동작
+	rest, err := repo.FindByFilter(ctx, conflux.NewSQLFilter("player_id = ?", "alice"), 0, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1}, amounts(rest))
+
+	// 슬라이스 인자는 IN 목록으로 펼쳐짐
+	many, err := repo.FindMany(ctx, conflux.NewSQLFilter("id IN ? AND amount > ?", []string{"p1", "p2", "p3"}, 4), 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int{5, 9}, amounts(many))
+
+	results, err := repo.InsertMany(ctx,
+		[]*conflux.SQLFilter{byID("p1"), byID("p5")},
+		[]conflux.CreateFunc[*Purchase]{createPurchase("p1", "sku-p1", 1), createPurchase("p5", "sku-p5", 4)})
+	require.NoError(t, err)
+	assert.True(t, results[0].IsDuplicate())
+	assert.True(t, results[1].IsSuccess())
+
+	_, err = repo.FindOneAndUpdate(ctx, nil, 1, conflux.NewUpdateFunc(addAmount))
+	assert.Error(t, err)
+}
+
+// ============================================================================
+// Mapping and dialect tests
+// ============================================================================
+
+func TestNewSQLRepository_RequiresIDColumn(t *testing.T) {
+	type noID struct {
+		Name string
+	}
+
+	_, err := NewSQLRepository[*noID](nil, &SQLRepositoryConfig{Table: "things"}, nil)
+	assert.Error(t, err)
+
+	_, err = NewSQLRepository[*Purchase](nil, &SQLRepositoryConfig{}, nil)
+	assert.Error(t, err)
+}
+
+func TestEntityMapping_Columns(t *testing.T) {
+	mapping, err := newEntityMapping(reflectTypeOf[*Purchase](), "id", "version", "deleted_at")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"created_at", "updated_at", "id", "player_id", "sku", "amount", "tags"}, mapping.names())
+	assert.True(t, mapping.columns[mapping.byName["tags"]].json)
+	assert.False(t, mapping.columns[mapping.byName["created_at"]].json)
+}
+
+func TestQueryBuilder_Filter(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect Dialect
+		filter  *conflux.SQLFilter
+		query   string
+		args    []any
+	}{
+		{
+			name:    "postgres numbering",
+			dialect: PostgreSQL,
+			filter:  conflux.NewSQLFilter("player_id = ? AND sku IN ?", "alice", []string{"a", "b"}),
+			query:   "player_id = $1 AND sku IN ($2, $3)",
+			args:    []any{"alice", "a", "b"},
+		},
+		{
+			name:    "question mark inside string literal",
+			dialect: SQLite,
+			filter:  conflux.NewSQLFilter("note <> '?' AND amount > ?", 3),
+			query:   "note <> '?' AND amount > ?",
+			args:    []any{3},
+		},
+		{
+			name:    "empty list and bytes",
+			dialect: MySQL,
+			filter:  conflux.NewSQLFilter("id IN ? OR payload = ?", []string{}, []byte("x")),
+			query:   "id IN (NULL) OR payload = ?",
+			args:    []any{[]byte("x")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newQueryBuilder(tt.dialect)
+			require.NoError(t, b.filter(tt.filter))
+			assert.Equal(t, tt.query, b.String())
+			assert.Equal(t, tt.args, b.args)
+		})
+	}
+
+	assert.Error(t, newQueryBuilder(SQLite).filter(conflux.NewSQLFilter("id = ?")))
+	assert.Error(t, newQueryBuilder(SQLite).filter(conflux.NewSQLFilter("id = 1", "extra")))
+}
+
+func TestDialect_Statements(t *testing.T) {
+	columns := []string{PostgreSQL.QuoteIdent("id"), PostgreSQL.QuoteIdent("version")}
+	placeholders := []string{PostgreSQL.Placeholder(1), PostgreSQL.Placeholder(2)}
+	assert.Equal(t, `INSERT INTO "t" ("id", "version") VALUES ($1, $2) ON CONFLICT DO NOTHING`,
+		PostgreSQL.InsertIgnore(`"t"`, columns, placeholders))
+
+	assert.Equal(t, "INSERT IGNORE INTO `t` (`id`) VALUES (?)",
+		MySQL.InsertIgnore(MySQL.QuoteIdent("t"), []string{MySQL.QuoteIdent("id")}, []string{"?"}))
+	assert.Equal(t, `"app"."weird""name"`, SQLite.QuoteIdent(`app.weird"name`))
+
+	assert.Equal(t, " LIMIT -1 OFFSET 5", SQLite.LimitOffset(0, 5))
+	assert.Equal(t, " OFFSET 5", PostgreSQL.LimitOffset(0, 5))
+	assert.Equal(t, " LIMIT 10 OFFSET 5", MySQL.LimitOffset(10, 5))
+	assert.Equal(t, "", SQLite.LimitOffset(0, 0))
+}
+
+func TestToSnakeCase(t *testing.T) {
+	cases := map[string]string{
+		"ID":        "id",
+		"PlayerID":  "player_id",
+		"CreatedAt": "created_at",
+		"HTTPCode":  "http_code",
+		"SKU":       "sku",
+	}
+	for in, want := range cases {
+		assert.Equal(t, want, toSnakeCase(in), in)
+	}
+}
+
+func reflectTypeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+func amounts(purchases []*Purchase) []int {
+	result := make([]int, len(purchases))
+	for i, p := range purchases {
+		result[i] = p.Amount
+	}
+	return result
+}
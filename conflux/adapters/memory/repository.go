@@ -21,6 +21,22 @@ type MemoryRepository[T any] struct {
 	entities    map[string]*versionedEntity[T] // ID를 string으로 통일
 	versions    map[string]int64
 	newEntityFn func() T
+	clock       conflux.Clock
+}
+
+// MemoryConfig 메모리 레포지토리 설정
+type MemoryConfig struct {
+	Clock conflux.Clock // 타임스탬프 기록용 시각 소스 (기본값: 시스템 시간)
+}
+
+// MemoryOption 메모리 레포지토리 생성 옵션
+type MemoryOption func(*MemoryConfig)
+
+// WithClock 타임스탬프 기록에 사용할 시각 소스 설정
+func WithClock(clock conflux.Clock) MemoryOption {
+	return func(config *MemoryConfig) {
+		config.Clock = clock
+	}
 }
 
 // versionedEntity 버전 정보를 포함한 엔터티 래퍼
@@ -29,17 +45,24 @@ type versionedEntity[T any] struct {
 	Version   int64
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	DeletedAt time.Time // 0이 아니면 소프트 삭제된 툼스톤
 }
 
 // NewMemoryRepository 새 메모리 레포지토리 생성
-func NewMemoryRepository[T any](newEntityFn func() T) interface {
-	conflux.Repository[T, conflux.MapFilter]
+func NewMemoryRepository[T any](newEntityFn func() T, options ...MemoryOption) interface {
+	conflux.TransactionalRepository[T, conflux.MapFilter]
 	conflux.ReadRepository[T, conflux.MapFilter]
 } {
+	config := &MemoryConfig{}
+	for _, opt := range options {
+		opt(config)
+	}
+
 	return &MemoryRepository[T]{
 		entities:    make(map[string]*versionedEntity[T]),
 		versions:    make(map[string]int64),
 		newEntityFn: newEntityFn,
+		clock:       conflux.ClockOrSystem(config.Clock),
 	}
 }
 
@@ -102,7 +125,7 @@ func (r *MemoryRepository[T]) FindOneAndInsert(
 
 	// 버전 및 타임스탬프 설정
 	version := int64(1)
-	now := time.Now()
+	now := r.clock.Now()
 	r.setEntityVersion(newEntity, version)
 	r.setEntityTimestamps(newEntity, now, now)
 
@@ -158,7 +181,7 @@ func (r *MemoryRepository[T]) FindOneAndUpsert(
 
 		// 버전 및 타임스탬프 설정
 		version := int64(1)
-		now := time.Now()
+		now := r.clock.Now()
 		r.setEntityVersion(newEntity, version)
 		r.setEntityTimestamps(newEntity, now, now)
 
@@ -185,7 +208,7 @@ func (r *MemoryRepository[T]) FindOneAndUpsert(
 
 	// 새 버전 설정
 	newVersion := existing.Version + 1
-	now := time.Now()
+	now := r.clock.Now()
 	r.setEntityVersion(updatedEntity, newVersion)
 	r.setEntityTimestamp(updatedEntity, now)
 
@@ -266,7 +289,7 @@ func (r *MemoryRepository[T]) FindOneAndUpdate(
 					// ResolveWithMerged 또는 기타
 					// resolved는 이미 T 타입이므로 직접 사용
 					newVersion := existing.Version + 1
-					now := time.Now()
+					now := r.clock.Now()
 					r.setEntityVersion(resolved, newVersion)
 					r.setEntityTimestamp(resolved, now)
 					
@@ -305,7 +328,7 @@ func (r *MemoryRepository[T]) FindOneAndUpdate(
 
 	// 새 버전 설정
 	newVersion := expectedVersion + 1
-	now := time.Now()
+	now := r.clock.Now()
 	r.setEntityVersion(updatedEntity, newVersion)
 	r.setEntityTimestamp(updatedEntity, now)
 
@@ -323,6 +346,69 @@ func (r *MemoryRepository[T]) FindOneAndUpdate(
 	return conflux.NewUpdateResult(updatedEntity, newVersion), nil
 }
 
+// FindOneAndDelete 원자적 엔터티 삭제
+// 소프트 삭제 시 툼스톤은 저장소에 남지만 이후 조회와 중복 검사에서 제외됩니다
+func (r *MemoryRepository[T]) FindOneAndDelete(
+	ctx context.Context,
+	lookupFilter conflux.MapFilter,
+	expectedVersion int64,
+	options ...conflux.DeleteOption,
+) (*conflux.DeleteResult[T], error) {
+	// 옵션 처리
+	config := conflux.NewDeleteConfig()
+	for _, opt := range options {
+		opt(config)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// 필터 유효성 검증
+	if err := lookupFilter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	// 필터로 기존 엔터티 조회
+	existingID, exists := r.findByMapFilter(lookupFilter)
+	if !exists {
+		return conflux.NewDeleteNotFoundResult[T](), nil
+	}
+
+	existing := r.entities[existingID]
+
+	// 버전 충돌 검사
+	if existing.Version != expectedVersion {
+		return conflux.NewDeleteConflictResult(existing.Entity, existing.Version), nil
+	}
+
+	now := r.clock.Now()
+
+	if !config.Soft {
+		delete(r.entities, existingID)
+		delete(r.versions, existingID)
+		return conflux.NewDeleteResult(existing.Entity, existing.Version, now, false), nil
+	}
+
+	// 툼스톤: deleted-at 기록 및 버전 증가
+	if config.TombstoneUpdate != nil {
+		config.TombstoneUpdate(existing.Entity)
+	}
+	newVersion := expectedVersion + 1
+	r.setEntityVersion(existing.Entity, newVersion)
+	r.setEntityTimestamp(existing.Entity, now)
+	r.setEntityDeletedAt(existing.Entity, now)
+
+	r.entities[existingID] = &versionedEntity[T]{
+		Entity:    existing.Entity,
+		Version:   newVersion,
+		CreatedAt: existing.CreatedAt,
+		UpdatedAt: now,
+		DeletedAt: now,
+	}
+	r.versions[existingID] = newVersion
+
+	return conflux.NewDeleteResult(existing.Entity, newVersion, now, true), nil
+}
+
 // NewEntity 새 엔터티 인스턴스 생성
 func (r *MemoryRepository[T]) NewEntity() T {
 	if r.newEntityFn != nil {
@@ -350,7 +436,7 @@ func (r *MemoryRepository[T]) FindOne(ctx context.Context, filter conflux.MapFil
 
 	entityID, exists := r.findByMapFilter(filter)
 	if !exists {
-		return empty, fmt.Errorf("entity not found")
+		return empty, conflux.ErrNotFound
 	}
 
 	return r.entities[entityID].Entity, nil
@@ -380,7 +466,7 @@ func (r *MemoryRepository[T]) GetVersion(ctx context.Context, filter conflux.Map
 
 	entityID, exists := r.findByMapFilter(filter)
 	if !exists {
-		return 0, fmt.Errorf("entity not found")
+		return 0, conflux.ErrNotFound
 	}
 
 	return r.entities[entityID].Version, nil
@@ -407,6 +493,10 @@ func (r *MemoryRepository[T]) FindMany(ctx context.Context, filter conflux.MapFi
 			break
 		}
 
+		if versioned.isDeleted() {
+			continue
+		}
+
 		if r.matchesMapFilter(versioned.Entity, filter) {
 			results = append(results, versioned.Entity)
 			count++
@@ -420,9 +510,12 @@ func (r *MemoryRepository[T]) FindMany(ctx context.Context, filter conflux.MapFi
 // 헬퍼 메서드들
 // ============================================================================
 
-// findByMapFilter MapFilter 조건에 맞는 엔터티 ID 찾기
+// findByMapFilter MapFilter 조건에 맞는 엔터티 ID 찾기 (툼스톤 제외)
 func (r *MemoryRepository[T]) findByMapFilter(filter conflux.MapFilter) (string, bool) {
 	for id, versioned := range r.entities {
+		if versioned.isDeleted() {
+			continue
+		}
 		if r.matchesMapFilter(versioned.Entity, filter) {
 			return id, true
 		}
@@ -494,4 +587,16 @@ func (r *MemoryRepository[T]) setEntityTimestamp(entity T, updatedAt time.Time)
 	if timestamped, ok := any(entity).(conflux.Timestamped); ok {
 		timestamped.SetUpdatedAt(updatedAt)
 	}
-}
\ No newline at end of file
+}
+
+// setEntityDeletedAt 엔터티의 삭제 타임스탬프 설정 (SoftDeletable 인터페이스 구현 시)
+func (r *MemoryRepository[T]) setEntityDeletedAt(entity T, deletedAt time.Time) {
+	if deletable, ok := any(entity).(conflux.SoftDeletable); ok {
+		deletable.SetDeletedAt(&deletedAt)
+	}
+}
+
+// isDeleted 소프트 삭제된 툼스톤인지 확인
+func (v *versionedEntity[T]) isDeleted() bool {
+	return !v.DeletedAt.IsZero()
+}
@@ -0,0 +1,463 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/homveloper/dukdakit/conflux"
+)
+
+// ============================================================================
+// TransactionalRepository 인터페이스 구현
+// ============================================================================
+
+// memoryTransaction 스테이징된 쓰기와 관찰한 버전을 보관하는 트랜잭션
+// 쓰기는 Commit 전까지 레포지토리에 반영되지 않으며, Commit 시 트랜잭션이
+// 건드린 모든 엔터티의 버전이 관찰 당시와 같을 때만 한 번에 적용됩니다
+type memoryTransaction[T any] struct {
+	mu       sync.Mutex
+	repo     *MemoryRepository[T]
+	staged   map[string]*stagedWrite[T]
+	observed map[string]int64 // 엔터티 ID -> 처음 접근 시의 커밋된 버전 (없으면 0)
+	inserts  []stagedInsert   // 중복 검사를 통과한 생성 (Commit 시 다시 검사)
+	active   bool
+}
+
+// stagedInsert 필터로 중복이 없음을 확인하고 스테이징한 생성
+// 필터에 맞는 엔터티가 없었다는 사실은 버전으로 관찰할 수 없으므로 Commit에서 필터를 다시 실행합니다
+type stagedInsert struct {
+	id     string
+	filter conflux.MapFilter
+}
+
+// stagedWrite 트랜잭션 내에서 스테이징된 쓰기
+// entry가 nil이면 하드 삭제를 의미합니다
+type stagedWrite[T any] struct {
+	entry *versionedEntity[T]
+}
+
+// BeginTransaction 새 트랜잭션 시작
+func (r *MemoryRepository[T]) BeginTransaction(ctx context.Context) (conflux.TransactionContext, error) {
+	return &memoryTransaction[T]{
+		repo:     r,
+		staged:   make(map[string]*stagedWrite[T]),
+		observed: make(map[string]int64),
+		active:   true,
+	}, nil
+}
+
+// WithTransaction 트랜잭션 내에서 동작하는 레포지토리 반환
+// 다른 레포지토리의 트랜잭션이 전달되면 모든 연산이 ErrInvalidTransaction을 반환합니다
+func (r *MemoryRepository[T]) WithTransaction(tx conflux.TransactionContext) conflux.Repository[T, conflux.MapFilter] {
+	memTx, ok := tx.(*memoryTransaction[T])
+	if !ok || memTx.repo != r {
+		memTx = nil
+	}
+	return &txRepository[T]{repo: r, tx: memTx}
+}
+
+// Commit 스테이징된 쓰기를 원자적으로 적용
+// 관찰한 버전이 바뀐 엔터티가 있거나 생성 시 중복 검사한 필터에 다른 엔터티가 커밋되었으면
+// *conflux.ConflictError를 반환하고 아무것도 적용하지 않습니다
+func (t *memoryTransaction[T]) Commit(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.active {
+		return conflux.ErrTransactionClosed
+	}
+	t.active = false
+
+	t.repo.mu.Lock()
+	defer t.repo.mu.Unlock()
+
+	// 버전 검사 (결정적인 에러를 위해 ID 순으로)
+	ids := make([]string, 0, len(t.observed))
+	for id := range t.observed {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		expected := t.observed[id]
+		if actual := t.repo.committedVersion(id); actual != expected {
+			return conflux.NewConflictError(expected, actual,
+				fmt.Sprintf("version conflict on entity %q: expected %d, got %d", id, expected, actual))
+		}
+	}
+
+	// 생성 시 비어 있던 필터에 다른 트랜잭션이 먼저 커밋한 엔터티가 있는지 검사
+	for _, insert := range t.inserts {
+		if id, version, exists := t.duplicateLocked(insert.filter, insert.id); exists {
+			return conflux.NewConflictError(0, version,
+				fmt.Sprintf("duplicate entity %q committed for insert of %q", id, insert.id))
+		}
+	}
+
+	// 모든 쓰기 적용
+	for id, write := range t.staged {
+		if write.entry == nil {
+			delete(t.repo.entities, id)
+			delete(t.repo.versions, id)
+			continue
+		}
+		t.repo.entities[id] = write.entry
+		t.repo.versions[id] = write.entry.Version
+	}
+
+	t.staged = nil
+	t.inserts = nil
+	return nil
+}
+
+// Rollback 스테이징된 쓰기를 모두 버림
+func (t *memoryTransaction[T]) Rollback(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.active {
+		return conflux.ErrTransactionClosed
+	}
+	t.active = false
+	t.staged = nil
+	t.inserts = nil
+	return nil
+}
+
+// IsActive 트랜잭션이 커밋/롤백 전인지 확인
+func (t *memoryTransaction[T]) IsActive() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.active
+}
+
+// ============================================================================
+// 트랜잭션 레포지토리
+// ============================================================================
+
+// txRepository 트랜잭션에 쓰기를 스테이징하는 Repository 구현
+// 조회는 커밋된 상태 위에 스테이징된 쓰기를 겹쳐서 봅니다
+// 충돌 해결자 옵션은 지원하지 않으며, 버전이 다르면 충돌 결과를 반환합니다
+type txRepository[T any] struct {
+	repo *MemoryRepository[T]
+	tx   *memoryTransaction[T]
+}
+
+// FindOneAndInsert 트랜잭션 내 엔터티 생성
+func (r *txRepository[T]) FindOneAndInsert(
+	ctx context.Context,
+	duplicateCheckFilter conflux.MapFilter,
+	createFunc conflux.CreateFunc[T],
+	options ...conflux.InsertOption,
+) (*conflux.InsertResult[T], error) {
+	if err := duplicateCheckFilter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	t, err := r.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer t.mu.Unlock()
+
+	if _, existing, exists := t.find(duplicateCheckFilter); exists {
+		return conflux.NewDuplicateInsertResult(existing.Entity, existing.Version), nil
+	}
+
+	newEntity, err := createFunc.CreateFn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create entity: %w", err)
+	}
+
+	if err := t.stageCreate(newEntity, duplicateCheckFilter); err != nil {
+		return nil, err
+	}
+
+	return conflux.NewInsertResult(newEntity, 1), nil
+}
+
+// FindOneAndUpsert 트랜잭션 내 엔터티 생성/업데이트
+func (r *txRepository[T]) FindOneAndUpsert(
+	ctx context.Context,
+	lookupFilter conflux.MapFilter,
+	upsertFunc conflux.UpsertFunc[T],
+	options ...conflux.UpsertOption,
+) (*conflux.UpsertResult[T], error) {
+	if err := lookupFilter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	t, err := r.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer t.mu.Unlock()
+
+	id, existing, exists := t.find(lookupFilter)
+	if !exists {
+		newEntity, err := upsertFunc.CreateFn(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create entity: %w", err)
+		}
+
+		if err := t.stageCreate(newEntity, lookupFilter); err != nil {
+			return nil, err
+		}
+
+		return conflux.NewUpsertResult(newEntity, true, 1), nil
+	}
+
+	updatedEntity, err := upsertFunc.UpdateFn(ctx, cloneEntity(existing.Entity))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update entity: %w", err)
+	}
+
+	entry := t.stageUpdate(id, existing, updatedEntity)
+	return conflux.NewUpsertResult(entry.Entity, false, entry.Version), nil
+}
+
+// FindOneAndUpdate 트랜잭션 내 엔터티 업데이트
+func (r *txRepository[T]) FindOneAndUpdate(
+	ctx context.Context,
+	lookupFilter conflux.MapFilter,
+	expectedVersion int64,
+	updateFunc conflux.UpdateFunc[T],
+	options ...conflux.UpdateOption,
+) (*conflux.UpdateResult[T], error) {
+	config := conflux.NewUpdateConfig()
+	for _, opt := range options {
+		opt(config)
+	}
+
+	if err := lookupFilter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	t, err := r.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer t.mu.Unlock()
+
+	id, existing, exists := t.find(lookupFilter)
+	if !exists {
+		return conflux.NewNotFoundResult[T](), nil
+	}
+
+	if existing.Version != expectedVersion && config.OnConflict != conflux.OverwriteOnConflict {
+		return conflux.NewVersionConflictResult(existing.Entity, existing.Version), nil
+	}
+
+	updatedEntity, err := updateFunc.UpdateFn(ctx, cloneEntity(existing.Entity))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update entity: %w", err)
+	}
+
+	entry := t.stageUpdate(id, existing, updatedEntity)
+	return conflux.NewUpdateResult(entry.Entity, entry.Version), nil
+}
+
+// FindOneAndDelete 트랜잭션 내 엔터티 삭제
+func (r *txRepository[T]) FindOneAndDelete(
+	ctx context.Context,
+	lookupFilter conflux.MapFilter,
+	expectedVersion int64,
+	options ...conflux.DeleteOption,
+) (*conflux.DeleteResult[T], error) {
+	config := conflux.NewDeleteConfig()
+	for _, opt := range options {
+		opt(config)
+	}
+
+	if err := lookupFilter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	t, err := r.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer t.mu.Unlock()
+
+	id, existing, exists := t.find(lookupFilter)
+	if !exists {
+		return conflux.NewDeleteNotFoundResult[T](), nil
+	}
+
+	if existing.Version != expectedVersion {
+		return conflux.NewDeleteConflictResult(existing.Entity, existing.Version), nil
+	}
+
+	now := r.repo.clock.Now()
+
+	if !config.Soft {
+		t.staged[id] = &stagedWrite[T]{}
+		return conflux.NewDeleteResult(existing.Entity, existing.Version, now, false), nil
+	}
+
+	tombstone := cloneEntity(existing.Entity)
+	if config.TombstoneUpdate != nil {
+		config.TombstoneUpdate(tombstone)
+	}
+	newVersion := existing.Version + 1
+	r.repo.setEntityVersion(tombstone, newVersion)
+	r.repo.setEntityTimestamp(tombstone, now)
+	r.repo.setEntityDeletedAt(tombstone, now)
+
+	t.staged[id] = &stagedWrite[T]{entry: &versionedEntity[T]{
+		Entity:    tombstone,
+		Version:   newVersion,
+		CreatedAt: existing.CreatedAt,
+		UpdatedAt: now,
+		DeletedAt: now,
+	}}
+
+	return conflux.NewDeleteResult(tombstone, newVersion, now, true), nil
+}
+
+// NewEntity 새 엔터티 인스턴스 생성
+func (r *txRepository[T]) NewEntity() T {
+	return r.repo.NewEntity()
+}
+
+// lock 트랜잭션을 잠그고 활성 상태인지 확인
+// 성공하면 호출자가 t.mu.Unlock을 호출해야 합니다
+func (r *txRepository[T]) lock() (*memoryTransaction[T], error) {
+	if r.tx == nil {
+		return nil, conflux.ErrInvalidTransaction
+	}
+
+	r.tx.mu.Lock()
+	if !r.tx.active {
+		r.tx.mu.Unlock()
+		return nil, conflux.ErrTransactionClosed
+	}
+	return r.tx, nil
+}
+
+// ============================================================================
+// 트랜잭션 헬퍼 메서드들 (t.mu를 잡은 상태에서 호출)
+// ============================================================================
+
+// find 커밋된 상태 위에 스테이징된 쓰기를 겹쳐서 필터에 맞는 엔터티 조회
+// 커밋된 엔터티를 읽으면 그 버전을 관찰 목록에 기록합니다
+func (t *memoryTransaction[T]) find(filter conflux.MapFilter) (string, *versionedEntity[T], bool) {
+	t.repo.mu.RLock()
+	defer t.repo.mu.RUnlock()
+
+	for id, committed := range t.repo.entities {
+		entry := committed
+		if write, ok := t.staged[id]; ok {
+			entry = write.entry
+		}
+		if entry == nil || entry.isDeleted() || !t.repo.matchesMapFilter(entry.Entity, filter) {
+			continue
+		}
+		t.observeLocked(id)
+		return id, entry, true
+	}
+
+	// 트랜잭션 안에서 새로 생성된 엔터티
+	for id, write := range t.staged {
+		if _, committed := t.repo.entities[id]; committed {
+			continue
+		}
+		if write.entry == nil || write.entry.isDeleted() || !t.repo.matchesMapFilter(write.entry.Entity, filter) {
+			continue
+		}
+		return id, write.entry, true
+	}
+
+	return "", nil, false
+}
+
+// stageCreate 새 엔터티를 버전 1로 스테이징하고 Commit에서 다시 검사할 필터를 기록
+func (t *memoryTransaction[T]) stageCreate(entity T, filter conflux.MapFilter) error {
+	id, err := t.repo.extractID(entity)
+	if err != nil {
+		return fmt.Errorf("failed to extract ID from entity: %w", err)
+	}
+
+	t.repo.mu.RLock()
+	t.observeLocked(id)
+	t.repo.mu.RUnlock()
+
+	now := t.repo.clock.Now()
+	t.repo.setEntityVersion(entity, 1)
+	t.repo.setEntityTimestamps(entity, now, now)
+
+	t.staged[id] = &stagedWrite[T]{entry: &versionedEntity[T]{
+		Entity:    entity,
+		Version:   1,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}}
+	t.inserts = append(t.inserts, stagedInsert{id: id, filter: filter})
+	return nil
+}
+
+// duplicateLocked 스테이징된 쓰기를 겹친 커밋 상태에서 excludeID가 아닌 엔터티 중 필터에 맞는 것을 조회 (repo.mu 필요)
+func (t *memoryTransaction[T]) duplicateLocked(filter conflux.MapFilter, excludeID string) (string, int64, bool) {
+	for id, committed := range t.repo.entities {
+		if id == excludeID {
+			continue
+		}
+		entry := committed
+		if write, ok := t.staged[id]; ok {
+			entry = write.entry
+		}
+		if entry == nil || entry.isDeleted() || !t.repo.matchesMapFilter(entry.Entity, filter) {
+			continue
+		}
+		return id, committed.Version, true
+	}
+	return "", 0, false
+}
+
+// stageUpdate 업데이트된 엔터티를 다음 버전으로 스테이징
+func (t *memoryTransaction[T]) stageUpdate(id string, existing *versionedEntity[T], updated T) *versionedEntity[T] {
+	now := t.repo.clock.Now()
+	newVersion := existing.Version + 1
+	t.repo.setEntityVersion(updated, newVersion)
+	t.repo.setEntityTimestamp(updated, now)
+
+	entry := &versionedEntity[T]{
+		Entity:    updated,
+		Version:   newVersion,
+		CreatedAt: existing.CreatedAt,
+		UpdatedAt: now,
+	}
+	t.staged[id] = &stagedWrite[T]{entry: entry}
+	return entry
+}
+
+// observeLocked 엔터티의 커밋된 버전을 처음 접근할 때 한 번만 기록 (repo.mu 필요)
+func (t *memoryTransaction[T]) observeLocked(id string) {
+	if _, seen := t.observed[id]; !seen {
+		t.observed[id] = t.repo.committedVersion(id)
+	}
+}
+
+// committedVersion 커밋된 엔터티의 버전 (툼스톤 포함, 없으면 0)
+func (r *MemoryRepository[T]) committedVersion(id string) int64 {
+	if entry, ok := r.entities[id]; ok {
+		return entry.Version
+	}
+	return 0
+}
+
+// cloneEntity 포인터 엔터티의 얕은 복사본 생성
+// 트랜잭션의 업데이트 함수가 커밋된 엔터티를 직접 변경하지 않도록 합니다
+func cloneEntity[T any](entity T) T {
+	value := reflect.ValueOf(entity)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return entity
+	}
+
+	clone := reflect.New(value.Elem().Type())
+	clone.Elem().Set(value.Elem())
+	return clone.Interface().(T)
+}
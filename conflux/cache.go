@@ -0,0 +1,367 @@
+package conflux
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// 읽기 캐시 데코레이터 (CachedRepository 구현)
+// ============================================================================
+
+// ReadWriteRepository 쓰기와 읽기를 모두 제공하는 레포지토리
+// 데코레이터들이 감쌀 수 있는 최소 조합입니다
+type ReadWriteRepository[T any, F any] interface {
+	Repository[T, F]
+	ReadRepository[T, F]
+}
+
+// 컴파일 타임 인터페이스 검사
+var _ CachedRepository[any, MapFilter, string] = (*CachingRepository[any, MapFilter, string])(nil)
+
+// CacheConfig 캐시 데코레이터 설정
+type CacheConfig struct {
+	// DefaultTTL CacheOptions.TTL이 0일 때 사용하는 유효시간 (기본값: 1분)
+	DefaultTTL time.Duration
+
+	// DefaultNamespace CacheOptions.Namespace가 비어 있을 때 사용하는 네임스페이스
+	DefaultNamespace string
+
+	// Clock 만료 계산용 시각 소스 (기본값: 시스템 시간)
+	Clock Clock
+}
+
+// CacheOption 캐시 데코레이터 생성 옵션
+type CacheOption func(*CacheConfig)
+
+// WithCacheTTL 기본 캐시 유효시간 설정
+func WithCacheTTL(ttl time.Duration) CacheOption {
+	return func(config *CacheConfig) {
+		config.DefaultTTL = ttl
+	}
+}
+
+// WithCacheNamespace 기본 캐시 네임스페이스 설정
+func WithCacheNamespace(namespace string) CacheOption {
+	return func(config *CacheConfig) {
+		config.DefaultNamespace = namespace
+	}
+}
+
+// WithCacheClock 만료 계산에 사용할 시각 소스 설정
+func WithCacheClock(clock Clock) CacheOption {
+	return func(config *CacheConfig) {
+		config.Clock = clock
+	}
+}
+
+// CachingRepository ID 기반 읽기 캐시를 제공하는 레포지토리 데코레이터
+// FindByIDCached는 캐시를 먼저 확인하고, 없으면 내부 레포지토리에서 읽어 캐시에 저장합니다
+// 이 데코레이터를 통한 쓰기가 성공하거나 버전 충돌을 반환하면 해당 ID의 캐시가 자동으로 무효화됩니다
+//
+// 오래된 버전이 캐시되지 않도록:
+//   - 읽기 도중 같은 ID가 무효화되면 읽은 값을 캐시에 저장하지 않습니다
+//   - 이미 캐시된 값보다 낮은 버전(Versioned 구현 시)은 저장하지 않습니다
+//
+// 캐시는 프로세스 메모리에 있으므로 다른 프로세스의 쓰기는 TTL이 지나야 반영됩니다
+//
+// Example usage:
+//
+//	cached := conflux.NewCachingRepository[*User, conflux.MapFilter, string](repo,
+//	    func(id string) conflux.MapFilter { return conflux.NewMapFilter().And("ID", id) },
+//	    func(u *User) string { return u.ID },
+//	    conflux.WithCacheTTL(30*time.Second))
+//
+//	user, err := cached.FindByIDCached(ctx, "user1", &conflux.CacheOptions{Tags: []string{"guild:7"}})
+//	cached.InvalidateCacheByTags(ctx, []string{"guild:7"})
+type CachingRepository[T any, F any, ID comparable] struct {
+	inner    ReadWriteRepository[T, F]
+	idFilter func(ID) F
+	entityID func(T) ID
+	config   *CacheConfig
+	clock    Clock
+
+	mu         sync.Mutex
+	entries    map[cacheKey[ID]]*cacheEntry[T]
+	tags       map[string]map[cacheKey[ID]]struct{}
+	namespaces map[string]struct{}
+	loading    map[ID]*pendingLoad // 읽기 중인 ID (읽기 도중 무효화 감지용)
+}
+
+// pendingLoad 진행 중인 읽기 수와 그동안의 무효화 횟수
+type pendingLoad struct {
+	readers int
+	epoch   uint64
+}
+
+// cacheKey 네임스페이스와 ID로 구성된 캐시 키
+type cacheKey[ID comparable] struct {
+	namespace string
+	id        ID
+}
+
+// cacheEntry 캐시된 엔터티
+type cacheEntry[T any] struct {
+	entity    T
+	version   int64
+	expiresAt time.Time
+	tags      []string
+}
+
+// NewCachingRepository 새 캐시 데코레이터 생성
+// idFilter: ID로 내부 레포지토리를 조회할 필터 생성
+// entityID: 쓰기 결과 엔터티에서 무효화할 ID 추출
+func NewCachingRepository[T any, F any, ID comparable](
+	inner ReadWriteRepository[T, F],
+	idFilter func(ID) F,
+	entityID func(T) ID,
+	options ...CacheOption,
+) *CachingRepository[T, F, ID] {
+	config := &CacheConfig{
+		DefaultTTL: time.Minute,
+	}
+	for _, opt := range options {
+		opt(config)
+	}
+
+	return &CachingRepository[T, F, ID]{
+		inner:      inner,
+		idFilter:   idFilter,
+		entityID:   entityID,
+		config:     config,
+		clock:      ClockOrSystem(config.Clock),
+		entries:    make(map[cacheKey[ID]]*cacheEntry[T]),
+		tags:       make(map[string]map[cacheKey[ID]]struct{}),
+		namespaces: make(map[string]struct{}),
+		loading:    make(map[ID]*pendingLoad),
+	}
+}
+
+// ============================================================================
+// CachedRepository 인터페이스 구현
+// ============================================================================
+
+// FindByIDCached 캐시를 사용하여 엔터티 조회
+// opts가 nil이면 기본 TTL과 네임스페이스를 사용합니다
+func (r *CachingRepository[T, F, ID]) FindByIDCached(ctx context.Context, id ID, opts *CacheOptions) (T, error) {
+	key, ttl, tags := r.resolveOptions(id, opts)
+
+	r.mu.Lock()
+	if entry, ok := r.entries[key]; ok {
+		if r.clock.Now().Before(entry.expiresAt) {
+			r.mu.Unlock()
+			return entry.entity, nil
+		}
+		r.removeLocked(key)
+	}
+	pending := r.loading[id]
+	if pending == nil {
+		pending = &pendingLoad{}
+		r.loading[id] = pending
+	}
+	pending.readers++
+	epoch := pending.epoch
+	r.mu.Unlock()
+
+	entity, err := r.inner.FindOne(ctx, r.idFilter(id))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	pending.readers--
+	if pending.readers == 0 {
+		delete(r.loading, id)
+	}
+
+	if err != nil {
+		var empty T
+		return empty, err
+	}
+
+	// 읽는 동안 무효화되었다면 읽은 값이 이미 오래되었을 수 있음
+	if pending.epoch != epoch {
+		return entity, nil
+	}
+
+	version := entityVersion(entity)
+	if existing, ok := r.entries[key]; ok && existing.version > version {
+		return entity, nil
+	}
+
+	r.removeLocked(key)
+	r.entries[key] = &cacheEntry[T]{
+		entity:    entity,
+		version:   version,
+		expiresAt: r.clock.Now().Add(ttl),
+		tags:      tags,
+	}
+	r.namespaces[key.namespace] = struct{}{}
+	for _, tag := range tags {
+		if r.tags[tag] == nil {
+			r.tags[tag] = make(map[cacheKey[ID]]struct{})
+		}
+		r.tags[tag][key] = struct{}{}
+	}
+
+	return entity, nil
+}
+
+// InvalidateCache 모든 네임스페이스에서 ID의 캐시 무효화
+func (r *CachingRepository[T, F, ID]) InvalidateCache(ctx context.Context, id ID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.invalidateLocked(id)
+	return nil
+}
+
+// InvalidateCacheByTags 태그 중 하나라도 가진 캐시들을 일괄 무효화
+func (r *CachingRepository[T, F, ID]) InvalidateCacheByTags(ctx context.Context, tags []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, tag := range tags {
+		for key := range r.tags[tag] {
+			r.markInvalidatedLocked(key.id)
+			r.removeLocked(key)
+		}
+	}
+	return nil
+}
+
+// ============================================================================
+// Repository 인터페이스 구현 (쓰기 후 자동 무효화)
+// ============================================================================
+
+// FindOneAndInsert 엔터티 생성 후 캐시 무효화
+func (r *CachingRepository[T, F, ID]) FindOneAndInsert(ctx context.Context, duplicateCheckFilter F, createFunc CreateFunc[T], options ...InsertOption) (*InsertResult[T], error) {
+	result, err := r.inner.FindOneAndInsert(ctx, duplicateCheckFilter, createFunc, options...)
+	if err == nil && result.IsSuccess() {
+		r.invalidateEntity(result.Entity)
+	}
+	return result, err
+}
+
+// FindOneAndUpsert 엔터티 생성/업데이트 후 캐시 무효화
+func (r *CachingRepository[T, F, ID]) FindOneAndUpsert(ctx context.Context, lookupFilter F, upsertFunc UpsertFunc[T], options ...UpsertOption) (*UpsertResult[T], error) {
+	result, err := r.inner.FindOneAndUpsert(ctx, lookupFilter, upsertFunc, options...)
+	if err == nil {
+		r.invalidateEntity(result.Entity)
+	}
+	return result, err
+}
+
+// FindOneAndUpdate 엔터티 업데이트 후 캐시 무효화
+// 버전 충돌 시에도 캐시된 값이 오래되었음을 뜻하므로 무효화합니다
+func (r *CachingRepository[T, F, ID]) FindOneAndUpdate(ctx context.Context, lookupFilter F, expectedVersion int64, updateFunc UpdateFunc[T], options ...UpdateOption) (*UpdateResult[T], error) {
+	result, err := r.inner.FindOneAndUpdate(ctx, lookupFilter, expectedVersion, updateFunc, options...)
+	if err == nil && !result.IsNotFound() {
+		r.invalidateEntity(result.Entity)
+	}
+	return result, err
+}
+
+// FindOneAndDelete 엔터티 삭제 후 캐시 무효화
+// 버전 충돌 시에도 캐시된 값이 오래되었음을 뜻하므로 무효화합니다
+func (r *CachingRepository[T, F, ID]) FindOneAndDelete(ctx context.Context, lookupFilter F, expectedVersion int64, options ...DeleteOption) (*DeleteResult[T], error) {
+	result, err := r.inner.FindOneAndDelete(ctx, lookupFilter, expectedVersion, options...)
+	if err == nil && !result.IsNotFound() {
+		r.invalidateEntity(result.Entity)
+	}
+	return result, err
+}
+
+// NewEntity 새 엔터티 인스턴스 생성
+func (r *CachingRepository[T, F, ID]) NewEntity() T {
+	return r.inner.NewEntity()
+}
+
+// ============================================================================
+// ReadRepository 인터페이스 구현 (캐시를 거치지 않음)
+// ============================================================================
+
+// FindOne 필터 조건으로 엔터티 조회
+func (r *CachingRepository[T, F, ID]) FindOne(ctx context.Context, filter F) (T, error) {
+	return r.inner.FindOne(ctx, filter)
+}
+
+// Exists 엔터티 존재 여부 확인
+func (r *CachingRepository[T, F, ID]) Exists(ctx context.Context, filter F) (bool, error) {
+	return r.inner.Exists(ctx, filter)
+}
+
+// GetVersion 엔터티의 현재 버전 조회
+func (r *CachingRepository[T, F, ID]) GetVersion(ctx context.Context, filter F) (int64, error) {
+	return r.inner.GetVersion(ctx, filter)
+}
+
+// ============================================================================
+// 캐시 헬퍼 메서드들
+// ============================================================================
+
+// resolveOptions CacheOptions를 기본값과 합쳐 캐시 키, TTL, 태그 결정
+func (r *CachingRepository[T, F, ID]) resolveOptions(id ID, opts *CacheOptions) (cacheKey[ID], time.Duration, []string) {
+	namespace := r.config.DefaultNamespace
+	ttl := r.config.DefaultTTL
+	var tags []string
+
+	if opts != nil {
+		if opts.Namespace != "" {
+			namespace = opts.Namespace
+		}
+		if opts.TTL > 0 {
+			ttl = time.Duration(opts.TTL) * time.Second
+		}
+		tags = append(tags, opts.Tags...)
+	}
+
+	return cacheKey[ID]{namespace: namespace, id: id}, ttl, tags
+}
+
+// invalidateEntity 쓰기 결과 엔터티의 캐시 무효화
+func (r *CachingRepository[T, F, ID]) invalidateEntity(entity T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.invalidateLocked(r.entityID(entity))
+}
+
+// invalidateLocked 모든 네임스페이스에서 ID의 캐시 제거 (r.mu 필요)
+func (r *CachingRepository[T, F, ID]) invalidateLocked(id ID) {
+	r.markInvalidatedLocked(id)
+	for namespace := range r.namespaces {
+		r.removeLocked(cacheKey[ID]{namespace: namespace, id: id})
+	}
+}
+
+// markInvalidatedLocked 진행 중인 읽기가 결과를 캐시하지 않도록 표시 (r.mu 필요)
+func (r *CachingRepository[T, F, ID]) markInvalidatedLocked(id ID) {
+	if pending, ok := r.loading[id]; ok {
+		pending.epoch++
+	}
+}
+
+// removeLocked 캐시 항목과 태그 인덱스 제거 (r.mu 필요)
+func (r *CachingRepository[T, F, ID]) removeLocked(key cacheKey[ID]) {
+	entry, ok := r.entries[key]
+	if !ok {
+		return
+	}
+
+	delete(r.entries, key)
+	for _, tag := range entry.tags {
+		delete(r.tags[tag], key)
+		if len(r.tags[tag]) == 0 {
+			delete(r.tags, tag)
+		}
+	}
+}
+
+// entityVersion 엔터티가 Versioned를 구현하면 버전 반환 (아니면 0)
+func entityVersion[T any](entity T) int64 {
+	if versioned, ok := any(entity).(Versioned); ok {
+		return versioned.GetVersion()
+	}
+	return 0
+}
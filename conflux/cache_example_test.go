@@ -0,0 +1,185 @@
+package conflux_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/homveloper/dukdakit/conflux"
+	memory "github.com/homveloper/dukdakit/conflux/adapters/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// 캐시 데코레이터 테스트용 헬퍼
+// ============================================================================
+
+// testClock 수동으로 움직이는 테스트용 시각 소스
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time { return c.now }
+
+// countingRepository FindOne 호출 횟수를 세는 레포지토리
+// afterFind가 있으면 읽은 값의 복사본을 돌려주기 직전에 한 번 실행합니다
+type countingRepository struct {
+	conflux.ReadWriteRepository[*User, conflux.MapFilter]
+	finds     int
+	afterFind func()
+}
+
+func (r *countingRepository) FindOne(ctx context.Context, filter conflux.MapFilter) (*User, error) {
+	r.finds++
+	user, err := r.ReadWriteRepository.FindOne(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := *user
+	if hook := r.afterFind; hook != nil {
+		r.afterFind = nil
+		hook()
+	}
+	return &snapshot, nil
+}
+
+func setupCachedUsers(t *testing.T, options ...conflux.CacheOption) (*countingRepository, *conflux.CachingRepository[*User, conflux.MapFilter, string]) {
+	inner := &countingRepository{
+		ReadWriteRepository: memory.NewMemoryRepository[*User](func() *User { return &User{} }),
+	}
+
+	_, err := inner.FindOneAndInsert(context.Background(), conflux.NewMapFilter().And("ID", "user1"),
+		conflux.NewCreateFunc(func(ctx context.Context) (*User, error) {
+			return &User{ID: "user1", Credits: 100}, nil
+		}))
+	require.NoError(t, err)
+
+	cached := conflux.NewCachingRepository[*User, conflux.MapFilter, string](inner,
+		func(id string) conflux.MapFilter { return conflux.NewMapFilter().And("ID", id) },
+		func(u *User) string { return u.ID },
+		options...)
+	return inner, cached
+}
+
+func addCredits(amount int) conflux.UpdateFunc[*User] {
+	return conflux.NewUpdateFunc(func(ctx context.Context, existing *User) (*User, error) {
+		existing.Credits += amount
+		return existing, nil
+	})
+}
+
+// ============================================================================
+// 캐시 데코레이터 테스트
+// ============================================================================
+
+func TestCachingRepository_ReadThroughAndTTL(t *testing.T) {
+	// Arrange
+	clock := &testClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	inner, cached := setupCachedUsers(t, conflux.WithCacheTTL(time.Minute), conflux.WithCacheClock(clock))
+	ctx := context.Background()
+
+	// Act & Assert - 두 번째 조회는 캐시에서
+	for i := 0; i < 2; i++ {
+		user, err := cached.FindByIDCached(ctx, "user1", nil)
+		require.NoError(t, err)
+		assert.Equal(t, 100, user.Credits)
+	}
+	assert.Equal(t, 1, inner.finds)
+
+	// 기본 TTL이 지나면 다시 읽음
+	clock.now = clock.now.Add(time.Minute)
+	_, err := cached.FindByIDCached(ctx, "user1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, inner.finds)
+
+	// 네임스페이스가 다르면 별도 항목, CacheOptions.TTL(초)이 기본값을 덮어씀
+	opts := &conflux.CacheOptions{Namespace: "profile", TTL: 5}
+	_, err = cached.FindByIDCached(ctx, "user1", opts)
+	require.NoError(t, err)
+	assert.Equal(t, 3, inner.finds)
+
+	clock.now = clock.now.Add(5 * time.Second)
+	_, err = cached.FindByIDCached(ctx, "user1", opts)
+	require.NoError(t, err)
+	assert.Equal(t, 4, inner.finds)
+}
+
+func TestCachingRepository_InvalidatesOnWrite(t *testing.T) {
+	// Arrange
+	inner, cached := setupCachedUsers(t)
+	ctx := context.Background()
+	filter := conflux.NewMapFilter().And("ID", "user1")
+
+	_, err := cached.FindByIDCached(ctx, "user1", &conflux.CacheOptions{Namespace: "a"})
+	require.NoError(t, err)
+	_, err = cached.FindByIDCached(ctx, "user1", &conflux.CacheOptions{Namespace: "b"})
+	require.NoError(t, err)
+
+	// Act
+	result, err := cached.FindOneAndUpdate(ctx, filter, 1, addCredits(50))
+	require.NoError(t, err)
+	require.True(t, result.IsSuccess())
+
+	// Assert - 모든 네임스페이스에서 무효화됨
+	user, err := cached.FindByIDCached(ctx, "user1", &conflux.CacheOptions{Namespace: "a"})
+	require.NoError(t, err)
+	assert.Equal(t, 150, user.Credits)
+	user, err = cached.FindByIDCached(ctx, "user1", &conflux.CacheOptions{Namespace: "b"})
+	require.NoError(t, err)
+	assert.Equal(t, 150, user.Credits)
+	assert.Equal(t, 4, inner.finds)
+
+	// 삭제 후에는 캐시된 값이 남지 않음
+	_, err = cached.FindOneAndDelete(ctx, filter, 2)
+	require.NoError(t, err)
+	_, err = cached.FindByIDCached(ctx, "user1", nil)
+	assert.Error(t, err)
+}
+
+func TestCachingRepository_InvalidateByTags(t *testing.T) {
+	// Arrange
+	inner, cached := setupCachedUsers(t)
+	ctx := context.Background()
+	opts := &conflux.CacheOptions{Tags: []string{"guild:7", "region:kr"}}
+
+	_, err := cached.FindByIDCached(ctx, "user1", opts)
+	require.NoError(t, err)
+
+	// Act
+	require.NoError(t, cached.InvalidateCacheByTags(ctx, []string{"guild:7"}))
+
+	// Assert
+	_, err = cached.FindByIDCached(ctx, "user1", opts)
+	require.NoError(t, err)
+	assert.Equal(t, 2, inner.finds)
+
+	require.NoError(t, cached.InvalidateCache(ctx, "user1"))
+	_, err = cached.FindByIDCached(ctx, "user1", opts)
+	require.NoError(t, err)
+	assert.Equal(t, 3, inner.finds)
+}
+
+func TestCachingRepository_DoesNotCacheStaleRead(t *testing.T) {
+	// Arrange
+	inner, cached := setupCachedUsers(t)
+	ctx := context.Background()
+
+	// 읽기가 끝나기 직전에 다른 쓰기가 끼어듦
+	inner.afterFind = func() {
+		_, err := cached.FindOneAndUpdate(ctx, conflux.NewMapFilter().And("ID", "user1"), 1, addCredits(1))
+		require.NoError(t, err)
+	}
+
+	// Act - 첫 조회는 쓰기 이전 값을 돌려받음
+	stale, err := cached.FindByIDCached(ctx, "user1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stale.Version)
+
+	// Assert - 오래된 값은 캐시되지 않았으므로 다시 읽어 최신 버전을 얻음
+	fresh, err := cached.FindByIDCached(ctx, "user1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), fresh.Version)
+	assert.Equal(t, 101, fresh.Credits)
+	assert.Equal(t, 2, inner.finds)
+}
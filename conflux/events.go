@@ -0,0 +1,907 @@
+package conflux
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ============================================================================
+// 이벤트 발행 데코레이터 (EventAwareRepository 구현)
+// ============================================================================
+
+// 엔터티 이벤트 타입
+const (
+	EventCreated  = "created"  // FindOneAndInsert로 생성됨
+	EventUpdated  = "updated"  // FindOneAndUpdate로 업데이트됨
+	EventUpserted = "upserted" // FindOneAndUpsert로 생성 또는 업데이트됨 (Metadata["created"]로 구분)
+	EventDeleted  = "deleted"  // FindOneAndDelete로 삭제됨 (Metadata["soft"]로 소프트 삭제 구분)
+)
+
+// ErrEventDelivery 이벤트 핸들러가 이벤트를 처리하지 못한 경우
+// 쓰기는 이미 적용된 상태이므로 결과와 함께 반환됩니다
+var ErrEventDelivery = errors.New("event delivery failed")
+
+// ErrEventsClosed Close 이후 핸들러를 등록하거나 비동기 이벤트를 보내려는 경우
+var ErrEventsClosed = errors.New("event repository is closed")
+
+// EventDeliveryError 특정 이벤트의 전달 실패 정보
+// errors.Is(err, ErrEventDelivery)로 확인할 수 있습니다
+type EventDeliveryError struct {
+	EventID   string
+	EventType string
+	Version   int64
+	Err       error
+}
+
+func (e *EventDeliveryError) Error() string {
+	return fmt.Sprintf("%s event %s (version %d): %v", e.EventType, e.EventID, e.Version, e.Err)
+}
+
+// Unwrap 원인 에러 반환
+func (e *EventDeliveryError) Unwrap() error {
+	return e.Err
+}
+
+// Is ErrEventDelivery와 비교
+func (e *EventDeliveryError) Is(target error) bool {
+	return target == ErrEventDelivery
+}
+
+// EventHandlerFunc 함수형 이벤트 핸들러
+type EventHandlerFunc[T any] func(ctx context.Context, event *EntityEvent[T]) error
+
+// funcEventHandler 함수를 EventHandler로 래핑 (포인터라서 등록 해제 시 비교 가능)
+type funcEventHandler[T any] struct {
+	fn EventHandlerFunc[T]
+}
+
+// HandleEvent EventHandler 인터페이스 구현
+func (h *funcEventHandler[T]) HandleEvent(ctx context.Context, event *EntityEvent[T]) error {
+	return h.fn(ctx, event)
+}
+
+// NewEventHandler 함수를 EventHandler로 변환
+// 반환된 핸들러를 보관해 두면 UnregisterEventHandler로 해제할 수 있습니다
+func NewEventHandler[T any](fn EventHandlerFunc[T]) EventHandler[T] {
+	return &funcEventHandler[T]{fn: fn}
+}
+
+// ============================================================================
+// 핸들러 옵션
+// ============================================================================
+
+// EventErrorPolicy 핸들러 에러 처리 정책
+type EventErrorPolicy int
+
+const (
+	// ContinueOnEventError 에러를 OnError 콜백에 알리고 계속 진행 (기본값)
+	ContinueOnEventError EventErrorPolicy = iota
+
+	// FailOnEventError 동기 핸들러 에러를 쓰기 호출자에게 반환
+	// 쓰기는 이미 적용되었으므로 결과와 ErrEventDelivery 에러가 함께 반환됩니다
+	// 비동기 핸들러에서는 ContinueOnEventError와 같습니다
+	FailOnEventError
+
+	// RetryOnEventError MaxRetries만큼 재시도한 뒤에도 실패하면 OnError 콜백에 알림
+	RetryOnEventError
+)
+
+// HandlerConfig 이벤트 핸들러 설정
+type HandlerConfig struct {
+	// Async true이면 전용 고루틴에서 순서대로 처리 (쓰기 호출을 막지 않음)
+	Async bool
+
+	// ErrorPolicy 핸들러 에러 처리 정책
+	ErrorPolicy EventErrorPolicy
+
+	// MaxRetries RetryOnEventError의 최대 재시도 횟수 (기본값: 3)
+	MaxRetries int
+
+	// RetryDelay 재시도 간격
+	RetryDelay time.Duration
+
+	// QueueSize 비동기 핸들러의 대기열 크기 (기본값: 256)
+	// 대기열이 가득 차면 쓰기 호출이 자리가 날 때까지 기다립니다
+	QueueSize int
+}
+
+// HandlerOption 이벤트 핸들러 등록 옵션
+type HandlerOption func(*HandlerConfig)
+
+// WithAsyncDelivery 핸들러를 비동기로 실행
+func WithAsyncDelivery() HandlerOption {
+	return func(config *HandlerConfig) {
+		config.Async = true
+	}
+}
+
+// WithEventErrorPolicy 핸들러 에러 처리 정책 설정
+func WithEventErrorPolicy(policy EventErrorPolicy) HandlerOption {
+	return func(config *HandlerConfig) {
+		config.ErrorPolicy = policy
+	}
+}
+
+// WithEventRetries 재시도 정책과 재시도 횟수, 간격 설정
+func WithEventRetries(maxRetries int, delay time.Duration) HandlerOption {
+	return func(config *HandlerConfig) {
+		config.ErrorPolicy = RetryOnEventError
+		config.MaxRetries = maxRetries
+		config.RetryDelay = delay
+	}
+}
+
+// WithEventQueueSize 비동기 핸들러의 대기열 크기 설정
+func WithEventQueueSize(size int) HandlerOption {
+	return func(config *HandlerConfig) {
+		config.QueueSize = size
+	}
+}
+
+// ============================================================================
+// 레포지토리 옵션
+// ============================================================================
+
+// EventConfig 이벤트 데코레이터 설정
+type EventConfig struct {
+	// Clock 이벤트 시각 기록용 시각 소스 (기본값: 시스템 시간)
+	Clock Clock
+
+	// OnError 정책상 호출자에게 반환되지 않는 핸들러 에러를 받는 콜백
+	OnError func(ctx context.Context, err *EventDeliveryError)
+
+	// Outbox true이면 이벤트를 엔터티와 같은 쓰기에 아웃박스 레코드로 저장
+	Outbox bool
+
+	// DeliveredRetention 전달 완료된 이벤트 ID를 기억하는 기간 (기본값: 1시간)
+	// 그 사이 같은 엔터티에 쓰기가 없으면 ID를 잊으므로 Redeliver가 다시 발행할 수 있습니다
+	DeliveredRetention time.Duration
+}
+
+// EventOption 이벤트 데코레이터 생성 옵션
+type EventOption func(*EventConfig)
+
+// WithEventClock 이벤트 시각 기록에 사용할 시각 소스 설정
+func WithEventClock(clock Clock) EventOption {
+	return func(config *EventConfig) {
+		config.Clock = clock
+	}
+}
+
+// WithEventErrorHandler 핸들러 에러 콜백 설정
+func WithEventErrorHandler(onError func(ctx context.Context, err *EventDeliveryError)) EventOption {
+	return func(config *EventConfig) {
+		config.OnError = onError
+	}
+}
+
+// WithDeliveredRetention 전달 완료된 이벤트 ID를 기억하는 기간 설정
+func WithDeliveredRetention(retention time.Duration) EventOption {
+	return func(config *EventConfig) {
+		config.DeliveredRetention = retention
+	}
+}
+
+// WithOutbox 트랜잭셔널 아웃박스 모드 활성화
+// 엔터티가 OutboxCarrier를 구현해야 하며, 이벤트 레코드가 엔터티 쓰기와 원자적으로 저장됩니다
+// 소프트 삭제 이벤트는 툼스톤의 아웃박스에 저장됩니다
+// 하드 삭제 이벤트는 저장할 엔터티가 없으므로 메모리에서만 발행되며, 삭제 직후 프로세스가 죽으면 유실됩니다
+func WithOutbox() EventOption {
+	return func(config *EventConfig) {
+		config.Outbox = true
+	}
+}
+
+// ============================================================================
+// 트랜잭셔널 아웃박스
+// ============================================================================
+
+// OutboxRecord 엔터티에 함께 저장되는 미전달 이벤트 레코드
+type OutboxRecord struct {
+	EventID    string         `json:"event_id" bson:"event_id"`
+	Type       string         `json:"type" bson:"type"`
+	Version    int64          `json:"version" bson:"version"`
+	OccurredAt time.Time      `json:"occurred_at" bson:"occurred_at"`
+	Metadata   map[string]any `json:"metadata,omitempty" bson:"metadata,omitempty"`
+}
+
+// OutboxCarrier 아웃박스 레코드를 저장할 수 있는 엔터티 인터페이스
+type OutboxCarrier interface {
+	GetOutbox() []OutboxRecord
+	SetOutbox(records []OutboxRecord)
+}
+
+// Outbox OutboxCarrier의 기본 구현체
+// BaseEntity와 함께 엔터티에 임베드하여 사용할 수 있습니다
+type Outbox struct {
+	PendingEvents []OutboxRecord `json:"pending_events,omitempty" bson:"pending_events,omitempty"`
+}
+
+// GetOutbox 저장된 아웃박스 레코드 반환
+func (o *Outbox) GetOutbox() []OutboxRecord {
+	return o.PendingEvents
+}
+
+// SetOutbox 아웃박스 레코드 설정
+func (o *Outbox) SetOutbox(records []OutboxRecord) {
+	o.PendingEvents = records
+}
+
+// ============================================================================
+// EventingRepository
+// ============================================================================
+
+// 컴파일 타임 인터페이스 검사
+var _ EventAwareRepository[any, MapFilter] = (*EventingRepository[any, MapFilter])(nil)
+
+// EventingRepository 쓰기가 성공할 때마다 엔터티 이벤트를 발행하는 레포지토리 데코레이터
+// 동기 핸들러는 쓰기 호출 안에서 등록 순서대로, 비동기 핸들러는 핸들러별 고루틴에서 순서대로 실행됩니다
+//
+// 아웃박스 모드(WithOutbox)에서는 생성/업데이트/업서트 이벤트가 엔터티의 아웃박스에
+// 같은 쓰기로 저장되므로, 쓰기 직후 프로세스가 죽어도 이벤트가 사라지지 않습니다
+//   - 모든 핸들러가 성공한 레코드는 전달 완료로 표시되고 같은 엔터티의 다음 쓰기에서 제거됩니다
+//   - 재시작 후에는 Redeliver로 남은 레코드를 다시 발행합니다 (최소 한 번 전달, 핸들러는 event.ID로 중복 제거)
+//   - 전달 완료 표시는 DeliveredRetention 동안만 메모리에 남으므로 오래 쓰기가 없는 엔터티의 이벤트는 다시 발행될 수 있습니다
+//   - 소프트 삭제 이벤트는 툼스톤의 아웃박스에 저장되지만, 툼스톤은 조회에서 제외되므로 Redeliver로는 다시 발행되지 않습니다
+//   - 하드 삭제는 엔터티와 함께 아웃박스도 사라지므로 삭제 이벤트는 저장되지 않습니다 (최대 한 번 전달)
+//
+// Example usage:
+//
+//	events := conflux.NewEventingRepository[*Mail, conflux.MapFilter](repo, conflux.WithOutbox())
+//	events.RegisterEventHandler(conflux.NewEventHandler(func(ctx context.Context, e *conflux.EntityEvent[*Mail]) error {
+//	    return notifier.Publish(ctx, e.ID, e.Type, e.Entity)
+//	}))
+//	events.RegisterEventHandlerWithOptions(auditHandler, conflux.WithAsyncDelivery(), conflux.WithEventRetries(3, time.Second))
+//	defer events.Close(ctx)
+type EventingRepository[T any, F any] struct {
+	inner  ReadWriteRepository[T, F]
+	config *EventConfig
+	clock  Clock
+
+	mu       sync.RWMutex
+	handlers []*registeredHandler[T]
+	closed   bool
+
+	deliveredMu    sync.Mutex
+	delivered      map[string]time.Time // 전달 완료되었지만 아직 아웃박스에서 제거되지 않은 이벤트 ID와 전달 시각
+	deliveredOrder []string             // 전달 순서 (보존 기간이 지난 ID 정리용)
+}
+
+// registeredHandler 등록된 핸들러와 비동기 대기열
+type registeredHandler[T any] struct {
+	handler EventHandler[T]
+	config  HandlerConfig
+
+	mu      sync.Mutex
+	queue   chan asyncEvent[T]
+	stopped bool
+	done    chan struct{}
+}
+
+// asyncEvent 비동기 핸들러 대기열 항목
+type asyncEvent[T any] struct {
+	ctx     context.Context
+	event   *EntityEvent[T]
+	tracker *deliveryTracker
+}
+
+// deliveryTracker 한 이벤트의 모든 핸들러 처리 완료를 추적
+type deliveryTracker struct {
+	remaining   atomic.Int32
+	failed      atomic.Bool
+	onDelivered func()
+}
+
+// done 핸들러 하나의 처리 결과 기록 (nil 트래커 허용)
+func (t *deliveryTracker) done(err error) {
+	if t == nil {
+		return
+	}
+	if err != nil {
+		t.failed.Store(true)
+	}
+	if t.remaining.Add(-1) == 0 && !t.failed.Load() {
+		t.onDelivered()
+	}
+}
+
+// NewEventingRepository 새 이벤트 데코레이터 생성
+func NewEventingRepository[T any, F any](inner ReadWriteRepository[T, F], options ...EventOption) *EventingRepository[T, F] {
+	config := &EventConfig{DeliveredRetention: time.Hour}
+	for _, opt := range options {
+		opt(config)
+	}
+
+	return &EventingRepository[T, F]{
+		inner:     inner,
+		config:    config,
+		clock:     ClockOrSystem(config.Clock),
+		delivered: make(map[string]time.Time),
+	}
+}
+
+// ============================================================================
+// EventAwareRepository 인터페이스 구현
+// ============================================================================
+
+// RegisterEventHandler 동기 핸들러 등록 (ContinueOnEventError 정책)
+func (r *EventingRepository[T, F]) RegisterEventHandler(handler EventHandler[T]) error {
+	return r.RegisterEventHandlerWithOptions(handler)
+}
+
+// RegisterEventHandlerWithOptions 옵션과 함께 핸들러 등록
+func (r *EventingRepository[T, F]) RegisterEventHandlerWithOptions(handler EventHandler[T], options ...HandlerOption) error {
+	if handler == nil {
+		return fmt.Errorf("event handler must not be nil")
+	}
+
+	config := HandlerConfig{
+		MaxRetries: 3,
+		QueueSize:  256,
+	}
+	for _, opt := range options {
+		opt(&config)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return ErrEventsClosed
+	}
+
+	registered := &registeredHandler[T]{handler: handler, config: config}
+	if config.Async {
+		registered.queue = make(chan asyncEvent[T], max(config.QueueSize, 1))
+		registered.done = make(chan struct{})
+		go r.runAsync(registered)
+	}
+
+	r.handlers = append(r.handlers, registered)
+	return nil
+}
+
+// UnregisterEventHandler 핸들러 해제
+// 비동기 핸들러는 이미 대기열에 있는 이벤트를 모두 처리한 뒤 종료됩니다
+func (r *EventingRepository[T, F]) UnregisterEventHandler(handler EventHandler[T]) error {
+	if handler == nil || !reflect.TypeOf(handler).Comparable() {
+		return fmt.Errorf("event handler is not comparable; register a pointer or use NewEventHandler")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, registered := range r.handlers {
+		if registered.handler == handler {
+			r.handlers = append(r.handlers[:i:i], r.handlers[i+1:]...)
+			registered.stop()
+			return nil
+		}
+	}
+	return fmt.Errorf("event handler not registered")
+}
+
+// Close 비동기 핸들러들이 대기열을 모두 처리할 때까지 기다림
+// ctx가 먼저 끝나면 ctx.Err()를 반환합니다. 이후의 쓰기는 동기 핸들러에만 전달됩니다
+func (r *EventingRepository[T, F]) Close(ctx context.Context) error {
+	r.mu.Lock()
+	r.closed = true
+	var async []*registeredHandler[T]
+	for _, registered := range r.handlers {
+		if registered.config.Async {
+			registered.stop()
+			async = append(async, registered)
+		}
+	}
+	r.mu.Unlock()
+
+	for _, registered := range async {
+		select {
+		case <-registered.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Redeliver 필터에 맞는 엔터티들의 아웃박스에서 전달 완료되지 않은 이벤트를 다시 발행
+// 재시작 직후나 주기적인 스윕에서 호출합니다. 재발행된 이벤트의 Entity는 현재 상태이며 OldEntity는 없습니다
+//   - 내부 레포지토리가 FindMany를 제공하면(BatchRepository 등) FindMany(filter, limit)로 읽은 모든 엔터티를 스윕합니다
+//     (limit이 0 이하이면 제한 없음, 아웃박스가 비어 있는 엔터티도 limit에 포함되므로 가능하면 필터로 좁히세요)
+//   - 그렇지 않으면 FindOne으로 찾은 엔터티 하나만 처리합니다
+//
+// 반환값: 다시 발행한 이벤트 수
+func (r *EventingRepository[T, F]) Redeliver(ctx context.Context, filter F, limit int) (int, error) {
+	entities, err := r.findPending(ctx, filter, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	var errs []error
+	for _, entity := range entities {
+		carrier, ok := any(entity).(OutboxCarrier)
+		if !ok {
+			continue
+		}
+
+		for _, record := range carrier.GetOutbox() {
+			if r.isDelivered(record.EventID) {
+				continue
+			}
+
+			event := &EntityEvent[T]{
+				ID:         record.EventID,
+				Type:       record.Type,
+				Entity:     entity,
+				Version:    record.Version,
+				OccurredAt: record.OccurredAt,
+				Metadata:   record.Metadata,
+			}
+			if err := r.emit(ctx, event, true); err != nil {
+				errs = append(errs, err)
+			}
+			count++
+		}
+	}
+
+	return count, errors.Join(errs...)
+}
+
+// manyFinder BatchRepository의 조회 부분 (InsertMany 없이 FindMany만 제공하는 어댑터 포함)
+type manyFinder[T any, F any] interface {
+	FindMany(ctx context.Context, filter F, limit int) ([]T, error)
+}
+
+// findPending Redeliver가 스윕할 엔터티들 조회
+func (r *EventingRepository[T, F]) findPending(ctx context.Context, filter F, limit int) ([]T, error) {
+	if finder, ok := r.inner.(manyFinder[T, F]); ok {
+		return finder.FindMany(ctx, filter, limit)
+	}
+
+	entity, err := r.inner.FindOne(ctx, filter)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []T{entity}, nil
+}
+
+// ============================================================================
+// Repository 인터페이스 구현 (쓰기 후 이벤트 발행)
+// ============================================================================
+
+// FindOneAndInsert 엔터티 생성 후 created 이벤트 발행
+func (r *EventingRepository[T, F]) FindOneAndInsert(ctx context.Context, duplicateCheckFilter F, createFunc CreateFunc[T], options ...InsertOption) (*InsertResult[T], error) {
+	config := NewInsertConfig()
+	for _, opt := range options {
+		opt(config)
+	}
+
+	capture := &writeCapture[T]{}
+	result, err := r.inner.FindOneAndInsert(ctx, duplicateCheckFilter, r.wrapCreate(createFunc, EventCreated, capture, config.Metadata), options...)
+	if err != nil || !result.IsSuccess() {
+		return result, err
+	}
+
+	event := r.newEvent(capture, EventCreated, result.Entity, nil, result.Version, config.Metadata)
+	return result, r.emit(ctx, event, capture.record != nil)
+}
+
+// FindOneAndUpsert 엔터티 생성/업데이트 후 upserted 이벤트 발행
+func (r *EventingRepository[T, F]) FindOneAndUpsert(ctx context.Context, lookupFilter F, upsertFunc UpsertFunc[T], options ...UpsertOption) (*UpsertResult[T], error) {
+	config := NewUpsertConfig()
+	for _, opt := range options {
+		opt(config)
+	}
+
+	capture := &writeCapture[T]{}
+	wrapped := NewUpsertFunc(
+		r.wrapCreate(upsertFunc, EventUpserted, capture, config.Metadata).CreateFn,
+		r.wrapUpdate(upsertFunc, EventUpserted, capture, config.Metadata).UpdateFn,
+	)
+
+	result, err := r.inner.FindOneAndUpsert(ctx, lookupFilter, wrapped, options...)
+	if err != nil {
+		return result, err
+	}
+
+	event := r.newEvent(capture, EventUpserted, result.Entity, capture.old, result.Version, config.Metadata)
+	event.Metadata["created"] = result.Created
+	return result, r.emit(ctx, event, capture.record != nil)
+}
+
+// FindOneAndUpdate 엔터티 업데이트 후 updated 이벤트 발행
+func (r *EventingRepository[T, F]) FindOneAndUpdate(ctx context.Context, lookupFilter F, expectedVersion int64, updateFunc UpdateFunc[T], options ...UpdateOption) (*UpdateResult[T], error) {
+	config := NewUpdateConfig()
+	for _, opt := range options {
+		opt(config)
+	}
+
+	capture := &writeCapture[T]{}
+	result, err := r.inner.FindOneAndUpdate(ctx, lookupFilter, expectedVersion, r.wrapUpdate(updateFunc, EventUpdated, capture, config.Metadata), options...)
+	if err != nil || !result.IsSuccess() {
+		return result, err
+	}
+
+	event := r.newEvent(capture, EventUpdated, result.Entity, capture.old, result.Version, config.Metadata)
+	return result, r.emit(ctx, event, capture.record != nil)
+}
+
+// FindOneAndDelete 엔터티 삭제 후 deleted 이벤트 발행
+// 소프트 삭제에서는 툼스톤에 쓰기 직전의 엔터티가 OldEntity가 되고, 아웃박스 모드이면 레코드가 툼스톤에 저장됩니다
+// 하드 삭제에서는 삭제 전에 따로 읽은 값이 expectedVersion과 같을 때만 OldEntity로 채워집니다
+func (r *EventingRepository[T, F]) FindOneAndDelete(ctx context.Context, lookupFilter F, expectedVersion int64, options ...DeleteOption) (*DeleteResult[T], error) {
+	config := NewDeleteConfig()
+	for _, opt := range options {
+		opt(config)
+	}
+
+	capture := &writeCapture[T]{}
+	if config.Soft {
+		options = append(options, r.wrapTombstone(config.TombstoneUpdate, capture, config.Metadata))
+	} else if current, err := r.inner.FindOne(ctx, lookupFilter); err == nil && entityVersion(current) == expectedVersion {
+		// 조회와 삭제 사이에 다른 쓰기가 끼어들면 버전이 달라지므로 삭제된 버전일 때만 사용
+		snapshot := cloneShallow(current)
+		capture.old = &snapshot
+	}
+
+	result, err := r.inner.FindOneAndDelete(ctx, lookupFilter, expectedVersion, options...)
+	if err != nil || !result.IsSuccess() {
+		return result, err
+	}
+
+	event := r.newEvent(capture, EventDeleted, result.Entity, capture.old, result.Version, config.Metadata)
+	event.Metadata["soft"] = result.SoftDeleted
+	return result, r.emit(ctx, event, capture.record != nil)
+}
+
+// NewEntity 새 엔터티 인스턴스 생성
+func (r *EventingRepository[T, F]) NewEntity() T {
+	return r.inner.NewEntity()
+}
+
+// ============================================================================
+// ReadRepository 인터페이스 구현
+// ============================================================================
+
+// FindOne 필터 조건으로 엔터티 조회
+func (r *EventingRepository[T, F]) FindOne(ctx context.Context, filter F) (T, error) {
+	return r.inner.FindOne(ctx, filter)
+}
+
+// Exists 엔터티 존재 여부 확인
+func (r *EventingRepository[T, F]) Exists(ctx context.Context, filter F) (bool, error) {
+	return r.inner.Exists(ctx, filter)
+}
+
+// GetVersion 엔터티의 현재 버전 조회
+func (r *EventingRepository[T, F]) GetVersion(ctx context.Context, filter F) (int64, error) {
+	return r.inner.GetVersion(ctx, filter)
+}
+
+// ============================================================================
+// 이벤트 헬퍼 메서드들
+// ============================================================================
+
+// writeCapture 쓰기 함수 안에서 관찰한 이전 엔터티와 아웃박스 레코드
+type writeCapture[T any] struct {
+	old    *T
+	record *OutboxRecord
+}
+
+// wrapCreate 생성 로직을 감싸 아웃박스 레코드를 추가
+func (r *EventingRepository[T, F]) wrapCreate(fn CreateFunc[T], eventType string, capture *writeCapture[T], metadata map[string]any) CreateFunc[T] {
+	return NewCreateFunc(func(ctx context.Context) (T, error) {
+		entity, err := fn.CreateFn(ctx)
+		if err != nil {
+			return entity, err
+		}
+
+		capture.old = nil
+		r.appendOutbox(entity, eventType, 1, metadata, capture)
+		return entity, nil
+	})
+}
+
+// wrapUpdate 업데이트 로직을 감싸 이전 엔터티를 보관하고 아웃박스 레코드를 추가
+func (r *EventingRepository[T, F]) wrapUpdate(fn UpdateFunc[T], eventType string, capture *writeCapture[T], metadata map[string]any) UpdateFunc[T] {
+	return NewUpdateFunc(func(ctx context.Context, existing T) (T, error) {
+		// 업데이트 로직이 existing을 직접 변경할 수 있으므로 먼저 복사
+		old := cloneShallow(existing)
+
+		updated, err := fn.UpdateFn(ctx, existing)
+		if err != nil {
+			return updated, err
+		}
+
+		capture.old = &old
+		r.appendOutbox(updated, eventType, entityVersion(old)+1, metadata, capture)
+		return updated, nil
+	})
+}
+
+// wrapTombstone 툼스톤 변경 함수를 감싸 이전 엔터티를 보관하고 아웃박스 레코드를 추가
+func (r *EventingRepository[T, F]) wrapTombstone(fn func(entity any), capture *writeCapture[T], metadata map[string]any) DeleteOption {
+	return WithTombstoneUpdate(func(entity any) {
+		tombstone, ok := entity.(T)
+		if !ok {
+			if fn != nil {
+				fn(entity)
+			}
+			return
+		}
+
+		// 호출자의 변경 함수가 엔터티를 직접 변경할 수 있으므로 먼저 복사
+		old := cloneShallow(tombstone)
+		if fn != nil {
+			fn(entity)
+		}
+
+		capture.old = &old
+		r.appendOutbox(tombstone, EventDeleted, entityVersion(old)+1, metadata, capture)
+	})
+}
+
+// appendOutbox 아웃박스 모드에서 엔터티에 이벤트 레코드를 추가하고 전달 완료된 레코드는 제거
+func (r *EventingRepository[T, F]) appendOutbox(entity T, eventType string, version int64, metadata map[string]any, capture *writeCapture[T]) {
+	capture.record = nil
+	if !r.config.Outbox {
+		return
+	}
+
+	carrier, ok := any(entity).(OutboxCarrier)
+	if !ok {
+		return
+	}
+
+	record := OutboxRecord{
+		EventID:    r.newEventID(),
+		Type:       eventType,
+		Version:    version,
+		OccurredAt: r.clock.Now(),
+		Metadata:   copyMetadata(metadata),
+	}
+	carrier.SetOutbox(append(r.pruneDelivered(carrier.GetOutbox()), record))
+	capture.record = &record
+}
+
+// newEvent 쓰기 결과로 이벤트 생성 (아웃박스 레코드가 있으면 같은 ID와 시각 사용)
+func (r *EventingRepository[T, F]) newEvent(capture *writeCapture[T], eventType string, entity T, old *T, version int64, metadata map[string]any) *EntityEvent[T] {
+	event := &EntityEvent[T]{
+		Type:      eventType,
+		Entity:    entity,
+		OldEntity: old,
+		Version:   version,
+		Metadata:  copyMetadata(metadata),
+	}
+
+	if capture.record != nil {
+		event.ID = capture.record.EventID
+		event.OccurredAt = capture.record.OccurredAt
+	} else {
+		event.ID = r.newEventID()
+		event.OccurredAt = r.clock.Now()
+	}
+	return event
+}
+
+// emit 등록된 모든 핸들러에 이벤트 전달
+// persisted가 true이면 모든 핸들러가 성공했을 때 아웃박스 레코드를 전달 완료로 표시합니다
+func (r *EventingRepository[T, F]) emit(ctx context.Context, event *EntityEvent[T], persisted bool) error {
+	r.mu.RLock()
+	handlers := make([]*registeredHandler[T], len(r.handlers))
+	copy(handlers, r.handlers)
+	r.mu.RUnlock()
+
+	var tracker *deliveryTracker
+	if persisted {
+		if len(handlers) == 0 {
+			r.markDelivered(event.ID)
+		} else {
+			tracker = &deliveryTracker{onDelivered: func() { r.markDelivered(event.ID) }}
+			tracker.remaining.Store(int32(len(handlers)))
+		}
+	}
+
+	var errs []error
+	for _, registered := range handlers {
+		if registered.config.Async {
+			item := asyncEvent[T]{ctx: context.WithoutCancel(ctx), event: event, tracker: tracker}
+			if err := registered.enqueue(ctx, item); err != nil {
+				tracker.done(err)
+				r.reportError(ctx, r.deliveryError(event, err))
+			}
+			continue
+		}
+
+		err := r.deliver(ctx, registered, event)
+		tracker.done(err)
+		if err == nil {
+			continue
+		}
+
+		deliveryErr := r.deliveryError(event, err)
+		if registered.config.ErrorPolicy == FailOnEventError {
+			errs = append(errs, deliveryErr)
+		} else {
+			r.reportError(ctx, deliveryErr)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// deliver 정책에 따라 재시도하며 핸들러 하나에 이벤트 전달
+func (r *EventingRepository[T, F]) deliver(ctx context.Context, registered *registeredHandler[T], event *EntityEvent[T]) error {
+	attempts := 1
+	if registered.config.ErrorPolicy == RetryOnEventError {
+		attempts += max(registered.config.MaxRetries, 0)
+	}
+
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 && registered.config.RetryDelay > 0 {
+			timer := time.NewTimer(registered.config.RetryDelay)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return errors.Join(err, ctx.Err())
+			}
+		}
+
+		if err = registered.handler.HandleEvent(ctx, event); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// runAsync 비동기 핸들러의 대기열 처리 루프
+func (r *EventingRepository[T, F]) runAsync(registered *registeredHandler[T]) {
+	defer close(registered.done)
+
+	for item := range registered.queue {
+		err := r.deliver(item.ctx, registered, item.event)
+		item.tracker.done(err)
+		if err != nil {
+			r.reportError(item.ctx, r.deliveryError(item.event, err))
+		}
+	}
+}
+
+// enqueue 비동기 대기열에 이벤트 추가 (가득 차면 ctx가 끝날 때까지 대기)
+func (h *registeredHandler[T]) enqueue(ctx context.Context, item asyncEvent[T]) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.stopped {
+		return ErrEventsClosed
+	}
+
+	select {
+	case h.queue <- item:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// stop 비동기 대기열을 닫아 워커가 남은 이벤트를 처리한 뒤 종료하게 함
+func (h *registeredHandler[T]) stop() {
+	if !h.config.Async {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.stopped {
+		h.stopped = true
+		close(h.queue)
+	}
+}
+
+// deliveryError 이벤트 정보를 담은 전달 에러 생성
+func (r *EventingRepository[T, F]) deliveryError(event *EntityEvent[T], err error) *EventDeliveryError {
+	return &EventDeliveryError{
+		EventID:   event.ID,
+		EventType: event.Type,
+		Version:   event.Version,
+		Err:       err,
+	}
+}
+
+// reportError OnError 콜백에 에러 전달
+func (r *EventingRepository[T, F]) reportError(ctx context.Context, err *EventDeliveryError) {
+	if r.config.OnError != nil {
+		r.config.OnError(ctx, err)
+	}
+}
+
+// markDelivered 아웃박스 레코드를 전달 완료로 표시하고 보존 기간이 지난 ID를 정리
+func (r *EventingRepository[T, F]) markDelivered(eventID string) {
+	now := r.clock.Now()
+
+	r.deliveredMu.Lock()
+	defer r.deliveredMu.Unlock()
+	r.delivered[eventID] = now
+	r.deliveredOrder = append(r.deliveredOrder, eventID)
+
+	// 전달 순서대로 쌓이므로 앞쪽부터 보존 기간이 지났거나 이미 정리된 ID를 제거
+	for len(r.deliveredOrder) > 0 {
+		oldest := r.deliveredOrder[0]
+		if deliveredAt, ok := r.delivered[oldest]; ok {
+			if now.Sub(deliveredAt) < r.config.DeliveredRetention {
+				break
+			}
+			delete(r.delivered, oldest)
+		}
+		r.deliveredOrder = r.deliveredOrder[1:]
+	}
+}
+
+// isDelivered 아웃박스 레코드가 전달 완료되었는지 확인
+func (r *EventingRepository[T, F]) isDelivered(eventID string) bool {
+	r.deliveredMu.Lock()
+	defer r.deliveredMu.Unlock()
+	_, ok := r.delivered[eventID]
+	return ok
+}
+
+// pruneDelivered 전달 완료된 레코드를 제거한 새 슬라이스 반환
+func (r *EventingRepository[T, F]) pruneDelivered(records []OutboxRecord) []OutboxRecord {
+	r.deliveredMu.Lock()
+	defer r.deliveredMu.Unlock()
+
+	pending := make([]OutboxRecord, 0, len(records)+1)
+	for _, record := range records {
+		if _, ok := r.delivered[record.EventID]; ok {
+			delete(r.delivered, record.EventID)
+			continue
+		}
+		pending = append(pending, record)
+	}
+	return pending
+}
+
+// newEventID 무작위 이벤트 ID 생성 (난수를 읽지 못하면 주입된 시각 소스 사용)
+func (r *EventingRepository[T, F]) newEventID() string {
+	var buf [16]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return fmt.Sprintf("%x", r.clock.Now().UnixNano())
+	}
+	return hex.EncodeToString(buf[:])
+}
+
+// copyMetadata 핸들러가 연산 설정을 변경하지 못하도록 메타데이터 복사
+func copyMetadata(metadata map[string]any) map[string]any {
+	copied := make(map[string]any, len(metadata)+1)
+	for k, v := range metadata {
+		copied[k] = v
+	}
+	return copied
+}
+
+// cloneShallow 포인터 엔터티의 얕은 복사본 생성
+func cloneShallow[T any](entity T) T {
+	value := reflect.ValueOf(entity)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return entity
+	}
+
+	clone := reflect.New(value.Elem().Type())
+	clone.Elem().Set(value.Elem())
+	return clone.Interface().(T)
+}
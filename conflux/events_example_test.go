@@ -0,0 +1,326 @@
+package conflux_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/homveloper/dukdakit/conflux"
+	memory "github.com/homveloper/dukdakit/conflux/adapters/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// 이벤트 데코레이터 테스트용 헬퍼
+// ============================================================================
+
+// Mail 아웃박스를 가진 테스트 엔터티
+type Mail struct {
+	conflux.BaseEntity
+	conflux.Outbox
+	ID      string `json:"id"`
+	Subject string `json:"subject"`
+}
+
+// eventRecorder 받은 이벤트를 기록하는 핸들러
+type eventRecorder[T any] struct {
+	mu     sync.Mutex
+	events []*conflux.EntityEvent[T]
+	fail   error
+}
+
+func (r *eventRecorder[T]) HandleEvent(ctx context.Context, event *conflux.EntityEvent[T]) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.fail != nil {
+		return r.fail
+	}
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *eventRecorder[T]) recorded() []*conflux.EntityEvent[T] {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*conflux.EntityEvent[T](nil), r.events...)
+}
+
+func insertMail(t *testing.T, repo conflux.Repository[*Mail, conflux.MapFilter], id string) {
+	_, err := repo.FindOneAndInsert(context.Background(), conflux.NewMapFilter().And("ID", id),
+		conflux.NewCreateFunc(func(ctx context.Context) (*Mail, error) {
+			return &Mail{ID: id, Subject: "welcome"}, nil
+		}))
+	require.NoError(t, err)
+}
+
+// ============================================================================
+// 이벤트 데코레이터 테스트
+// ============================================================================
+
+func TestEventingRepository_EmitsEventsWithOldAndNewValues(t *testing.T) {
+	// Arrange
+	repo := conflux.NewEventingRepository[*User, conflux.MapFilter](
+		memory.NewMemoryRepository[*User](func() *User { return &User{} }))
+	recorder := &eventRecorder[*User]{}
+	require.NoError(t, repo.RegisterEventHandler(recorder))
+	ctx := context.Background()
+	filter := conflux.NewMapFilter().And("ID", "user1")
+
+	// Act
+	_, err := repo.FindOneAndInsert(ctx, filter, conflux.NewCreateFunc(func(ctx context.Context) (*User, error) {
+		return &User{ID: "user1", Credits: 100}, nil
+	}), conflux.WithInsertMetadata(map[string]any{"source": "signup"}))
+	require.NoError(t, err)
+	_, err = repo.FindOneAndUpdate(ctx, filter, 1, addCredits(50))
+	require.NoError(t, err)
+	conflict, err := repo.FindOneAndUpdate(ctx, filter, 1, addCredits(50))
+	require.NoError(t, err)
+	require.True(t, conflict.HasVersionConflict())
+	_, err = repo.FindOneAndDelete(ctx, filter, 2)
+	require.NoError(t, err)
+
+	// Assert - 충돌한 쓰기는 이벤트를 만들지 않음
+	events := recorder.recorded()
+	require.Len(t, events, 3)
+
+	created := events[0]
+	assert.Equal(t, conflux.EventCreated, created.Type)
+	assert.Nil(t, created.OldEntity)
+	assert.Equal(t, int64(1), created.Version)
+	assert.Equal(t, "signup", created.Metadata["source"])
+	assert.NotEmpty(t, created.ID)
+
+	updated := events[1]
+	assert.Equal(t, conflux.EventUpdated, updated.Type)
+	require.NotNil(t, updated.OldEntity)
+	assert.Equal(t, 100, (*updated.OldEntity).Credits)
+	assert.Equal(t, 150, updated.Entity.Credits)
+	assert.Equal(t, int64(2), updated.Version)
+
+	deleted := events[2]
+	assert.Equal(t, conflux.EventDeleted, deleted.Type)
+	require.NotNil(t, deleted.OldEntity)
+	assert.Equal(t, 150, (*deleted.OldEntity).Credits)
+	assert.Equal(t, false, deleted.Metadata["soft"])
+
+	// 해제된 핸들러는 더 이상 이벤트를 받지 않음
+	require.NoError(t, repo.UnregisterEventHandler(recorder))
+	recreated, err := repo.FindOneAndUpsert(ctx, filter, conflux.NewUpsertFunc(
+		func(ctx context.Context) (*User, error) { return &User{ID: "user1"}, nil }, nil))
+	require.NoError(t, err)
+	assert.True(t, recreated.Created)
+	assert.Len(t, recorder.recorded(), 3)
+}
+
+func TestEventingRepository_AsyncHandlersDrainOnClose(t *testing.T) {
+	// Arrange
+	repo := conflux.NewEventingRepository[*User, conflux.MapFilter](
+		memory.NewMemoryRepository[*User](func() *User { return &User{} }))
+	recorder := &eventRecorder[*User]{}
+	require.NoError(t, repo.RegisterEventHandlerWithOptions(recorder, conflux.WithAsyncDelivery()))
+	ctx := context.Background()
+	filter := conflux.NewMapFilter().And("ID", "user1")
+
+	// Act
+	upsert := conflux.NewUpsertFunc(
+		func(ctx context.Context) (*User, error) { return &User{ID: "user1", Credits: 1}, nil },
+		func(ctx context.Context, existing *User) (*User, error) {
+			existing.Credits++
+			return existing, nil
+		})
+	for i := 0; i < 5; i++ {
+		_, err := repo.FindOneAndUpsert(ctx, filter, upsert)
+		require.NoError(t, err)
+	}
+	require.NoError(t, repo.Close(ctx))
+
+	// Assert - 비동기 핸들러도 쓰기 순서대로 처리
+	events := recorder.recorded()
+	require.Len(t, events, 5)
+	assert.Equal(t, true, events[0].Metadata["created"])
+	for i, event := range events {
+		assert.Equal(t, conflux.EventUpserted, event.Type)
+		assert.Equal(t, int64(i+1), event.Version)
+	}
+	assert.Equal(t, 4, (*events[4].OldEntity).Credits)
+
+	assert.ErrorIs(t, repo.RegisterEventHandler(recorder), conflux.ErrEventsClosed)
+}
+
+func TestEventingRepository_ErrorPolicies(t *testing.T) {
+	// Arrange
+	var reported []*conflux.EventDeliveryError
+	repo := conflux.NewEventingRepository[*User, conflux.MapFilter](
+		memory.NewMemoryRepository[*User](func() *User { return &User{} }),
+		conflux.WithEventErrorHandler(func(ctx context.Context, err *conflux.EventDeliveryError) {
+			reported = append(reported, err)
+		}))
+	ctx := context.Background()
+	failure := errors.New("broker unavailable")
+
+	attempts := 0
+	flaky := conflux.NewEventHandler(func(ctx context.Context, event *conflux.EntityEvent[*User]) error {
+		attempts++
+		if attempts < 3 {
+			return failure
+		}
+		return nil
+	})
+	require.NoError(t, repo.RegisterEventHandlerWithOptions(flaky, conflux.WithEventRetries(2, time.Millisecond)))
+	require.NoError(t, repo.RegisterEventHandler(&eventRecorder[*User]{fail: failure}))
+	require.NoError(t, repo.RegisterEventHandlerWithOptions(&eventRecorder[*User]{fail: failure},
+		conflux.WithEventErrorPolicy(conflux.FailOnEventError)))
+
+	// Act
+	result, err := repo.FindOneAndInsert(ctx, conflux.NewMapFilter().And("ID", "user1"),
+		conflux.NewCreateFunc(func(ctx context.Context) (*User, error) {
+			return &User{ID: "user1"}, nil
+		}))
+
+	// Assert - 쓰기는 적용되었고 실패 정책 핸들러의 에러만 반환됨
+	require.NotNil(t, result)
+	assert.True(t, result.IsSuccess())
+	assert.ErrorIs(t, err, conflux.ErrEventDelivery)
+	assert.ErrorIs(t, err, failure)
+
+	assert.Equal(t, 3, attempts)
+	require.Len(t, reported, 1)
+	assert.Equal(t, conflux.EventCreated, reported[0].EventType)
+	assert.Equal(t, int64(1), reported[0].Version)
+}
+
+func TestEventingRepository_OutboxRedelivery(t *testing.T) {
+	// Arrange - 첫 프로세스에서 핸들러가 실패해 이벤트가 전달되지 못함
+	store := memory.NewMemoryRepository[*Mail](func() *Mail { return &Mail{} })
+	ctx := context.Background()
+	filter := conflux.NewMapFilter().And("ID", "mail1")
+
+	first := conflux.NewEventingRepository[*Mail, conflux.MapFilter](store, conflux.WithOutbox())
+	require.NoError(t, first.RegisterEventHandler(&eventRecorder[*Mail]{fail: errors.New("crashed")}))
+	insertMail(t, first, "mail1")
+
+	stored, err := store.FindOne(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, stored.PendingEvents, 1)
+	pending := stored.PendingEvents[0]
+	assert.Equal(t, conflux.EventCreated, pending.Type)
+	assert.Equal(t, int64(1), pending.Version)
+
+	// Act - 재시작한 프로세스가 남은 이벤트를 다시 발행
+	second := conflux.NewEventingRepository[*Mail, conflux.MapFilter](store, conflux.WithOutbox())
+	recorder := &eventRecorder[*Mail]{}
+	require.NoError(t, second.RegisterEventHandler(recorder))
+
+	count, err := second.Redeliver(ctx, filter, 0)
+	require.NoError(t, err)
+
+	// Assert - 같은 이벤트 ID로 한 번 전달되고, 다시 호출해도 중복 발행되지 않음
+	assert.Equal(t, 1, count)
+	events := recorder.recorded()
+	require.Len(t, events, 1)
+	assert.Equal(t, pending.EventID, events[0].ID)
+	assert.Equal(t, "mail1", events[0].Entity.ID)
+
+	count, err = second.Redeliver(ctx, filter, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	// 다음 쓰기에서 전달 완료된 레코드가 정리되고 새 레코드만 남음
+	_, err = second.FindOneAndUpdate(ctx, filter, 1, conflux.NewUpdateFunc(func(ctx context.Context, existing *Mail) (*Mail, error) {
+		existing.Subject = "read"
+		return existing, nil
+	}))
+	require.NoError(t, err)
+
+	stored, err = store.FindOne(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, stored.PendingEvents, 1)
+	assert.Equal(t, conflux.EventUpdated, stored.PendingEvents[0].Type)
+	assert.Equal(t, int64(2), stored.PendingEvents[0].Version)
+
+	events = recorder.recorded()
+	require.Len(t, events, 2)
+	assert.Equal(t, stored.PendingEvents[0].EventID, events[1].ID)
+}
+
+func TestEventingRepository_OutboxSoftDeleteStoresRecordOnTombstone(t *testing.T) {
+	// Arrange
+	store := memory.NewMemoryRepository[*Mail](func() *Mail { return &Mail{} })
+	repo := conflux.NewEventingRepository[*Mail, conflux.MapFilter](store, conflux.WithOutbox())
+	recorder := &eventRecorder[*Mail]{}
+	require.NoError(t, repo.RegisterEventHandler(recorder))
+	ctx := context.Background()
+	filter := conflux.NewMapFilter().And("ID", "mail1")
+	insertMail(t, repo, "mail1")
+
+	// Act
+	result, err := repo.FindOneAndDelete(ctx, filter, 1, conflux.WithSoftDelete(),
+		conflux.WithTombstoneUpdate(func(entity any) {
+			entity.(*Mail).Subject = "archived"
+		}))
+	require.NoError(t, err)
+	require.True(t, result.SoftDeleted)
+
+	// Assert - 삭제 이벤트 레코드가 툼스톤과 함께 저장되고 같은 ID로 발행됨
+	tombstone := result.Entity
+	assert.Equal(t, "archived", tombstone.Subject)
+	require.Len(t, tombstone.PendingEvents, 1, "the delivered created record is pruned")
+	record := tombstone.PendingEvents[0]
+	assert.Equal(t, conflux.EventDeleted, record.Type)
+	assert.Equal(t, int64(2), record.Version)
+
+	events := recorder.recorded()
+	require.Len(t, events, 2)
+	deleted := events[1]
+	assert.Equal(t, record.EventID, deleted.ID)
+	assert.Equal(t, true, deleted.Metadata["soft"])
+	require.NotNil(t, deleted.OldEntity)
+	assert.Equal(t, "welcome", (*deleted.OldEntity).Subject)
+	assert.Equal(t, int64(1), (*deleted.OldEntity).GetVersion())
+}
+
+func TestEventingRepository_RedeliverSweepsAfterRestart(t *testing.T) {
+	// Arrange - 첫 프로세스가 여러 엔터티를 쓰고 이벤트를 전달하지 못한 채 종료
+	store := memory.NewMemoryRepository[*Mail](func() *Mail { return &Mail{} })
+	ctx := context.Background()
+
+	first := conflux.NewEventingRepository[*Mail, conflux.MapFilter](store, conflux.WithOutbox())
+	require.NoError(t, first.RegisterEventHandler(&eventRecorder[*Mail]{fail: errors.New("crashed")}))
+	for _, id := range []string{"mail1", "mail2", "mail3"} {
+		insertMail(t, first, id)
+	}
+
+	// Act - 같은 저장소 위에 새로 만든 데코레이터가 전체를 스윕
+	all := conflux.NewMapFilter().And("Subject", "welcome")
+	clock := &testClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	second := conflux.NewEventingRepository[*Mail, conflux.MapFilter](store,
+		conflux.WithOutbox(), conflux.WithEventClock(clock), conflux.WithDeliveredRetention(time.Minute))
+	recorder := &eventRecorder[*Mail]{}
+	require.NoError(t, second.RegisterEventHandler(recorder))
+
+	count, err := second.Redeliver(ctx, all, 0)
+	require.NoError(t, err)
+
+	// Assert - 모든 엔터티의 미전달 이벤트가 한 번씩 발행됨
+	assert.Equal(t, 3, count)
+	var ids []string
+	for _, event := range recorder.recorded() {
+		ids = append(ids, event.Entity.ID)
+	}
+	assert.ElementsMatch(t, []string{"mail1", "mail2", "mail3"}, ids)
+
+	count, err = second.Redeliver(ctx, all, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	// 보존 기간이 지나면 전달 완료 ID를 잊으므로 메모리가 계속 늘지 않음 (대신 다시 발행될 수 있음)
+	clock.now = clock.now.Add(2 * time.Minute)
+	insertMail(t, second, "mail4")
+
+	count, err = second.Redeliver(ctx, all, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count, "mail1-3 are redelivered at least once more; mail4 is still remembered")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/homveloper/dukdakit/conflux"
 	memory "github.com/homveloper/dukdakit/conflux/adapters/memory"
@@ -300,6 +301,119 @@ func TestFindOneAndUpdate_NotFound(t *testing.T) {
 	assert.True(t, result.IsNotFound())
 }
 
+// ============================================================================
+// FindOneAndDelete 테스트
+// ============================================================================
+
+func TestFindOneAndDelete_Success(t *testing.T) {
+	// Arrange
+	repo := memory.NewMemoryRepository[*User](func() *User {
+		return &User{}
+	})
+	
+	ctx := context.Background()
+	_, err := repo.FindOneAndInsert(ctx, conflux.NewMapFilter().And("ID", "item1"),
+		conflux.NewCreateFunc(func(ctx context.Context) (*User, error) {
+			return &User{ID: "item1", Email: "item@example.com", Credits: 10}, nil
+		}))
+	require.NoError(t, err)
+	
+	// Act
+	filter := conflux.NewMapFilter().And("ID", "item1")
+	result, err := repo.FindOneAndDelete(ctx, filter, 1)
+	
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, result.IsSuccess())
+	assert.False(t, result.IsSoftDeleted())
+	assert.Equal(t, "item1", result.GetEntity().ID)
+	assert.Equal(t, int64(1), result.GetVersion())
+	
+	exists, err := repo.Exists(ctx, filter)
+	require.NoError(t, err)
+	assert.False(t, exists)
+	
+	// 두 번째 삭제는 NotFound
+	result, err = repo.FindOneAndDelete(ctx, filter, 1)
+	require.NoError(t, err)
+	assert.True(t, result.IsNotFound())
+}
+
+func TestFindOneAndDelete_VersionConflict(t *testing.T) {
+	// Arrange
+	repo := memory.NewMemoryRepository[*User](func() *User {
+		return &User{}
+	})
+	
+	ctx := context.Background()
+	filter := conflux.NewMapFilter().And("ID", "item1")
+	_, err := repo.FindOneAndInsert(ctx, filter,
+		conflux.NewCreateFunc(func(ctx context.Context) (*User, error) {
+			return &User{ID: "item1", Credits: 10}, nil
+		}))
+	require.NoError(t, err)
+	
+	_, err = repo.FindOneAndUpdate(ctx, filter, 1,
+		conflux.NewUpdateFunc(func(ctx context.Context, existing *User) (*User, error) {
+			existing.Credits = 20
+			return existing, nil
+		}))
+	require.NoError(t, err)
+	
+	// Act - 오래된 버전으로 삭제 시도
+	result, err := repo.FindOneAndDelete(ctx, filter, 1)
+	
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, result.IsSuccess())
+	assert.True(t, result.HasVersionConflict())
+	assert.Equal(t, int64(2), result.GetVersion())
+	assert.Equal(t, 20, result.GetEntity().Credits)
+	
+	exists, err := repo.Exists(ctx, filter)
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestFindOneAndDelete_SoftDelete(t *testing.T) {
+	// Arrange
+	repo := memory.NewMemoryRepository[*User](func() *User {
+		return &User{}
+	})
+	
+	ctx := context.Background()
+	filter := conflux.NewMapFilter().And("ID", "mail1")
+	_, err := repo.FindOneAndInsert(ctx, filter,
+		conflux.NewCreateFunc(func(ctx context.Context) (*User, error) {
+			return &User{ID: "mail1", Status: "unread"}, nil
+		}))
+	require.NoError(t, err)
+	
+	// Act
+	result, err := repo.FindOneAndDelete(ctx, filter, 1, conflux.WithSoftDelete())
+	
+	// Assert - 툼스톤은 버전이 증가하고 deleted-at이 기록됨
+	require.NoError(t, err)
+	assert.True(t, result.IsSuccess())
+	assert.True(t, result.IsSoftDeleted())
+	assert.Equal(t, int64(2), result.GetVersion())
+	require.NotNil(t, result.GetEntity().DeletedAt)
+	assert.True(t, result.GetEntity().IsDeleted())
+	assert.Equal(t, result.DeletedAt, *result.GetEntity().DeletedAt)
+	
+	// 툼스톤은 조회와 업데이트에서 제외됨
+	_, err = repo.FindOne(ctx, filter)
+	assert.Error(t, err)
+	
+	update, err := repo.FindOneAndUpdate(ctx, filter, 2,
+		conflux.NewUpdateFunc(func(ctx context.Context, existing *User) (*User, error) {
+			existing.Status = "read"
+			return existing, nil
+		}))
+	require.NoError(t, err)
+	assert.True(t, update.IsNotFound())
+}
+
 // ============================================================================
 // 동시성 테스트
 // ============================================================================
@@ -440,4 +554,29 @@ func TestRealWorldScenario_UserRegistrationAndCredits(t *testing.T) {
 		assert.True(t, result2.IsSuccess())
 		assert.Equal(t, 120, result2.GetEntity().Credits)
 	})
-}
\ No newline at end of file
+}
+// ============================================================================
+// 팩토리 테스트
+// ============================================================================
+
+func TestVersionAwareFactory_UsesInjectedClock(t *testing.T) {
+	// Arrange - 호출될 때마다 1시간씩 전진하는 시계
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := &stepClock{now: start, step: time.Hour}
+	factory := conflux.NewVersionAwareFactory[*User](
+		func(ctx context.Context) (*User, error) { return &User{ID: "user1"}, nil },
+		func(ctx context.Context, existing *User) (*User, error) { return existing, nil },
+		conflux.WithFactoryClock(clock))
+	ctx := context.Background()
+
+	// Act
+	created, err := factory.CreateFn(ctx)
+	require.NoError(t, err)
+	updated, err := factory.UpdateFn(ctx, created)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, start.Add(time.Hour), updated.CreatedAt)
+	assert.Equal(t, start.Add(2*time.Hour), updated.UpdatedAt)
+	assert.Equal(t, int64(2), updated.Version)
+}
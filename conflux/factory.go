@@ -3,7 +3,6 @@ package conflux
 import (
 	"context"
 	"fmt"
-	"time"
 )
 
 // ============================================================================
@@ -114,16 +113,40 @@ func (f *ConditionalEntityFactory[T]) ShouldUpdate(ctx context.Context, existing
 type VersionAwareFactory[T Versioned] struct {
 	createFn func(ctx context.Context) (T, error)
 	updateFn func(ctx context.Context, existing T) (T, error)
+	clock    Clock
+}
+
+// FactoryOption 팩토리 설정 옵션
+type FactoryOption func(*FactoryConfig)
+
+// FactoryConfig 팩토리 설정
+type FactoryConfig struct {
+	// Clock 타임스탬프 기록용 시각 소스 (nil이면 시스템 시간)
+	Clock Clock
+}
+
+// WithFactoryClock 팩토리가 타임스탬프 기록에 사용할 시각 소스 설정
+func WithFactoryClock(clock Clock) FactoryOption {
+	return func(config *FactoryConfig) {
+		config.Clock = clock
+	}
 }
 
 // NewVersionAwareFactory 새 VersionAwareFactory 생성
 func NewVersionAwareFactory[T Versioned](
 	createFn func(ctx context.Context) (T, error),
 	updateFn func(ctx context.Context, existing T) (T, error),
+	options ...FactoryOption,
 ) UpsertFunc[T] {
+	config := &FactoryConfig{}
+	for _, opt := range options {
+		opt(config)
+	}
+
 	return &VersionAwareFactory[T]{
 		createFn: createFn,
 		updateFn: updateFn,
+		clock:    ClockOrSystem(config.Clock),
 	}
 }
 
@@ -144,7 +167,7 @@ func (f *VersionAwareFactory[T]) CreateFn(ctx context.Context) (T, error) {
 	
 	// Timestamped 인터페이스도 구현하고 있다면 타임스탬프 설정
 	if timestamped, ok := any(entity).(Timestamped); ok {
-		now := time.Now()
+		now := f.clock.Now()
 		timestamped.SetCreatedAt(now)
 		timestamped.SetUpdatedAt(now)
 	}
@@ -168,7 +191,7 @@ func (f *VersionAwareFactory[T]) UpdateFn(ctx context.Context, existing T) (T, e
 	
 	// 업데이트 타임스탬프 설정
 	if timestamped, ok := any(updated).(Timestamped); ok {
-		timestamped.SetUpdatedAt(time.Now())
+		timestamped.SetUpdatedAt(f.clock.Now())
 	}
 	
 	return updated, nil
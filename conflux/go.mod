@@ -2,11 +2,7 @@ module github.com/homveloper/dukdakit/conflux
 
 go 1.21
 
-require (
-	github.com/go-redis/redis v6.15.9+incompatible
-	github.com/go-redis/redis/v8 v8.11.5
-	github.com/stretchr/testify v1.8.4
-)
+require github.com/stretchr/testify v1.10.0
 
 require (
 	github.com/cespare/xxhash/v2 v2.3.0 // indirect
@@ -16,3 +12,5 @@ require (
 	github.com/redis/go-redis/v9 v9.12.1
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+require github.com/mattn/go-sqlite3 v1.14.32
@@ -0,0 +1,504 @@
+package conflux
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// 메트릭 데코레이터 (MetricsRepository 구현)
+// ============================================================================
+
+// 메트릭 연산 종류
+const (
+	OpInsert     = "insert"
+	OpUpsert     = "upsert"
+	OpUpdate     = "update"
+	OpDelete     = "delete"
+	OpFindOne    = "find_one"
+	OpExists     = "exists"
+	OpGetVersion = "get_version"
+)
+
+// OperationOutcome 연산 결과 분류
+type OperationOutcome int
+
+const (
+	OutcomeSuccess   OperationOutcome = iota // 성공
+	OutcomeConflict                          // 버전 충돌
+	OutcomeDuplicate                         // 중복으로 생성되지 않음
+	OutcomeNotFound                          // 대상 엔터티 없음
+	OutcomeError                             // 에러 반환
+)
+
+// 컴파일 타임 인터페이스 검사
+var _ MetricsRepository[any, MapFilter] = (*MeteredRepository[any, MapFilter])(nil)
+
+// MetricsConfig 메트릭 데코레이터 설정
+type MetricsConfig struct {
+	// Clock 응답시간 측정용 시각 소스 (기본값: 시스템 시간)
+	Clock Clock
+
+	// Collector 메트릭을 기록할 수집기 (기본값: 데코레이터 전용 수집기)
+	// 여러 데코레이터가 같은 수집기를 공유하면 EntityTypes로 타입별 메트릭을 비교할 수 있습니다
+	Collector *MetricsCollector
+
+	// EntityType 메트릭에 기록할 엔터티 타입 이름 (기본값: T의 타입 이름)
+	EntityType string
+}
+
+// MetricsOption 메트릭 데코레이터 생성 옵션
+type MetricsOption func(*MetricsConfig)
+
+// WithMetricsClock 응답시간 측정에 사용할 시각 소스 설정
+func WithMetricsClock(clock Clock) MetricsOption {
+	return func(config *MetricsConfig) {
+		config.Clock = clock
+	}
+}
+
+// WithMetricsCollector 공유 메트릭 수집기 설정
+func WithMetricsCollector(collector *MetricsCollector) MetricsOption {
+	return func(config *MetricsConfig) {
+		config.Collector = collector
+	}
+}
+
+// WithMetricsEntityType 엔터티 타입 이름 설정
+func WithMetricsEntityType(entityType string) MetricsOption {
+	return func(config *MetricsConfig) {
+		config.EntityType = entityType
+	}
+}
+
+// ============================================================================
+// MetricsCollector
+// ============================================================================
+
+// DefaultMetricsSampleSize 백분위수 계산에 사용하는 기본 표본 수 (시리즈별 최근 응답시간)
+const DefaultMetricsSampleSize = 1024
+
+// MetricsCollector 엔터티 타입과 연산별 메트릭 수집기
+// 카운트와 평균은 전체 기간, 백분위수는 시리즈별 최근 표본으로 계산합니다
+// 여러 시리즈를 합칠 때는 표본마다 시리즈의 연산 수를 나눠 가중치로 주므로,
+// 표본 수가 같아도 연산이 많은 시리즈가 합산 백분위수에 그만큼 더 반영됩니다
+type MetricsCollector struct {
+	mu         sync.Mutex
+	sampleSize int
+	series     map[metricKey]*metricSeries
+}
+
+// metricKey 메트릭 시리즈 키
+type metricKey struct {
+	entityType string
+	operation  string
+}
+
+// metricSeries 한 엔터티 타입, 한 연산의 누적 메트릭
+type metricSeries struct {
+	outcomes     [OutcomeError + 1]int64
+	totalLatency time.Duration
+	maxLatency   time.Duration
+	samples      []time.Duration // 최근 응답시간 링 버퍼
+	next         int
+}
+
+// NewMetricsCollector 새 메트릭 수집기 생성
+// sampleSize가 0 이하이면 DefaultMetricsSampleSize를 사용합니다
+func NewMetricsCollector(sampleSize int) *MetricsCollector {
+	if sampleSize <= 0 {
+		sampleSize = DefaultMetricsSampleSize
+	}
+	return &MetricsCollector{
+		sampleSize: sampleSize,
+		series:     make(map[metricKey]*metricSeries),
+	}
+}
+
+// Record 연산 하나의 결과와 응답시간 기록
+func (c *MetricsCollector) Record(entityType, operation string, outcome OperationOutcome, latency time.Duration) {
+	if outcome < OutcomeSuccess || outcome > OutcomeError {
+		outcome = OutcomeError
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := metricKey{entityType: entityType, operation: operation}
+	series, ok := c.series[key]
+	if !ok {
+		series = &metricSeries{}
+		c.series[key] = series
+	}
+
+	series.outcomes[outcome]++
+	series.totalLatency += latency
+	if latency > series.maxLatency {
+		series.maxLatency = latency
+	}
+
+	if len(series.samples) < c.sampleSize {
+		series.samples = append(series.samples, latency)
+	} else {
+		series.samples[series.next] = latency
+		series.next = (series.next + 1) % c.sampleSize
+	}
+}
+
+// Snapshot 현재까지의 메트릭 집계
+func (c *MetricsCollector) Snapshot() *RepositoryMetrics {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	total := &metricAccumulator{}
+	operations := make(map[string]*metricAccumulator)
+	entityTypes := make(map[string]*metricAccumulator)
+	entityOperations := make(map[metricKey]*metricAccumulator)
+
+	for key, series := range c.series {
+		total.add(series)
+		accumulatorFor(operations, key.operation).add(series)
+		accumulatorFor(entityTypes, key.entityType).add(series)
+		accumulatorFor(entityOperations, key).add(series)
+	}
+
+	metrics := total.metrics()
+	metrics.Operations = make(map[string]*RepositoryMetrics, len(operations))
+	for operation, acc := range operations {
+		metrics.Operations[operation] = acc.metrics()
+	}
+
+	metrics.EntityTypes = make(map[string]*RepositoryMetrics, len(entityTypes))
+	for entityType, acc := range entityTypes {
+		metrics.EntityTypes[entityType] = acc.metrics()
+		metrics.EntityTypes[entityType].Operations = make(map[string]*RepositoryMetrics)
+	}
+	for key, acc := range entityOperations {
+		metrics.EntityTypes[key.entityType].Operations[key.operation] = acc.metrics()
+	}
+
+	return metrics
+}
+
+// Reset 모든 메트릭 초기화
+func (c *MetricsCollector) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.series = make(map[metricKey]*metricSeries)
+}
+
+// metricAccumulator 여러 시리즈를 합산하는 집계기
+type metricAccumulator struct {
+	outcomes     [OutcomeError + 1]int64
+	totalLatency time.Duration
+	maxLatency   time.Duration
+	samples      []weightedSample
+}
+
+// weightedSample 표본 하나가 대표하는 연산 수를 가중치로 가진 응답시간
+type weightedSample struct {
+	latency time.Duration
+	weight  float64
+}
+
+// accumulatorFor 키에 해당하는 집계기 반환 (없으면 생성)
+func accumulatorFor[K comparable](accs map[K]*metricAccumulator, key K) *metricAccumulator {
+	acc, ok := accs[key]
+	if !ok {
+		acc = &metricAccumulator{}
+		accs[key] = acc
+	}
+	return acc
+}
+
+// add 시리즈 합산
+func (a *metricAccumulator) add(series *metricSeries) {
+	for i, count := range series.outcomes {
+		a.outcomes[i] += count
+	}
+	a.totalLatency += series.totalLatency
+	if series.maxLatency > a.maxLatency {
+		a.maxLatency = series.maxLatency
+	}
+
+	if len(series.samples) == 0 {
+		return
+	}
+	// 링 버퍼는 최근 표본만 남기므로 표본 하나가 시리즈 연산 여러 개를 대표합니다
+	var operations int64
+	for _, count := range series.outcomes {
+		operations += count
+	}
+	weight := float64(operations) / float64(len(series.samples))
+	for _, latency := range series.samples {
+		a.samples = append(a.samples, weightedSample{latency: latency, weight: weight})
+	}
+}
+
+// metrics 집계 결과를 RepositoryMetrics로 변환
+func (a *metricAccumulator) metrics() *RepositoryMetrics {
+	metrics := &RepositoryMetrics{
+		SuccessfulOps:  a.outcomes[OutcomeSuccess],
+		ConflictCount:  a.outcomes[OutcomeConflict],
+		DuplicateCount: a.outcomes[OutcomeDuplicate],
+		NotFoundCount:  a.outcomes[OutcomeNotFound],
+		ErrorCount:     a.outcomes[OutcomeError],
+		MaxLatencyMs:   durationMs(a.maxLatency),
+	}
+	for _, count := range a.outcomes {
+		metrics.TotalOperations += count
+	}
+	if metrics.TotalOperations == 0 {
+		return metrics
+	}
+
+	metrics.AverageLatencyMs = durationMs(a.totalLatency) / float64(metrics.TotalOperations)
+	metrics.ErrorRate = float64(metrics.ErrorCount) / float64(metrics.TotalOperations)
+
+	sorted := make([]weightedSample, len(a.samples))
+	copy(sorted, a.samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].latency < sorted[j].latency })
+	metrics.P50LatencyMs = durationMs(percentile(sorted, 0.50))
+	metrics.P95LatencyMs = durationMs(percentile(sorted, 0.95))
+	metrics.P99LatencyMs = durationMs(percentile(sorted, 0.99))
+
+	return metrics
+}
+
+// percentile 정렬된 가중 표본에서 nearest-rank 백분위수 계산
+// 누적 가중치가 전체의 p 이상이 되는 첫 표본을 반환합니다 (가중치가 모두 같으면 일반 nearest-rank와 같음)
+func percentile(sorted []weightedSample, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	var total float64
+	for _, sample := range sorted {
+		total += sample.weight
+	}
+
+	target := p * total
+	var cumulative float64
+	for _, sample := range sorted {
+		cumulative += sample.weight
+		if cumulative >= target {
+			return sample.latency
+		}
+	}
+	return sorted[len(sorted)-1].latency
+}
+
+// durationMs 밀리초 단위 실수로 변환
+func durationMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// ============================================================================
+// MeteredRepository
+// ============================================================================
+
+// MeteredRepository 모든 연산의 응답시간과 결과를 기록하는 레포지토리 데코레이터
+// 결과 분류:
+//   - 버전 충돌 결과나 ConflictError → ConflictCount
+//   - 중복 삽입 결과 → DuplicateCount
+//   - NotFound 결과나 ErrNotFound → NotFoundCount
+//   - 그 밖의 에러 → ErrorCount (ErrorRate 계산에 사용)
+//
+// Example usage:
+//
+//	collector := conflux.NewMetricsCollector(0)
+//	users := conflux.NewMeteredRepository[*User, conflux.MapFilter](userRepo, conflux.WithMetricsCollector(collector))
+//	mails := conflux.NewMeteredRepository[*Mail, conflux.MapFilter](mailRepo, conflux.WithMetricsCollector(collector))
+//
+//	metrics, _ := users.GetMetrics(ctx)
+//	fmt.Println(metrics.P99LatencyMs, metrics.EntityTypes["Mail"].ConflictCount)
+type MeteredRepository[T any, F any] struct {
+	inner      ReadWriteRepository[T, F]
+	collector  *MetricsCollector
+	clock      Clock
+	entityType string
+}
+
+// NewMeteredRepository 새 메트릭 데코레이터 생성
+func NewMeteredRepository[T any, F any](inner ReadWriteRepository[T, F], options ...MetricsOption) *MeteredRepository[T, F] {
+	config := &MetricsConfig{}
+	for _, opt := range options {
+		opt(config)
+	}
+
+	collector := config.Collector
+	if collector == nil {
+		collector = NewMetricsCollector(0)
+	}
+
+	entityType := config.EntityType
+	if entityType == "" {
+		entityType = entityTypeName[T]()
+	}
+
+	return &MeteredRepository[T, F]{
+		inner:      inner,
+		collector:  collector,
+		clock:      ClockOrSystem(config.Clock),
+		entityType: entityType,
+	}
+}
+
+// ============================================================================
+// MetricsRepository 인터페이스 구현
+// ============================================================================
+
+// GetMetrics 수집기의 현재 메트릭 반환
+// 수집기를 공유하는 경우 다른 데코레이터의 연산도 포함됩니다
+func (r *MeteredRepository[T, F]) GetMetrics(ctx context.Context) (*RepositoryMetrics, error) {
+	return r.collector.Snapshot(), nil
+}
+
+// ResetMetrics 수집기의 메트릭 초기화
+func (r *MeteredRepository[T, F]) ResetMetrics(ctx context.Context) error {
+	r.collector.Reset()
+	return nil
+}
+
+// Collector 메트릭 수집기 반환
+func (r *MeteredRepository[T, F]) Collector() *MetricsCollector {
+	return r.collector
+}
+
+// ============================================================================
+// Repository 인터페이스 구현
+// ============================================================================
+
+// FindOneAndInsert 엔터티 생성 연산 측정
+func (r *MeteredRepository[T, F]) FindOneAndInsert(ctx context.Context, duplicateCheckFilter F, createFunc CreateFunc[T], options ...InsertOption) (*InsertResult[T], error) {
+	start := r.clock.Now()
+	result, err := r.inner.FindOneAndInsert(ctx, duplicateCheckFilter, createFunc, options...)
+
+	outcome := classifyError(err)
+	if err == nil && result.IsDuplicate() {
+		outcome = OutcomeDuplicate
+	}
+	r.record(OpInsert, outcome, start)
+	return result, err
+}
+
+// FindOneAndUpsert 엔터티 생성/업데이트 연산 측정
+func (r *MeteredRepository[T, F]) FindOneAndUpsert(ctx context.Context, lookupFilter F, upsertFunc UpsertFunc[T], options ...UpsertOption) (*UpsertResult[T], error) {
+	start := r.clock.Now()
+	result, err := r.inner.FindOneAndUpsert(ctx, lookupFilter, upsertFunc, options...)
+
+	r.record(OpUpsert, classifyError(err), start)
+	return result, err
+}
+
+// FindOneAndUpdate 엔터티 업데이트 연산 측정
+func (r *MeteredRepository[T, F]) FindOneAndUpdate(ctx context.Context, lookupFilter F, expectedVersion int64, updateFunc UpdateFunc[T], options ...UpdateOption) (*UpdateResult[T], error) {
+	start := r.clock.Now()
+	result, err := r.inner.FindOneAndUpdate(ctx, lookupFilter, expectedVersion, updateFunc, options...)
+
+	outcome := classifyError(err)
+	if err == nil {
+		switch {
+		case result.HasVersionConflict():
+			outcome = OutcomeConflict
+		case result.IsNotFound():
+			outcome = OutcomeNotFound
+		}
+	}
+	r.record(OpUpdate, outcome, start)
+	return result, err
+}
+
+// FindOneAndDelete 엔터티 삭제 연산 측정
+func (r *MeteredRepository[T, F]) FindOneAndDelete(ctx context.Context, lookupFilter F, expectedVersion int64, options ...DeleteOption) (*DeleteResult[T], error) {
+	start := r.clock.Now()
+	result, err := r.inner.FindOneAndDelete(ctx, lookupFilter, expectedVersion, options...)
+
+	outcome := classifyError(err)
+	if err == nil {
+		switch {
+		case result.HasVersionConflict():
+			outcome = OutcomeConflict
+		case result.IsNotFound():
+			outcome = OutcomeNotFound
+		}
+	}
+	r.record(OpDelete, outcome, start)
+	return result, err
+}
+
+// NewEntity 새 엔터티 인스턴스 생성
+func (r *MeteredRepository[T, F]) NewEntity() T {
+	return r.inner.NewEntity()
+}
+
+// ============================================================================
+// ReadRepository 인터페이스 구현
+// ============================================================================
+
+// FindOne 조회 연산 측정
+func (r *MeteredRepository[T, F]) FindOne(ctx context.Context, filter F) (T, error) {
+	start := r.clock.Now()
+	entity, err := r.inner.FindOne(ctx, filter)
+
+	r.record(OpFindOne, classifyError(err), start)
+	return entity, err
+}
+
+// Exists 존재 확인 연산 측정
+func (r *MeteredRepository[T, F]) Exists(ctx context.Context, filter F) (bool, error) {
+	start := r.clock.Now()
+	exists, err := r.inner.Exists(ctx, filter)
+
+	r.record(OpExists, classifyError(err), start)
+	return exists, err
+}
+
+// GetVersion 버전 조회 연산 측정
+func (r *MeteredRepository[T, F]) GetVersion(ctx context.Context, filter F) (int64, error) {
+	start := r.clock.Now()
+	version, err := r.inner.GetVersion(ctx, filter)
+
+	r.record(OpGetVersion, classifyError(err), start)
+	return version, err
+}
+
+// ============================================================================
+// 메트릭 헬퍼 메서드들
+// ============================================================================
+
+// record 연산 결과와 시작 시각부터의 응답시간 기록
+func (r *MeteredRepository[T, F]) record(operation string, outcome OperationOutcome, start time.Time) {
+	r.collector.Record(r.entityType, operation, outcome, r.clock.Now().Sub(start))
+}
+
+// classifyError 에러를 연산 결과로 분류
+func classifyError(err error) OperationOutcome {
+	var conflictErr *ConflictError
+	switch {
+	case err == nil:
+		return OutcomeSuccess
+	case errors.As(err, &conflictErr):
+		return OutcomeConflict
+	case errors.Is(err, ErrNotFound):
+		return OutcomeNotFound
+	default:
+		return OutcomeError
+	}
+}
+
+// entityTypeName 포인터를 벗긴 T의 타입 이름
+func entityTypeName[T any]() string {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Name() != "" {
+		return t.Name()
+	}
+	return t.String()
+}
@@ -0,0 +1,173 @@
+package conflux_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/homveloper/dukdakit/conflux"
+	memory "github.com/homveloper/dukdakit/conflux/adapters/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// 메트릭 데코레이터 테스트용 헬퍼
+// ============================================================================
+
+// stepClock 호출될 때마다 step만큼 전진하는 시각 소스
+// 연산 하나가 시작과 끝에 한 번씩 호출하므로 응답시간이 step이 됩니다
+type stepClock struct {
+	now  time.Time
+	step time.Duration
+}
+
+func (c *stepClock) Now() time.Time {
+	c.now = c.now.Add(c.step)
+	return c.now
+}
+
+// ============================================================================
+// 메트릭 데코레이터 테스트
+// ============================================================================
+
+func TestMeteredRepository_CountsOutcomesPerOperation(t *testing.T) {
+	// Arrange
+	clock := &stepClock{step: time.Millisecond}
+	repo := conflux.NewMeteredRepository[*User, conflux.MapFilter](
+		memory.NewMemoryRepository[*User](func() *User { return &User{} }),
+		conflux.WithMetricsClock(clock))
+	ctx := context.Background()
+	filter := conflux.NewMapFilter().And("ID", "user1")
+	create := conflux.NewCreateFunc(func(ctx context.Context) (*User, error) {
+		return &User{ID: "user1", Credits: 100}, nil
+	})
+
+	// Act
+	_, err := repo.FindOneAndInsert(ctx, filter, create)
+	require.NoError(t, err)
+	_, err = repo.FindOneAndInsert(ctx, filter, create)
+	require.NoError(t, err)
+	_, err = repo.FindOneAndUpdate(ctx, filter, 1, addCredits(10))
+	require.NoError(t, err)
+	_, err = repo.FindOneAndUpdate(ctx, filter, 1, addCredits(10))
+	require.NoError(t, err)
+	_, err = repo.FindOneAndDelete(ctx, conflux.NewMapFilter().And("ID", "ghost"), 1)
+	require.NoError(t, err)
+	_, err = repo.FindOne(ctx, conflux.NewMapFilter().And("ID", "ghost"))
+	require.ErrorIs(t, err, conflux.ErrNotFound)
+	_, err = repo.FindOneAndUpdate(ctx, filter, 2, conflux.NewUpdateFunc(func(ctx context.Context, existing *User) (*User, error) {
+		return nil, errors.New("rejected")
+	}))
+	require.Error(t, err)
+
+	// Assert
+	metrics, err := repo.GetMetrics(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), metrics.TotalOperations)
+	assert.Equal(t, int64(2), metrics.SuccessfulOps)
+	assert.Equal(t, int64(1), metrics.DuplicateCount)
+	assert.Equal(t, int64(1), metrics.ConflictCount)
+	assert.Equal(t, int64(2), metrics.NotFoundCount)
+	assert.Equal(t, int64(1), metrics.ErrorCount)
+	assert.InDelta(t, 1.0/7.0, metrics.ErrorRate, 1e-9)
+	assert.InDelta(t, 1.0, metrics.AverageLatencyMs, 1e-9)
+
+	insert := metrics.Operations[conflux.OpInsert]
+	require.NotNil(t, insert)
+	assert.Equal(t, int64(2), insert.TotalOperations)
+	assert.Equal(t, int64(1), insert.DuplicateCount)
+
+	update := metrics.Operations[conflux.OpUpdate]
+	require.NotNil(t, update)
+	assert.Equal(t, int64(3), update.TotalOperations)
+	assert.Equal(t, int64(1), update.ConflictCount)
+	assert.Equal(t, int64(1), update.ErrorCount)
+
+	// 엔터티 타입 이름은 T에서 가져옴
+	require.Contains(t, metrics.EntityTypes, "User")
+	assert.Equal(t, int64(1), metrics.EntityTypes["User"].Operations[conflux.OpDelete].NotFoundCount)
+
+	// 초기화
+	require.NoError(t, repo.ResetMetrics(ctx))
+	metrics, err = repo.GetMetrics(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, metrics.TotalOperations)
+	assert.Empty(t, metrics.Operations)
+}
+
+func TestMeteredRepository_PercentilesAndEntityBreakdown(t *testing.T) {
+	// Arrange - 두 엔터티 타입이 수집기를 공유
+	collector := conflux.NewMetricsCollector(0)
+	clock := &stepClock{}
+	users := conflux.NewMeteredRepository[*User, conflux.MapFilter](
+		memory.NewMemoryRepository[*User](func() *User { return &User{} }),
+		conflux.WithMetricsCollector(collector), conflux.WithMetricsClock(clock))
+	mails := conflux.NewMeteredRepository[*Mail, conflux.MapFilter](
+		memory.NewMemoryRepository[*Mail](func() *Mail { return &Mail{} }),
+		conflux.WithMetricsCollector(collector), conflux.WithMetricsClock(clock), conflux.WithMetricsEntityType("mail"))
+	ctx := context.Background()
+
+	// Act - 사용자 조회 응답시간 1ms ~ 100ms, 메일 조회는 모두 500ms
+	for i := 1; i <= 100; i++ {
+		clock.step = time.Duration(i) * time.Millisecond
+		_, _ = users.Exists(ctx, conflux.NewMapFilter().And("ID", "user1"))
+	}
+	clock.step = 500 * time.Millisecond
+	for i := 0; i < 10; i++ {
+		_, _ = mails.Exists(ctx, conflux.NewMapFilter().And("ID", "mail1"))
+	}
+
+	// Assert
+	metrics, err := users.GetMetrics(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(110), metrics.TotalOperations)
+	assert.Equal(t, 500.0, metrics.MaxLatencyMs)
+
+	userMetrics := metrics.EntityTypes["User"]
+	require.NotNil(t, userMetrics)
+	assert.Equal(t, int64(100), userMetrics.TotalOperations)
+	assert.Equal(t, 50.0, userMetrics.P50LatencyMs)
+	assert.Equal(t, 95.0, userMetrics.P95LatencyMs)
+	assert.Equal(t, 99.0, userMetrics.P99LatencyMs)
+	assert.InDelta(t, 50.5, userMetrics.AverageLatencyMs, 1e-9)
+
+	mailMetrics := metrics.EntityTypes["mail"]
+	require.NotNil(t, mailMetrics)
+	assert.Equal(t, int64(10), mailMetrics.Operations[conflux.OpExists].SuccessfulOps)
+	assert.Equal(t, 500.0, mailMetrics.P50LatencyMs)
+}
+
+func TestMetricsCollector_WeightsPercentilesByVolume(t *testing.T) {
+	// Arrange - 두 시리즈 모두 표본 10개만 남지만 연산 수는 100배 차이
+	collector := conflux.NewMetricsCollector(10)
+	for i := 0; i < 1000; i++ {
+		collector.Record("User", conflux.OpFindOne, conflux.OutcomeSuccess, time.Millisecond)
+	}
+	for i := 0; i < 10; i++ {
+		collector.Record("Mail", conflux.OpFindOne, conflux.OutcomeSuccess, 100*time.Millisecond)
+	}
+
+	// Act
+	metrics := collector.Snapshot()
+
+	// Assert - 합산 백분위수는 연산 수 비율(1000:10)을 따르고 표본 수(10:10)를 따르지 않음
+	assert.Equal(t, int64(1010), metrics.TotalOperations)
+	assert.Equal(t, 1.0, metrics.P50LatencyMs)
+	assert.Equal(t, 1.0, metrics.P95LatencyMs)
+	assert.Equal(t, 1.0, metrics.P99LatencyMs, "slow series is under 1% of operations")
+	assert.Equal(t, 1.0, metrics.Operations[conflux.OpFindOne].P95LatencyMs)
+
+	// 시리즈 하나만 보는 수준에서는 그 시리즈의 표본 그대로
+	assert.Equal(t, 100.0, metrics.EntityTypes["Mail"].P50LatencyMs)
+	assert.Equal(t, 1.0, metrics.EntityTypes["User"].P99LatencyMs)
+
+	// 연산이 더 쌓이면 느린 시리즈가 상위 백분위수에 나타남
+	for i := 0; i < 90; i++ {
+		collector.Record("Mail", conflux.OpFindOne, conflux.OutcomeSuccess, 100*time.Millisecond)
+	}
+	metrics = collector.Snapshot()
+	assert.Equal(t, 1.0, metrics.P50LatencyMs)
+	assert.Equal(t, 100.0, metrics.P95LatencyMs)
+}
@@ -103,6 +103,35 @@ func NewUpdateConfig() *UpdateConfig {
 	}
 }
 
+// DeleteOption FindOneAndDelete 연산의 옵션
+type DeleteOption func(*DeleteConfig)
+
+// DeleteConfig FindOneAndDelete 연산 설정
+type DeleteConfig struct {
+	// Soft true이면 엔터티를 제거하지 않고 툼스톤으로 남깁니다
+	// 툼스톤은 deleted-at이 기록되고 버전이 증가하며, 이후 조회에서 제외됩니다
+	Soft bool
+	
+	// TombstoneUpdate 소프트 삭제 시 툼스톤을 저장하기 직전에 엔터티를 변경하는 함수
+	// 버전과 deleted-at이 기록되기 전의 엔터티를 받으며, 변경 내용은 툼스톤과 함께 저장됩니다
+	TombstoneUpdate func(entity any)
+	
+	// Timeout 연산 타임아웃
+	Timeout time.Duration
+	
+	// Metadata 연산에 추가할 메타데이터
+	Metadata map[string]any
+}
+
+// NewDeleteConfig 기본 DeleteConfig 생성
+func NewDeleteConfig() *DeleteConfig {
+	return &DeleteConfig{
+		Soft:     false,
+		Timeout:  30 * time.Second,
+		Metadata: make(map[string]any),
+	}
+}
+
 // ============================================================================
 // 중복 처리 전략
 // ============================================================================
@@ -309,6 +338,37 @@ func WithUpdateMetadata(metadata map[string]any) UpdateOption {
 	}
 }
 
+// WithSoftDelete 엔터티를 제거하는 대신 툼스톤으로 남기도록 설정
+func WithSoftDelete() DeleteOption {
+	return func(config *DeleteConfig) {
+		config.Soft = true
+	}
+}
+
+// WithTombstoneUpdate 소프트 삭제로 남는 툼스톤에 함께 저장할 변경 설정
+// 하드 삭제에서는 호출되지 않습니다
+func WithTombstoneUpdate(fn func(entity any)) DeleteOption {
+	return func(config *DeleteConfig) {
+		config.TombstoneUpdate = fn
+	}
+}
+
+// WithDeleteTimeout Delete 연산의 타임아웃 설정
+func WithDeleteTimeout(timeout time.Duration) DeleteOption {
+	return func(config *DeleteConfig) {
+		config.Timeout = timeout
+	}
+}
+
+// WithDeleteMetadata Delete 연산의 메타데이터 설정
+func WithDeleteMetadata(metadata map[string]any) DeleteOption {
+	return func(config *DeleteConfig) {
+		for k, v := range metadata {
+			config.Metadata[k] = v
+		}
+	}
+}
+
 // ============================================================================
 // 미리 정의된 충돌 해결자들
 // ============================================================================
@@ -2,6 +2,7 @@ package conflux
 
 import (
 	"context"
+	"time"
 )
 
 // ============================================================================
@@ -28,6 +29,11 @@ type Repository[T any, F any] interface {
 	// options: 선택적 설정 (충돌 해결 전략, NotFound 처리 등)
 	FindOneAndUpdate(ctx context.Context, lookupFilter F, expectedVersion int64, updateFunc UpdateFunc[T], options ...UpdateOption) (*UpdateResult[T], error)
 	
+	// FindOneAndDelete 기존 엔터티를 원자적으로 삭제합니다
+	// 현재 버전이 expectedVersion과 다르면 삭제하지 않고 충돌 결과를 반환합니다
+	// options: WithSoftDelete로 툼스톤(deleted-at 기록 + 버전 증가)을 남길 수 있습니다
+	FindOneAndDelete(ctx context.Context, lookupFilter F, expectedVersion int64, options ...DeleteOption) (*DeleteResult[T], error)
+	
 	// NewEntity 새로운 엔터티 인스턴스를 생성합니다
 	// 사용자가 올바른 타입의 엔터티를 생성할 수 있도록 도와줍니다
 	NewEntity() T
@@ -96,14 +102,27 @@ type TransactionContext interface {
 }
 
 // TransactionalRepository 트랜잭션을 지원하는 레포지토리
-type TransactionalRepository[T any, ID comparable] interface {
-	Repository[T, ID]
+// WithTransaction으로 얻은 레포지토리의 쓰기는 Commit 전까지 스테이징되며,
+// Commit 시 트랜잭션이 읽거나 쓴 모든 엔터티의 버전을 검사하여 전부 적용하거나 전부 버립니다
+// F: 필터 타입 (MapFilter처럼 비교 불가능한 타입도 사용할 수 있습니다)
+//
+// Example usage:
+//
+//	tx, _ := repo.BeginTransaction(ctx)
+//	txRepo := repo.WithTransaction(tx)
+//	txRepo.FindOneAndUpdate(ctx, playerA, versionA, removeItem)
+//	txRepo.FindOneAndUpdate(ctx, playerB, versionB, addItem)
+//	if err := tx.Commit(ctx); err != nil {
+//	    // *ConflictError: 다른 쓰기와 충돌하여 아무것도 적용되지 않음
+//	}
+type TransactionalRepository[T any, F any] interface {
+	Repository[T, F]
 	
 	// BeginTransaction 새 트랜잭션을 시작합니다
 	BeginTransaction(ctx context.Context) (TransactionContext, error)
 	
 	// WithTransaction 트랜잭션 컨텍스트 내에서 작동하는 레포지토리를 반환합니다
-	WithTransaction(tx TransactionContext) Repository[T, ID]
+	WithTransaction(tx TransactionContext) Repository[T, F]
 }
 
 // ============================================================================
@@ -118,8 +137,10 @@ type CacheOptions struct {
 }
 
 // CachedRepository 캐싱을 지원하는 레포지토리
-type CachedRepository[T any, ID comparable] interface {
-	Repository[T, ID]
+// F: 필터 타입, ID: 캐시 키로 사용하는 엔터티 ID 타입
+// 기본 구현은 NewCachingRepository를 참고하세요
+type CachedRepository[T any, F any, ID comparable] interface {
+	Repository[T, F]
 	
 	// FindByIDCached 캐시를 사용하여 엔터티를 조회합니다
 	FindByIDCached(ctx context.Context, id ID, opts *CacheOptions) (T, error)
@@ -137,11 +158,13 @@ type CachedRepository[T any, ID comparable] interface {
 
 // EntityEvent 엔터티 관련 이벤트
 type EntityEvent[T any] struct {
-	Type      string    // 이벤트 타입 (created, updated, deleted)
-	Entity    T         // 관련 엔터티
-	OldEntity *T        // 이전 엔터티 (업데이트 시)
-	Version   int64     // 엔터티 버전
-	Metadata  map[string]any // 추가 메타데이터
+	ID         string    // 이벤트 ID (아웃박스 재전달 시 중복 제거용)
+	Type       string    // 이벤트 타입 (created, updated, upserted, deleted)
+	Entity     T         // 관련 엔터티 (쓰기 이후 상태)
+	OldEntity  *T        // 이전 엔터티 (업데이트/삭제 시)
+	Version    int64     // 엔터티 버전
+	OccurredAt time.Time // 쓰기 시각
+	Metadata   map[string]any // 추가 메타데이터
 }
 
 // EventHandler 엔터티 이벤트를 처리하는 핸들러
@@ -151,8 +174,9 @@ type EventHandler[T any] interface {
 }
 
 // EventAwareRepository 이벤트를 발생시키는 레포지토리
-type EventAwareRepository[T any, ID comparable] interface {
-	Repository[T, ID]
+// 기본 구현은 NewEventingRepository를 참고하세요
+type EventAwareRepository[T any, F any] interface {
+	Repository[T, F]
 	
 	// RegisterEventHandler 이벤트 핸들러를 등록합니다
 	RegisterEventHandler(handler EventHandler[T]) error
@@ -170,13 +194,27 @@ type RepositoryMetrics struct {
 	TotalOperations   int64   // 총 연산 수
 	SuccessfulOps     int64   // 성공한 연산 수
 	ConflictCount     int64   // 충돌 발생 수
+	DuplicateCount    int64   // 중복으로 생성되지 않은 수
+	NotFoundCount     int64   // 대상 엔터티가 없었던 수
+	ErrorCount        int64   // 에러를 반환한 연산 수
 	AverageLatencyMs  float64 // 평균 응답시간(밀리초)
+	P50LatencyMs      float64 // 응답시간 중앙값(밀리초)
+	P95LatencyMs      float64 // 응답시간 95 백분위수(밀리초)
+	P99LatencyMs      float64 // 응답시간 99 백분위수(밀리초)
+	MaxLatencyMs      float64 // 최대 응답시간(밀리초)
 	ErrorRate         float64 // 에러율 (0.0 ~ 1.0)
+
+	// Operations 연산 종류별 메트릭 (키: OpInsert, OpUpdate 등)
+	Operations map[string]*RepositoryMetrics
+
+	// EntityTypes 엔터티 타입별 메트릭 (각 항목은 Operations를 포함)
+	EntityTypes map[string]*RepositoryMetrics
 }
 
 // MetricsRepository 메트릭 수집을 지원하는 레포지토리
-type MetricsRepository[T any, ID comparable] interface {
-	Repository[T, ID]
+// 기본 구현은 NewMeteredRepository를 참고하세요
+type MetricsRepository[T any, F any] interface {
+	Repository[T, F]
 	
 	// GetMetrics 현재까지의 메트릭을 반환합니다
 	GetMetrics(ctx context.Context) (*RepositoryMetrics, error)
@@ -0,0 +1,204 @@
+package conflux_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/homveloper/dukdakit/conflux"
+	memory "github.com/homveloper/dukdakit/conflux/adapters/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// 트랜잭션 테스트 - 플레이어 간 아이템(크레딧) 이동
+// ============================================================================
+
+func setupPlayers(t *testing.T) interface {
+	conflux.TransactionalRepository[*User, conflux.MapFilter]
+	conflux.ReadRepository[*User, conflux.MapFilter]
+} {
+	repo := memory.NewMemoryRepository[*User](func() *User {
+		return &User{}
+	})
+
+	ctx := context.Background()
+	for _, id := range []string{"playerA", "playerB"} {
+		id := id
+		_, err := repo.FindOneAndInsert(ctx, conflux.NewMapFilter().And("ID", id),
+			conflux.NewCreateFunc(func(ctx context.Context) (*User, error) {
+				return &User{ID: id, Credits: 100}, nil
+			}))
+		require.NoError(t, err)
+	}
+	return repo
+}
+
+func transferCredits(amount int) (conflux.UpdateFunc[*User], conflux.UpdateFunc[*User]) {
+	withdraw := conflux.NewUpdateFunc(func(ctx context.Context, existing *User) (*User, error) {
+		existing.Credits -= amount
+		return existing, nil
+	})
+	deposit := conflux.NewUpdateFunc(func(ctx context.Context, existing *User) (*User, error) {
+		existing.Credits += amount
+		return existing, nil
+	})
+	return withdraw, deposit
+}
+
+func TestTransaction_CommitAppliesAllWrites(t *testing.T) {
+	// Arrange
+	repo := setupPlayers(t)
+	ctx := context.Background()
+	playerA := conflux.NewMapFilter().And("ID", "playerA")
+	playerB := conflux.NewMapFilter().And("ID", "playerB")
+	withdraw, deposit := transferCredits(30)
+
+	tx, err := repo.BeginTransaction(ctx)
+	require.NoError(t, err)
+	txRepo := repo.WithTransaction(tx)
+
+	// Act
+	resultA, err := txRepo.FindOneAndUpdate(ctx, playerA, 1, withdraw)
+	require.NoError(t, err)
+	require.True(t, resultA.IsSuccess())
+	resultB, err := txRepo.FindOneAndUpdate(ctx, playerB, 1, deposit)
+	require.NoError(t, err)
+	require.True(t, resultB.IsSuccess())
+
+	// 커밋 전에는 바깥에서 보이지 않음
+	before, err := repo.FindOne(ctx, playerA)
+	require.NoError(t, err)
+	assert.Equal(t, 100, before.Credits)
+
+	require.NoError(t, tx.Commit(ctx))
+
+	// Assert
+	assert.False(t, tx.IsActive())
+	a, err := repo.FindOne(ctx, playerA)
+	require.NoError(t, err)
+	b, err := repo.FindOne(ctx, playerB)
+	require.NoError(t, err)
+	assert.Equal(t, 70, a.Credits)
+	assert.Equal(t, 130, b.Credits)
+	assert.Equal(t, int64(2), a.Version)
+	assert.Equal(t, int64(2), b.Version)
+}
+
+func TestTransaction_ConflictAppliesNothing(t *testing.T) {
+	// Arrange
+	repo := setupPlayers(t)
+	ctx := context.Background()
+	playerA := conflux.NewMapFilter().And("ID", "playerA")
+	playerB := conflux.NewMapFilter().And("ID", "playerB")
+	withdraw, deposit := transferCredits(30)
+
+	tx, err := repo.BeginTransaction(ctx)
+	require.NoError(t, err)
+	txRepo := repo.WithTransaction(tx)
+
+	_, err = txRepo.FindOneAndUpdate(ctx, playerA, 1, withdraw)
+	require.NoError(t, err)
+	_, err = txRepo.FindOneAndUpdate(ctx, playerB, 1, deposit)
+	require.NoError(t, err)
+
+	// 트랜잭션 밖에서 플레이어 B가 먼저 변경됨
+	_, err = repo.FindOneAndUpdate(ctx, playerB, 1, deposit)
+	require.NoError(t, err)
+
+	// Act
+	err = tx.Commit(ctx)
+
+	// Assert - 둘 다 적용되지 않음
+	var conflictErr *conflux.ConflictError
+	require.True(t, errors.As(err, &conflictErr))
+	assert.Equal(t, int64(1), conflictErr.ExpectedVersion)
+	assert.Equal(t, int64(2), conflictErr.ActualVersion)
+
+	a, err := repo.FindOne(ctx, playerA)
+	require.NoError(t, err)
+	assert.Equal(t, 100, a.Credits)
+	assert.Equal(t, int64(1), a.Version)
+}
+
+func TestTransaction_Rollback(t *testing.T) {
+	// Arrange
+	repo := setupPlayers(t)
+	ctx := context.Background()
+	playerA := conflux.NewMapFilter().And("ID", "playerA")
+
+	tx, err := repo.BeginTransaction(ctx)
+	require.NoError(t, err)
+	txRepo := repo.WithTransaction(tx)
+
+	_, err = txRepo.FindOneAndDelete(ctx, playerA, 1)
+	require.NoError(t, err)
+	_, err = txRepo.FindOneAndInsert(ctx, conflux.NewMapFilter().And("ID", "playerC"),
+		conflux.NewCreateFunc(func(ctx context.Context) (*User, error) {
+			return &User{ID: "playerC"}, nil
+		}))
+	require.NoError(t, err)
+
+	// 트랜잭션 안에서는 스테이징된 상태가 보임
+	deleted, err := txRepo.FindOneAndDelete(ctx, playerA, 1)
+	require.NoError(t, err)
+	assert.True(t, deleted.IsNotFound())
+
+	// Act
+	require.NoError(t, tx.Rollback(ctx))
+
+	// Assert
+	exists, err := repo.Exists(ctx, playerA)
+	require.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = repo.Exists(ctx, conflux.NewMapFilter().And("ID", "playerC"))
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// 종료된 트랜잭션은 더 이상 사용할 수 없음
+	assert.ErrorIs(t, tx.Commit(ctx), conflux.ErrTransactionClosed)
+	_, err = txRepo.FindOneAndDelete(ctx, playerA, 1)
+	assert.ErrorIs(t, err, conflux.ErrTransactionClosed)
+}
+
+func TestTransaction_ConcurrentInsertsUnderSameFilterConflict(t *testing.T) {
+	// Arrange - 두 트랜잭션이 같은 이메일로 서로 다른 ID의 사용자를 생성
+	repo := setupPlayers(t)
+	ctx := context.Background()
+	byEmail := conflux.NewMapFilter().And("Email", "new@example.com")
+	register := func(id string) conflux.CreateFunc[*User] {
+		return conflux.NewCreateFunc(func(ctx context.Context) (*User, error) {
+			return &User{ID: id, Email: "new@example.com"}, nil
+		})
+	}
+
+	first, err := repo.BeginTransaction(ctx)
+	require.NoError(t, err)
+	second, err := repo.BeginTransaction(ctx)
+	require.NoError(t, err)
+
+	inserted, err := repo.WithTransaction(first).FindOneAndInsert(ctx, byEmail, register("userA"))
+	require.NoError(t, err)
+	require.True(t, inserted.IsSuccess())
+	inserted, err = repo.WithTransaction(second).FindOneAndInsert(ctx, byEmail, register("userB"))
+	require.NoError(t, err)
+	require.True(t, inserted.IsSuccess())
+
+	// Act
+	require.NoError(t, first.Commit(ctx))
+	err = second.Commit(ctx)
+
+	// Assert - 나중 커밋은 중복으로 충돌하고 아무것도 적용하지 않음
+	var conflictErr *conflux.ConflictError
+	require.True(t, errors.As(err, &conflictErr))
+	assert.Equal(t, int64(0), conflictErr.ExpectedVersion)
+	assert.Equal(t, int64(1), conflictErr.ActualVersion)
+
+	exists, err := repo.Exists(ctx, conflux.NewMapFilter().And("ID", "userB"))
+	require.NoError(t, err)
+	assert.False(t, exists)
+	found, err := repo.FindOne(ctx, byEmail)
+	require.NoError(t, err)
+	assert.Equal(t, "userA", found.ID)
+}
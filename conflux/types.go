@@ -2,6 +2,7 @@ package conflux
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 )
@@ -134,6 +135,61 @@ func (r *UpdateResult[T]) IsNotFound() bool {
 	return r.NotFound
 }
 
+// DeleteResult FindOneAndDelete 연산의 결과
+type DeleteResult[T any] struct {
+	Entity          T         // 삭제된 엔터티 (소프트 삭제 시 툼스톤)
+	Version         int64     // 삭제 시점의 버전 (소프트 삭제 시 증가된 버전)
+	DeletedAt       time.Time // 삭제 시각
+	SoftDeleted     bool      // 툼스톤으로 남겼는지 여부
+	VersionConflict bool      // 버전 충돌 발생 여부
+	NotFound        bool      // 엔터티를 찾지 못한 경우
+}
+
+// NewDeleteResult 새 DeleteResult 생성
+func NewDeleteResult[T any](entity T, version int64, deletedAt time.Time, soft bool) *DeleteResult[T] {
+	return &DeleteResult[T]{
+		Entity:      entity,
+		Version:     version,
+		DeletedAt:   deletedAt,
+		SoftDeleted: soft,
+	}
+}
+
+// NewDeleteConflictResult 삭제 시 버전 충돌 결과
+// 엔터티는 삭제되지 않으며 현재 엔터티와 버전을 담습니다
+func NewDeleteConflictResult[T any](currentEntity T, currentVersion int64) *DeleteResult[T] {
+	return &DeleteResult[T]{
+		Entity:          currentEntity,
+		Version:         currentVersion,
+		VersionConflict: true,
+	}
+}
+
+// NewDeleteNotFoundResult 삭제할 엔터티를 찾지 못한 경우의 결과
+func NewDeleteNotFoundResult[T any]() *DeleteResult[T] {
+	return &DeleteResult[T]{NotFound: true}
+}
+
+// IsSuccess 삭제가 성공했는지 확인
+func (r *DeleteResult[T]) IsSuccess() bool {
+	return !r.VersionConflict && !r.NotFound
+}
+
+// HasVersionConflict 버전 충돌이 발생했는지 확인
+func (r *DeleteResult[T]) HasVersionConflict() bool {
+	return r.VersionConflict
+}
+
+// IsNotFound 엔터티를 찾지 못했는지 확인
+func (r *DeleteResult[T]) IsNotFound() bool {
+	return r.NotFound
+}
+
+// IsSoftDeleted 툼스톤으로 삭제되었는지 확인
+func (r *DeleteResult[T]) IsSoftDeleted() bool {
+	return r.SoftDeleted
+}
+
 // ============================================================================
 // 공통 인터페이스
 // ============================================================================
@@ -174,6 +230,16 @@ func (r *UpdateResult[T]) GetVersion() int64 {
 	return r.Version
 }
 
+// GetEntity DeleteResult의 엔터티 반환
+func (r *DeleteResult[T]) GetEntity() T {
+	return r.Entity
+}
+
+// GetVersion DeleteResult의 버전 반환
+func (r *DeleteResult[T]) GetVersion() int64 {
+	return r.Version
+}
+
 // ============================================================================
 // 엔터티 로직 인터페이스 - IoC 패턴의 핵심
 // ============================================================================
@@ -326,12 +392,46 @@ type Timestamped interface {
 	SetUpdatedAt(t time.Time)
 }
 
+// SoftDeletable 소프트 삭제(툼스톤)를 지원하는 엔터티 인터페이스
+// 삭제되지 않은 엔터티의 GetDeletedAt은 nil을 반환합니다
+type SoftDeletable interface {
+	GetDeletedAt() *time.Time
+	SetDeletedAt(t *time.Time)
+}
+
 // Entity 완전한 엔터티가 구현해야 하는 인터페이스 조합
 type Entity interface {
 	Versioned
 	Timestamped
 }
 
+// ============================================================================
+// 시간 소스 인터페이스
+// ============================================================================
+
+// Clock 현재 시각을 제공하는 인터페이스
+// 어댑터들은 타임스탬프 기록 시 이 인터페이스를 사용하므로
+// 테스트에서는 dukdakit의 FakeClock 같은 제어 가능한 구현체를 주입할 수 있습니다
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock 시스템 시간을 사용하는 기본 Clock 구현체
+type SystemClock struct{}
+
+// Now 현재 시스템 시각 반환
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// ClockOrSystem clock이 nil이면 SystemClock을 반환합니다
+func ClockOrSystem(clock Clock) Clock {
+	if clock == nil {
+		return SystemClock{}
+	}
+	return clock
+}
+
 // ============================================================================
 // 기본 엔터티 구현체
 // ============================================================================
@@ -339,9 +439,10 @@ type Entity interface {
 // BaseEntity 기본적인 버전 및 타임스탬프 관리 기능을 제공하는 구조체
 // 사용자 정의 엔터티에 임베드하여 사용할 수 있습니다
 type BaseEntity struct {
-	Version   int64     `json:"version" bson:"version"`
-	CreatedAt time.Time `json:"created_at" bson:"created_at"`
-	UpdatedAt time.Time `json:"updated_at" bson:"updated_at"`
+	Version   int64      `json:"version" bson:"version"`
+	CreatedAt time.Time  `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at" bson:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
 }
 
 // GetVersion 버전 반환
@@ -374,10 +475,34 @@ func (e *BaseEntity) SetUpdatedAt(t time.Time) {
 	e.UpdatedAt = t
 }
 
+// GetDeletedAt 소프트 삭제 시간 반환 (삭제되지 않았으면 nil)
+func (e *BaseEntity) GetDeletedAt() *time.Time {
+	return e.DeletedAt
+}
+
+// SetDeletedAt 소프트 삭제 시간 설정
+func (e *BaseEntity) SetDeletedAt(t *time.Time) {
+	e.DeletedAt = t
+}
+
+// IsDeleted 소프트 삭제된 엔터티인지 확인
+func (e *BaseEntity) IsDeleted() bool {
+	return e.DeletedAt != nil
+}
+
 // ============================================================================
 // 에러 타입들
 // ============================================================================
 
+// ErrNotFound 조회 대상 엔터티가 없는 경우
+var ErrNotFound = errors.New("entity not found")
+
+// ErrTransactionClosed 이미 커밋되거나 롤백된 트랜잭션을 사용한 경우
+var ErrTransactionClosed = errors.New("transaction is no longer active")
+
+// ErrInvalidTransaction 다른 레포지토리에서 시작된 트랜잭션을 사용한 경우
+var ErrInvalidTransaction = errors.New("transaction does not belong to this repository")
+
 // ConflictError 버전 충돌 에러
 type ConflictError struct {
 	ExpectedVersion int64
@@ -21,6 +21,7 @@ type MemoryAdapter struct {
 	friendships   map[friendit.FriendshipID]friendit.BasicFriendship
 	friendRequests map[friendit.RequestID]friendit.BasicFriendRequest
 	blockRelations map[friendit.BlockID]friendit.BasicBlockRelation
+	clock         friendit.Clock // 타임스탬프 시각 소스 (nil이면 시스템 시간)
 	mu            sync.RWMutex
 }
 
@@ -34,6 +35,18 @@ func NewMemoryAdapter() *MemoryAdapter {
 	}
 }
 
+// SetClock sets the clock used for timestamps by every repository of this adapter
+func (ma *MemoryAdapter) SetClock(clock friendit.Clock) {
+	ma.mu.Lock()
+	defer ma.mu.Unlock()
+	ma.clock = clock
+}
+
+// now returns the current time; callers hold ma.mu
+func (ma *MemoryAdapter) now() time.Time {
+	return friendit.ClockOrSystem(ma.clock).Now()
+}
+
 // Close is a no-op for memory adapter
 func (ma *MemoryAdapter) Close() error {
 	return nil
@@ -53,6 +66,11 @@ func (ma *MemoryAdapter) NewMemoryUserRepository() *MemoryUserRepository {
 	return &MemoryUserRepository{adapter: ma}
 }
 
+// SetClock implements friendit.ClockSetter; the clock is shared with the adapter
+func (r *MemoryUserRepository) SetClock(clock friendit.Clock) {
+	r.adapter.SetClock(clock)
+}
+
 // Create implements UserRepository.Create for BasicUser
 func (r *MemoryUserRepository) Create(ctx context.Context, user friendit.BasicUser) error {
 	r.adapter.mu.Lock()
@@ -62,8 +80,8 @@ func (r *MemoryUserRepository) Create(ctx context.Context, user friendit.BasicUs
 		return fmt.Errorf("user already exists: %s", user.ID)
 	}
 
-	user.CreatedAt = time.Now()
-	user.UpdatedAt = time.Now()
+	user.CreatedAt = r.adapter.now()
+	user.UpdatedAt = r.adapter.now()
 	r.adapter.users[user.ID] = user
 
 	return nil
@@ -91,7 +109,7 @@ func (r *MemoryUserRepository) Update(ctx context.Context, user friendit.BasicUs
 		return fmt.Errorf("user not found: %s", user.ID)
 	}
 
-	user.UpdatedAt = time.Now()
+	user.UpdatedAt = r.adapter.now()
 	r.adapter.users[user.ID] = user
 
 	return nil
@@ -177,7 +195,7 @@ func (r *MemoryUserRepository) UpdateStatus(ctx context.Context, id friendit.Use
 	}
 
 	user.Status = status
-	now := time.Now()
+	now := r.adapter.now()
 	user.LastSeen = &now
 	user.UpdatedAt = now
 	r.adapter.users[id] = user
@@ -204,6 +222,11 @@ func (ma *MemoryAdapter) NewMemoryFriendshipRepository() *MemoryFriendshipReposi
 	return &MemoryFriendshipRepository{adapter: ma}
 }
 
+// SetClock implements friendit.ClockSetter; the clock is shared with the adapter
+func (r *MemoryFriendshipRepository) SetClock(clock friendit.Clock) {
+	r.adapter.SetClock(clock)
+}
+
 // Create implements FriendshipRepository.Create for BasicFriendship
 func (r *MemoryFriendshipRepository) Create(ctx context.Context, friendship friendit.BasicFriendship) error {
 	r.adapter.mu.Lock()
@@ -213,8 +236,8 @@ func (r *MemoryFriendshipRepository) Create(ctx context.Context, friendship frie
 		return fmt.Errorf("friendship already exists: %s", friendship.ID)
 	}
 
-	friendship.CreatedAt = time.Now()
-	friendship.UpdatedAt = time.Now()
+	friendship.CreatedAt = r.adapter.now()
+	friendship.UpdatedAt = r.adapter.now()
 	r.adapter.friendships[friendship.ID] = friendship
 
 	return nil
@@ -272,7 +295,7 @@ func (r *MemoryFriendshipRepository) Update(ctx context.Context, friendship frie
 		return fmt.Errorf("friendship not found: %s", friendship.ID)
 	}
 
-	friendship.UpdatedAt = time.Now()
+	friendship.UpdatedAt = r.adapter.now()
 	r.adapter.friendships[friendship.ID] = friendship
 
 	return nil
@@ -357,7 +380,7 @@ func (r *MemoryFriendshipRepository) UpdateStatus(ctx context.Context, id friend
 	}
 
 	friendship.Status = status
-	friendship.UpdatedAt = time.Now()
+	friendship.UpdatedAt = r.adapter.now()
 	r.adapter.friendships[id] = friendship
 
 	return nil
@@ -20,6 +20,7 @@ type ConcurrentMemoryUserRepository struct {
 	mu       sync.RWMutex
 	users    map[friendit.UserID]*versionedUser
 	versions map[friendit.UserID]int64
+	clock    friendit.Clock // 타임스탬프 시각 소스 (nil이면 시스템 시간)
 }
 
 // versionedUser 버전 정보를 포함한 사용자 엔터티
@@ -36,6 +37,18 @@ func NewConcurrentMemoryUserRepository() *ConcurrentMemoryUserRepository {
 	}
 }
 
+// SetClock implements friendit.ClockSetter
+func (r *ConcurrentMemoryUserRepository) SetClock(clock friendit.Clock) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.clock = clock
+}
+
+// now 현재 시각 (호출자가 r.mu를 잡고 있어야 함)
+func (r *ConcurrentMemoryUserRepository) now() time.Time {
+	return friendit.ClockOrSystem(r.clock).Now()
+}
+
 // ============================================================================
 // 기본 CRUD 연산들
 // ============================================================================
@@ -138,7 +151,7 @@ func (r *ConcurrentMemoryUserRepository) FindOneAndUpsert(
 		r.users[userID] = versioned
 		r.versions[userID] = version
 		
-		return friendit.NewAtomicResult(newEntity, true, version), nil
+		return friendit.NewAtomicResultAt(newEntity, true, version, r.now()), nil
 	}
 	
 	// 업데이트
@@ -156,7 +169,7 @@ func (r *ConcurrentMemoryUserRepository) FindOneAndUpsert(
 	r.users[userID] = versioned
 	r.versions[userID] = newVersion
 	
-	return friendit.NewAtomicResult(updatedEntity, false, newVersion), nil
+	return friendit.NewAtomicResultAt(updatedEntity, false, newVersion, r.now()), nil
 }
 
 // FindOneAndInsert 원자적 사용자 생성 (중복 방지)
@@ -186,7 +199,7 @@ func (r *ConcurrentMemoryUserRepository) FindOneAndInsert(
 	r.users[newEntity.ID] = versioned
 	r.versions[newEntity.ID] = version
 	
-	return friendit.NewAtomicResult(newEntity, true, version), nil
+	return friendit.NewAtomicResultAt(newEntity, true, version, r.now()), nil
 }
 
 // FindOneAndUpdate 원자적 사용자 업데이트
@@ -217,7 +230,7 @@ func (r *ConcurrentMemoryUserRepository) FindOneAndUpdate(
 	r.users[id] = versioned
 	r.versions[id] = newVersion
 	
-	return friendit.NewAtomicResult(updatedEntity, false, newVersion), nil
+	return friendit.NewAtomicResultAt(updatedEntity, false, newVersion, r.now()), nil
 }
 
 // UpdateIfVersion 낙관적 잠금을 사용한 조건부 업데이트
@@ -253,7 +266,7 @@ func (r *ConcurrentMemoryUserRepository) UpdateIfVersion(
 	r.users[id] = versioned
 	r.versions[id] = newVersion
 	
-	return friendit.NewAtomicResult(updatedEntity, false, newVersion), nil
+	return friendit.NewAtomicResultAt(updatedEntity, false, newVersion, r.now()), nil
 }
 
 func (r *ConcurrentMemoryUserRepository) SoftDelete(ctx context.Context, id friendit.UserID) error {
@@ -266,7 +279,7 @@ func (r *ConcurrentMemoryUserRepository) SoftDelete(ctx context.Context, id frie
 	}
 	
 	user.BasicUser.Status = "deleted"
-	user.BasicUser.UpdatedAt = time.Now()
+	user.BasicUser.UpdatedAt = r.now()
 	user.Version++
 	r.versions[id] = user.Version
 	
@@ -378,7 +391,9 @@ func (r *ConcurrentMemoryUserRepository) UpdateStatus(ctx context.Context, id fr
 	}
 	
 	user.BasicUser.Status = status
-	user.BasicUser.SetLastSeen(time.Now())
+	now := r.now()
+	user.BasicUser.LastSeen = &now
+	user.BasicUser.UpdatedAt = now
 	user.Version++
 	r.versions[id] = user.Version
 	
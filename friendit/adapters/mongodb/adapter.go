@@ -21,6 +21,7 @@ import (
 type MongoAdapter struct {
 	client   *mongo.Client
 	database *mongo.Database
+	clock    friendit.Clock // 타임스탬프 시각 소스 (nil이면 시스템 시간)
 }
 
 // NewMongoAdapter creates a new MongoDB adapter
@@ -41,6 +42,11 @@ func NewMongoAdapter(connectionString, databaseName string) (*MongoAdapter, erro
 	}, nil
 }
 
+// SetClock sets the clock that repositories created afterwards use for timestamps
+func (ma *MongoAdapter) SetClock(clock friendit.Clock) {
+	ma.clock = clock
+}
+
 // Close closes the MongoDB connection
 func (ma *MongoAdapter) Close() error {
 	return ma.client.Disconnect(context.Background())
@@ -53,19 +59,30 @@ func (ma *MongoAdapter) Close() error {
 // MongoUserRepository implements UserRepository for MongoDB with BasicUser
 type MongoUserRepository struct {
 	collection *mongo.Collection
+	clock      friendit.Clock
 }
 
 // NewMongoUserRepository creates a new MongoDB user repository
 func (ma *MongoAdapter) NewMongoUserRepository() *MongoUserRepository {
 	return &MongoUserRepository{
 		collection: ma.database.Collection("users"),
+		clock:      ma.clock,
 	}
 }
 
+// SetClock implements friendit.ClockSetter
+func (r *MongoUserRepository) SetClock(clock friendit.Clock) {
+	r.clock = clock
+}
+
+func (r *MongoUserRepository) now() time.Time {
+	return friendit.ClockOrSystem(r.clock).Now()
+}
+
 // Create implements UserRepository.Create for BasicUser
 func (r *MongoUserRepository) Create(ctx context.Context, user friendit.BasicUser) error {
-	user.CreatedAt = time.Now()
-	user.UpdatedAt = time.Now()
+	user.CreatedAt = r.now()
+	user.UpdatedAt = r.now()
 
 	_, err := r.collection.InsertOne(ctx, user)
 	if err != nil {
@@ -91,7 +108,7 @@ func (r *MongoUserRepository) GetByID(ctx context.Context, id friendit.UserID) (
 
 // Update implements UserRepository.Update for BasicUser
 func (r *MongoUserRepository) Update(ctx context.Context, user friendit.BasicUser) error {
-	user.UpdatedAt = time.Now()
+	user.UpdatedAt = r.now()
 
 	filter := bson.M{"_id": user.ID}
 	update := bson.M{"$set": user}
@@ -188,8 +205,8 @@ func (r *MongoUserRepository) UpdateStatus(ctx context.Context, id friendit.User
 	update := bson.M{
 		"$set": bson.M{
 			"status":     status,
-			"last_seen":  time.Now(),
-			"updated_at": time.Now(),
+			"last_seen":  r.now(),
+			"updated_at": r.now(),
 		},
 	}
 
@@ -230,19 +247,30 @@ func (r *MongoUserRepository) GetOnlineUsers(ctx context.Context) ([]friendit.Ba
 // MongoFriendshipRepository implements FriendshipRepository for MongoDB with BasicFriendship
 type MongoFriendshipRepository struct {
 	collection *mongo.Collection
+	clock      friendit.Clock
 }
 
 // NewMongoFriendshipRepository creates a new MongoDB friendship repository
 func (ma *MongoAdapter) NewMongoFriendshipRepository() *MongoFriendshipRepository {
 	return &MongoFriendshipRepository{
 		collection: ma.database.Collection("friendships"),
+		clock:      ma.clock,
 	}
 }
 
+// SetClock implements friendit.ClockSetter
+func (r *MongoFriendshipRepository) SetClock(clock friendit.Clock) {
+	r.clock = clock
+}
+
+func (r *MongoFriendshipRepository) now() time.Time {
+	return friendit.ClockOrSystem(r.clock).Now()
+}
+
 // Create implements FriendshipRepository.Create for BasicFriendship
 func (r *MongoFriendshipRepository) Create(ctx context.Context, friendship friendit.BasicFriendship) error {
-	friendship.CreatedAt = time.Now()
-	friendship.UpdatedAt = time.Now()
+	friendship.CreatedAt = r.now()
+	friendship.UpdatedAt = r.now()
 
 	_, err := r.collection.InsertOne(ctx, friendship)
 	if err != nil {
@@ -313,7 +341,7 @@ func (r *MongoFriendshipRepository) GetByUserID(ctx context.Context, userID frie
 
 // Update implements FriendshipRepository.Update for BasicFriendship
 func (r *MongoFriendshipRepository) Update(ctx context.Context, friendship friendit.BasicFriendship) error {
-	friendship.UpdatedAt = time.Now()
+	friendship.UpdatedAt = r.now()
 
 	filter := bson.M{"_id": friendship.ID}
 	update := bson.M{"$set": friendship}
@@ -450,7 +478,7 @@ func (r *MongoFriendshipRepository) UpdateStatus(ctx context.Context, id friendi
 	update := bson.M{
 		"$set": bson.M{
 			"status":     status,
-			"updated_at": time.Now(),
+			"updated_at": r.now(),
 		},
 	}
 
@@ -17,7 +17,8 @@ import (
 // PostgresAdapter provides PostgreSQL implementation of repositories
 // 이것은 예제 구현입니다. 사용자가 자신의 요구사항에 맞게 수정할 수 있습니다.
 type PostgresAdapter struct {
-	db *sql.DB
+	db    *sql.DB
+	clock friendit.Clock // 타임스탬프 시각 소스 (nil이면 시스템 시간)
 }
 
 // NewPostgresAdapter creates a new PostgreSQL adapter
@@ -42,6 +43,11 @@ func NewPostgresAdapter(connectionString string) (*PostgresAdapter, error) {
 	return adapter, nil
 }
 
+// SetClock sets the clock that repositories created afterwards use for timestamps
+func (pa *PostgresAdapter) SetClock(clock friendit.Clock) {
+	pa.clock = clock
+}
+
 // Close closes the database connection
 func (pa *PostgresAdapter) Close() error {
 	return pa.db.Close()
@@ -129,18 +135,28 @@ func (pa *PostgresAdapter) initTables() error {
 
 // PostgresUserRepository implements UserRepository for PostgreSQL with BasicUser
 type PostgresUserRepository struct {
-	db *sql.DB
+	db    *sql.DB
+	clock friendit.Clock
 }
 
 // NewPostgresUserRepository creates a new PostgreSQL user repository
 func (pa *PostgresAdapter) NewPostgresUserRepository() *PostgresUserRepository {
-	return &PostgresUserRepository{db: pa.db}
+	return &PostgresUserRepository{db: pa.db, clock: pa.clock}
+}
+
+// SetClock implements friendit.ClockSetter
+func (r *PostgresUserRepository) SetClock(clock friendit.Clock) {
+	r.clock = clock
+}
+
+func (r *PostgresUserRepository) now() time.Time {
+	return friendit.ClockOrSystem(r.clock).Now()
 }
 
 // Create implements UserRepository.Create for BasicUser
 func (r *PostgresUserRepository) Create(ctx context.Context, user friendit.BasicUser) error {
-	user.CreatedAt = time.Now()
-	user.UpdatedAt = time.Now()
+	user.CreatedAt = r.now()
+	user.UpdatedAt = r.now()
 
 	query := `INSERT INTO friendit_users (id, status, last_seen, username, display_name, created_at, updated_at) 
 			  VALUES ($1, $2, $3, $4, $5, $6, $7)`
@@ -177,7 +193,7 @@ func (r *PostgresUserRepository) GetByID(ctx context.Context, id friendit.UserID
 
 // Update implements UserRepository.Update for BasicUser
 func (r *PostgresUserRepository) Update(ctx context.Context, user friendit.BasicUser) error {
-	user.UpdatedAt = time.Now()
+	user.UpdatedAt = r.now()
 	
 	query := `UPDATE friendit_users SET status = $2, last_seen = $3, username = $4, 
 			  display_name = $5, updated_at = $6 WHERE id = $1`
@@ -327,7 +343,7 @@ func (r *PostgresUserRepository) Search(ctx context.Context, query string, limit
 func (r *PostgresUserRepository) UpdateStatus(ctx context.Context, id friendit.UserID, status string) error {
 	query := `UPDATE friendit_users SET status = $2, last_seen = $3, updated_at = $4 WHERE id = $1`
 	
-	now := time.Now()
+	now := r.now()
 	result, err := r.db.ExecContext(ctx, query, id, status, now, now)
 	if err != nil {
 		return fmt.Errorf("failed to update user status: %w", err)
@@ -18,6 +18,7 @@ import (
 // 이것은 예제 구현입니다. 사용자가 자신의 요구사항에 맞게 수정할 수 있습니다.
 type RedisAdapter struct {
 	client *redis.Client
+	clock  friendit.Clock // 타임스탬프 시각 소스 (nil이면 시스템 시간)
 }
 
 // NewRedisAdapter creates a new Redis adapter
@@ -39,6 +40,11 @@ func NewRedisAdapter(addr, password string, db int) (*RedisAdapter, error) {
 	}, nil
 }
 
+// SetClock sets the clock that repositories created afterwards use for timestamps
+func (ra *RedisAdapter) SetClock(clock friendit.Clock) {
+	ra.clock = clock
+}
+
 // Close closes the Redis connection
 func (ra *RedisAdapter) Close() error {
 	return ra.client.Close()
@@ -52,6 +58,7 @@ func (ra *RedisAdapter) Close() error {
 type RedisUserRepository struct {
 	client *redis.Client
 	keyPrefix string
+	clock     friendit.Clock
 }
 
 // NewRedisUserRepository creates a new Redis user repository
@@ -59,13 +66,23 @@ func (ra *RedisAdapter) NewRedisUserRepository() *RedisUserRepository {
 	return &RedisUserRepository{
 		client:    ra.client,
 		keyPrefix: "friendit:users:",
+		clock:     ra.clock,
 	}
 }
 
+// SetClock implements friendit.ClockSetter
+func (r *RedisUserRepository) SetClock(clock friendit.Clock) {
+	r.clock = clock
+}
+
+func (r *RedisUserRepository) now() time.Time {
+	return friendit.ClockOrSystem(r.clock).Now()
+}
+
 // Create implements UserRepository.Create for BasicUser
 func (r *RedisUserRepository) Create(ctx context.Context, user friendit.BasicUser) error {
-	user.CreatedAt = time.Now()
-	user.UpdatedAt = time.Now()
+	user.CreatedAt = r.now()
+	user.UpdatedAt = r.now()
 	
 	data, err := json.Marshal(user)
 	if err != nil {
@@ -102,7 +119,7 @@ func (r *RedisUserRepository) GetByID(ctx context.Context, id friendit.UserID) (
 
 // Update implements UserRepository.Update for BasicUser
 func (r *RedisUserRepository) Update(ctx context.Context, user friendit.BasicUser) error {
-	user.UpdatedAt = time.Now()
+	user.UpdatedAt = r.now()
 	
 	// Check if user exists
 	key := r.keyPrefix + string(user.ID)
@@ -215,7 +232,7 @@ func (r *RedisUserRepository) UpdateStatus(ctx context.Context, id friendit.User
 	}
 	
 	user.Status = status
-	now := time.Now()
+	now := r.now()
 	user.LastSeen = &now
 	user.UpdatedAt = now
 	
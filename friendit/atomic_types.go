@@ -34,13 +34,18 @@ type ConflictResolver[T any] interface {
 // 헬퍼 함수들
 // ============================================================================
 
-// NewAtomicResult 원자적 연산 결과 생성 헬퍼
+// NewAtomicResult 원자적 연산 결과 생성 헬퍼 (수정 시각은 시스템 시간)
 func NewAtomicResult[T any](entity T, created bool, version int64) *AtomicResult[T] {
+	return NewAtomicResultAt(entity, created, version, time.Now())
+}
+
+// NewAtomicResultAt 주어진 수정 시각으로 원자적 연산 결과 생성 (주입된 Clock을 쓰는 저장소용)
+func NewAtomicResultAt[T any](entity T, created bool, version int64, modifiedAt time.Time) *AtomicResult[T] {
 	return &AtomicResult[T]{
 		Entity:     entity,
 		Created:    created,
 		Version:    version,
-		ModifiedAt: time.Now(),
+		ModifiedAt: modifiedAt,
 	}
 }
 
@@ -3,7 +3,6 @@ package friendit
 import (
 	"context"
 	"fmt"
-	"time"
 )
 
 // ============================================================================
@@ -38,6 +37,7 @@ func NewBlockService[BR BlockRelationEntity](
 	repo BlockRelationRepository[BR],
 	config *ServiceConfig,
 ) BlockService[BR] {
+	shareClock(config, repo)
 	return &BasicBlockService[BR]{
 		repo:   repo,
 		config: config,
@@ -68,6 +68,7 @@ func (s *BasicBlockService[BR]) BlockUser(ctx context.Context, blockerID, blocke
 		blockedID: blockedID,
 		config:    config,
 		repo:      s.repo,
+		clock:     s.config.clock(),
 	}
 	
 	// Atomic upsert prevents race conditions and duplicate blocks
@@ -150,6 +151,7 @@ type createBlockRelationEntityFactory[BR BlockRelationEntity] struct {
 	blockedID UserID
 	config    *BlockConfig
 	repo      BlockRelationRepository[BR]
+	clock     Clock
 }
 
 func (f *createBlockRelationEntityFactory[BR]) CreateFn(ctx context.Context) (BR, error) {
@@ -157,13 +159,14 @@ func (f *createBlockRelationEntityFactory[BR]) CreateFn(ctx context.Context) (BR
 	blockRelation.SetBlockerID(f.blockerID)
 	blockRelation.SetBlockedID(f.blockedID)
 	blockRelation.SetStatus("active")
-	blockRelation.SetCreatedAt(time.Now())
+	now := f.clock.Now()
+	blockRelation.SetCreatedAt(now)
 	
 	if f.config.Reason != "" {
 		blockRelation.SetReason(f.config.Reason)
 	}
 	if f.config.Duration != nil {
-		blockRelation.SetExpiresAt(time.Now().Add(*f.config.Duration))
+		blockRelation.SetExpiresAt(now.Add(*f.config.Duration))
 	}
 	
 	return blockRelation, nil
@@ -177,7 +180,7 @@ func (f *createBlockRelationEntityFactory[BR]) UpdateFn(ctx context.Context, exi
 		existing.SetReason(f.config.Reason)
 	}
 	if f.config.Duration != nil {
-		existing.SetExpiresAt(time.Now().Add(*f.config.Duration))
+		existing.SetExpiresAt(f.clock.Now().Add(*f.config.Duration))
 	}
 	
 	return existing, nil
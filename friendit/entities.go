@@ -64,6 +64,18 @@ type FriendRequestEntity interface {
 	IsExpired() bool
 }
 
+// ExpiryChecker is implemented by friend requests that can check expiry at a given time
+// Services prefer it over IsExpired so that an injected Clock decides expiry
+type ExpiryChecker interface {
+	IsExpiredAt(now time.Time) bool
+}
+
+// ClockSetter is implemented by entities and repositories that stamp timestamps themselves
+// Services hand their ServiceConfig.Clock to repositories implementing it
+type ClockSetter interface {
+	SetClock(clock Clock)
+}
+
 // BlockRelationEntity represents the minimum required fields for a block relation
 // 차단 관계 엔터티 - 사용자가 추가 정보를 자유롭게 확장 가능
 type BlockRelationEntity interface {
@@ -97,6 +109,8 @@ type BasicUser struct {
 	Metadata    map[string]any `json:"metadata,omitempty" bson:"metadata,omitempty"`
 	CreatedAt   time.Time      `json:"created_at,omitempty" bson:"created_at,omitempty"`
 	UpdatedAt   time.Time      `json:"updated_at,omitempty" bson:"updated_at,omitempty"`
+
+	clock Clock // 세터가 기록하는 시각의 소스 (nil이면 시스템 시간)
 }
 
 // BasicFriendship - 기본적인 Friendship 구현 예제
@@ -110,6 +124,8 @@ type BasicFriendship struct {
 	// 사용자가 추가할 수 있는 선택적 필드들:
 	Metadata map[string]any `json:"metadata,omitempty" bson:"metadata,omitempty"`
 	Source   string         `json:"source,omitempty" bson:"source,omitempty"` // 친구 추가 경로
+
+	clock Clock // 세터가 기록하는 시각의 소스 (nil이면 시스템 시간)
 }
 
 // BasicFriendRequest - 기본적인 FriendRequest 구현 예제
@@ -124,6 +140,8 @@ type BasicFriendRequest struct {
 	// 사용자가 추가할 수 있는 선택적 필드들:
 	Message  string         `json:"message,omitempty" bson:"message,omitempty"`
 	Metadata map[string]any `json:"metadata,omitempty" bson:"metadata,omitempty"`
+
+	clock Clock // 세터와 IsExpired가 사용하는 시각의 소스 (nil이면 시스템 시간)
 }
 
 // BasicBlockRelation - 기본적인 BlockRelation 구현 예제
@@ -149,12 +167,13 @@ func (u *BasicUser) GetStatus() string       { return u.Status }
 func (u *BasicUser) GetLastSeen() *time.Time { return u.LastSeen }
 func (u *BasicUser) SetStatus(status string) {
 	u.Status = status
-	u.UpdatedAt = time.Now()
+	u.UpdatedAt = ClockOrSystem(u.clock).Now()
 }
 func (u *BasicUser) SetLastSeen(t time.Time) {
 	u.LastSeen = &t
-	u.UpdatedAt = time.Now()
+	u.UpdatedAt = ClockOrSystem(u.clock).Now()
 }
+func (u *BasicUser) SetClock(clock Clock) { u.clock = clock }
 
 // BasicFriendship이 FriendshipEntity 인터페이스를 구현
 func (f *BasicFriendship) GetID() FriendshipID     { return f.ID }
@@ -167,8 +186,9 @@ func (f *BasicFriendship) SetUser1ID(userID UserID) { f.User1ID = userID }
 func (f *BasicFriendship) SetUser2ID(userID UserID) { f.User2ID = userID }
 func (f *BasicFriendship) SetStatus(status string) {
 	f.Status = status
-	f.UpdatedAt = time.Now()
+	f.UpdatedAt = ClockOrSystem(f.clock).Now()
 }
+func (f *BasicFriendship) SetClock(clock Clock) { f.clock = clock }
 func (f *BasicFriendship) SetCreatedAt(t time.Time) { f.CreatedAt = t }
 func (f *BasicFriendship) SetUpdatedAt(t time.Time) { f.UpdatedAt = t }
 func (f *BasicFriendship) SetSource(source string) { f.Source = source }
@@ -186,8 +206,9 @@ func (fr *BasicFriendRequest) SetSenderID(senderID UserID)    { fr.SenderID = se
 func (fr *BasicFriendRequest) SetReceiverID(receiverID UserID) { fr.ReceiverID = receiverID }
 func (fr *BasicFriendRequest) SetStatus(status string) {
 	fr.Status = status
-	fr.UpdatedAt = time.Now()
+	fr.UpdatedAt = ClockOrSystem(fr.clock).Now()
 }
+func (fr *BasicFriendRequest) SetClock(clock Clock) { fr.clock = clock }
 func (fr *BasicFriendRequest) SetCreatedAt(t time.Time)  { fr.CreatedAt = t }
 func (fr *BasicFriendRequest) SetExpiresAt(t time.Time)  { fr.ExpiresAt = &t }
 func (fr *BasicFriendRequest) SetUpdatedAt(t time.Time) { fr.UpdatedAt = t }
@@ -201,10 +222,13 @@ func (fr *BasicFriendRequest) SetPriority(priority string) {
 }
 func (fr *BasicFriendRequest) SetMetadata(metadata map[string]any) { fr.Metadata = metadata }
 func (fr *BasicFriendRequest) IsExpired() bool {
+	return fr.IsExpiredAt(ClockOrSystem(fr.clock).Now())
+}
+func (fr *BasicFriendRequest) IsExpiredAt(now time.Time) bool {
 	if fr.ExpiresAt == nil {
 		return false
 	}
-	return time.Now().After(*fr.ExpiresAt)
+	return now.After(*fr.ExpiresAt)
 }
 
 // BasicBlockRelation이 BlockRelationEntity 인터페이스를 구현
@@ -254,6 +278,20 @@ func CanBeAccepted(request FriendRequestEntity) bool {
 	return request.GetStatus() == "pending" && !request.IsExpired()
 }
 
+// CanBeAcceptedAt returns true if the request can be accepted at the given time
+func CanBeAcceptedAt(request FriendRequestEntity, now time.Time) bool {
+	return request.GetStatus() == "pending" && !IsExpiredAt(request, now)
+}
+
+// IsExpiredAt reports whether the request is expired at the given time
+// Uses ExpiryChecker when implemented and falls back to IsExpired
+func IsExpiredAt(request FriendRequestEntity, now time.Time) bool {
+	if checker, ok := request.(ExpiryChecker); ok {
+		return checker.IsExpiredAt(now)
+	}
+	return request.IsExpired()
+}
+
 // CanBeRejected returns true if the request can be rejected
 func CanBeRejected(request FriendRequestEntity) bool {
 	return request.GetStatus() == "pending"
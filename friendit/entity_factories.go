@@ -15,24 +15,34 @@ import (
 // ============================================================================
 
 // BasicUserFactory BasicUser를 위한 팩토리
-type BasicUserFactory struct{}
+type BasicUserFactory struct {
+	clock Clock // nil이면 시스템 시간
+}
+
+// NewBasicUserFactory 주어진 시각 소스로 타임스탬프와 만료를 기록하는 BasicUserFactory 생성
+func NewBasicUserFactory(clock Clock) *BasicUserFactory {
+	return &BasicUserFactory{clock: clock}
+}
 
 func (f *BasicUserFactory) NewUser(id UserID, status string) EntityFactory[*BasicUser] {
 	return &createUserFactory{
 		userID: id,
 		status: status,
+		clock:  ClockOrSystem(f.clock),
 	}
 }
 
 func (f *BasicUserFactory) UpdateUserStatus(status string) EntityFactory[*BasicUser] {
 	return &updateUserStatusFactory{
 		newStatus: status,
+		clock:     ClockOrSystem(f.clock),
 	}
 }
 
 func (f *BasicUserFactory) UpdateUserLastSeen(t time.Time) EntityFactory[*BasicUser] {
 	return &updateUserLastSeenFactory{
 		lastSeen: t,
+		clock:    ClockOrSystem(f.clock),
 	}
 }
 
@@ -40,10 +50,11 @@ func (f *BasicUserFactory) UpdateUserLastSeen(t time.Time) EntityFactory[*BasicU
 type createUserFactory struct {
 	userID UserID
 	status string
+	clock  Clock
 }
 
 func (f *createUserFactory) CreateFn(ctx context.Context) (*BasicUser, error) {
-	now := time.Now()
+	now := f.clock.Now()
 	return &BasicUser{
 		ID:        f.userID,
 		Status:    f.status,
@@ -51,19 +62,21 @@ func (f *createUserFactory) CreateFn(ctx context.Context) (*BasicUser, error) {
 		CreatedAt: now,
 		UpdatedAt: now,
 		Metadata:  make(map[string]any),
+		clock:     f.clock,
 	}, nil
 }
 
 func (f *createUserFactory) UpdateFn(ctx context.Context, existing *BasicUser) (*BasicUser, error) {
 	// 이미 존재하면 상태만 업데이트
 	existing.Status = f.status
-	existing.UpdatedAt = time.Now()
+	existing.UpdatedAt = f.clock.Now()
 	return existing, nil
 }
 
 // updateUserStatusFactory 사용자 상태 업데이트 팩토리
 type updateUserStatusFactory struct {
 	newStatus string
+	clock     Clock
 }
 
 func (f *updateUserStatusFactory) CreateFn(ctx context.Context) (*BasicUser, error) {
@@ -72,14 +85,16 @@ func (f *updateUserStatusFactory) CreateFn(ctx context.Context) (*BasicUser, err
 
 func (f *updateUserStatusFactory) UpdateFn(ctx context.Context, existing *BasicUser) (*BasicUser, error) {
 	existing.Status = f.newStatus
-	existing.LastSeen = &[]time.Time{time.Now()}[0]
-	existing.UpdatedAt = time.Now()
+	now := f.clock.Now()
+	existing.LastSeen = &now
+	existing.UpdatedAt = now
 	return existing, nil
 }
 
 // updateUserLastSeenFactory 사용자 마지막 접속 업데이트 팩토리
 type updateUserLastSeenFactory struct {
 	lastSeen time.Time
+	clock    Clock
 }
 
 func (f *updateUserLastSeenFactory) CreateFn(ctx context.Context) (*BasicUser, error) {
@@ -88,7 +103,7 @@ func (f *updateUserLastSeenFactory) CreateFn(ctx context.Context) (*BasicUser, e
 
 func (f *updateUserLastSeenFactory) UpdateFn(ctx context.Context, existing *BasicUser) (*BasicUser, error) {
 	existing.LastSeen = &f.lastSeen
-	existing.UpdatedAt = time.Now()
+	existing.UpdatedAt = f.clock.Now()
 	return existing, nil
 }
 
@@ -97,25 +112,35 @@ func (f *updateUserLastSeenFactory) UpdateFn(ctx context.Context, existing *Basi
 // ============================================================================
 
 // BasicFriendshipFactory BasicFriendship을 위한 팩토리
-type BasicFriendshipFactory struct{}
+type BasicFriendshipFactory struct {
+	clock Clock // nil이면 시스템 시간
+}
+
+// NewBasicFriendshipFactory 주어진 시각 소스로 타임스탬프와 만료를 기록하는 BasicFriendshipFactory 생성
+func NewBasicFriendshipFactory(clock Clock) *BasicFriendshipFactory {
+	return &BasicFriendshipFactory{clock: clock}
+}
 
 func (f *BasicFriendshipFactory) NewFriendship(user1ID, user2ID UserID, source string) EntityFactory[*BasicFriendship] {
 	return &createFriendshipFactory{
 		user1ID: user1ID,
 		user2ID: user2ID,
 		source:  source,
+		clock:   ClockOrSystem(f.clock),
 	}
 }
 
 func (f *BasicFriendshipFactory) UpdateFriendshipStatus(status string) EntityFactory[*BasicFriendship] {
 	return &updateFriendshipStatusFactory{
 		newStatus: status,
+		clock:     ClockOrSystem(f.clock),
 	}
 }
 
 func (f *BasicFriendshipFactory) UpdateFriendshipMetadata(metadata map[string]any) EntityFactory[*BasicFriendship] {
 	return &updateFriendshipMetadataFactory{
 		metadata: metadata,
+		clock:    ClockOrSystem(f.clock),
 	}
 }
 
@@ -124,10 +149,11 @@ type createFriendshipFactory struct {
 	user1ID UserID
 	user2ID UserID
 	source  string
+	clock   Clock
 }
 
 func (f *createFriendshipFactory) CreateFn(ctx context.Context) (*BasicFriendship, error) {
-	now := time.Now()
+	now := f.clock.Now()
 	return &BasicFriendship{
 		ID:        FriendshipID(fmt.Sprintf("%s_%s_%d", f.user1ID, f.user2ID, now.UnixNano())),
 		User1ID:   f.user1ID,
@@ -137,6 +163,7 @@ func (f *createFriendshipFactory) CreateFn(ctx context.Context) (*BasicFriendshi
 		CreatedAt: now,
 		UpdatedAt: now,
 		Metadata:  make(map[string]any),
+		clock:     f.clock,
 	}, nil
 }
 
@@ -144,7 +171,7 @@ func (f *createFriendshipFactory) UpdateFn(ctx context.Context, existing *BasicF
 	// 이미 존재하면 상태만 활성화
 	if existing.Status != "active" {
 		existing.Status = "active"
-		existing.UpdatedAt = time.Now()
+		existing.UpdatedAt = f.clock.Now()
 	}
 	return existing, nil
 }
@@ -152,6 +179,7 @@ func (f *createFriendshipFactory) UpdateFn(ctx context.Context, existing *BasicF
 // updateFriendshipStatusFactory 친구관계 상태 업데이트 팩토리
 type updateFriendshipStatusFactory struct {
 	newStatus string
+	clock     Clock
 }
 
 func (f *updateFriendshipStatusFactory) CreateFn(ctx context.Context) (*BasicFriendship, error) {
@@ -160,13 +188,14 @@ func (f *updateFriendshipStatusFactory) CreateFn(ctx context.Context) (*BasicFri
 
 func (f *updateFriendshipStatusFactory) UpdateFn(ctx context.Context, existing *BasicFriendship) (*BasicFriendship, error) {
 	existing.Status = f.newStatus
-	existing.UpdatedAt = time.Now()
+	existing.UpdatedAt = f.clock.Now()
 	return existing, nil
 }
 
 // updateFriendshipMetadataFactory 친구관계 메타데이터 업데이트 팩토리
 type updateFriendshipMetadataFactory struct {
 	metadata map[string]any
+	clock    Clock
 }
 
 func (f *updateFriendshipMetadataFactory) CreateFn(ctx context.Context) (*BasicFriendship, error) {
@@ -180,7 +209,7 @@ func (f *updateFriendshipMetadataFactory) UpdateFn(ctx context.Context, existing
 	for k, v := range f.metadata {
 		existing.Metadata[k] = v
 	}
-	existing.UpdatedAt = time.Now()
+	existing.UpdatedAt = f.clock.Now()
 	return existing, nil
 }
 
@@ -189,28 +218,41 @@ func (f *updateFriendshipMetadataFactory) UpdateFn(ctx context.Context, existing
 // ============================================================================
 
 // BasicFriendRequestFactory BasicFriendRequest를 위한 팩토리
-type BasicFriendRequestFactory struct{}
+type BasicFriendRequestFactory struct {
+	clock Clock // nil이면 시스템 시간
+}
+
+// NewBasicFriendRequestFactory 주어진 시각 소스로 타임스탬프와 만료를 기록하는 BasicFriendRequestFactory 생성
+func NewBasicFriendRequestFactory(clock Clock) *BasicFriendRequestFactory {
+	return &BasicFriendRequestFactory{clock: clock}
+}
 
 func (f *BasicFriendRequestFactory) NewFriendRequest(senderID, receiverID UserID, message string) EntityFactory[*BasicFriendRequest] {
 	return &createFriendRequestFactory{
 		senderID:   senderID,
 		receiverID: receiverID,
 		message:    message,
+		clock:      ClockOrSystem(f.clock),
 	}
 }
 
 func (f *BasicFriendRequestFactory) AcceptFriendRequest() EntityFactory[*BasicFriendRequest] {
-	return &acceptFriendRequestFactory{}
+	return &acceptFriendRequestFactory{
+		clock: ClockOrSystem(f.clock),
+	}
 }
 
 func (f *BasicFriendRequestFactory) RejectFriendRequest(reason string) EntityFactory[*BasicFriendRequest] {
 	return &rejectFriendRequestFactory{
 		reason: reason,
+		clock:  ClockOrSystem(f.clock),
 	}
 }
 
 func (f *BasicFriendRequestFactory) ExpireFriendRequest() EntityFactory[*BasicFriendRequest] {
-	return &expireFriendRequestFactory{}
+	return &expireFriendRequestFactory{
+		clock: ClockOrSystem(f.clock),
+	}
 }
 
 // createFriendRequestFactory 새 친구요청 생성 팩토리
@@ -218,12 +260,13 @@ type createFriendRequestFactory struct {
 	senderID   UserID
 	receiverID UserID
 	message    string
+	clock      Clock
 }
 
 func (f *createFriendRequestFactory) CreateFn(ctx context.Context) (*BasicFriendRequest, error) {
-	now := time.Now()
+	now := f.clock.Now()
 	expiresAt := now.Add(7 * 24 * time.Hour) // 7일 후 만료
-	
+
 	return &BasicFriendRequest{
 		ID:         RequestID(fmt.Sprintf("%s_%s_%d", f.senderID, f.receiverID, now.UnixNano())),
 		SenderID:   f.senderID,
@@ -234,19 +277,23 @@ func (f *createFriendRequestFactory) CreateFn(ctx context.Context) (*BasicFriend
 		UpdatedAt:  now,
 		ExpiresAt:  &expiresAt,
 		Metadata:   make(map[string]any),
+		clock:      f.clock,
 	}, nil
 }
 
 func (f *createFriendRequestFactory) UpdateFn(ctx context.Context, existing *BasicFriendRequest) (*BasicFriendRequest, error) {
 	// 이미 존재하고 pending이면 그대로 반환, 아니면 에러
-	if existing.Status == "pending" && !existing.IsExpired() {
+	expired := existing.IsExpiredAt(f.clock.Now())
+	if existing.Status == "pending" && !expired {
 		return existing, nil
 	}
-	return nil, fmt.Errorf("cannot update existing friend request: status=%s, expired=%v", existing.Status, existing.IsExpired())
+	return nil, fmt.Errorf("cannot update existing friend request: status=%s, expired=%v", existing.Status, expired)
 }
 
 // acceptFriendRequestFactory 친구요청 수락 팩토리
-type acceptFriendRequestFactory struct{}
+type acceptFriendRequestFactory struct {
+	clock Clock
+}
 
 func (f *acceptFriendRequestFactory) CreateFn(ctx context.Context) (*BasicFriendRequest, error) {
 	return nil, fmt.Errorf("cannot create new friend request with accept factory")
@@ -256,18 +303,20 @@ func (f *acceptFriendRequestFactory) UpdateFn(ctx context.Context, existing *Bas
 	if existing.Status != "pending" {
 		return nil, fmt.Errorf("cannot accept non-pending request: status=%s", existing.Status)
 	}
-	if existing.IsExpired() {
+	now := f.clock.Now()
+	if existing.IsExpiredAt(now) {
 		return nil, fmt.Errorf("cannot accept expired request")
 	}
-	
+
 	existing.Status = "accepted"
-	existing.UpdatedAt = time.Now()
+	existing.UpdatedAt = now
 	return existing, nil
 }
 
 // rejectFriendRequestFactory 친구요청 거절 팩토리
 type rejectFriendRequestFactory struct {
 	reason string
+	clock  Clock
 }
 
 func (f *rejectFriendRequestFactory) CreateFn(ctx context.Context) (*BasicFriendRequest, error) {
@@ -278,9 +327,9 @@ func (f *rejectFriendRequestFactory) UpdateFn(ctx context.Context, existing *Bas
 	if existing.Status != "pending" {
 		return nil, fmt.Errorf("cannot reject non-pending request: status=%s", existing.Status)
 	}
-	
+
 	existing.Status = "rejected"
-	existing.UpdatedAt = time.Now()
+	existing.UpdatedAt = f.clock.Now()
 	if f.reason != "" {
 		if existing.Metadata == nil {
 			existing.Metadata = make(map[string]any)
@@ -291,7 +340,9 @@ func (f *rejectFriendRequestFactory) UpdateFn(ctx context.Context, existing *Bas
 }
 
 // expireFriendRequestFactory 친구요청 만료 팩토리
-type expireFriendRequestFactory struct{}
+type expireFriendRequestFactory struct {
+	clock Clock
+}
 
 func (f *expireFriendRequestFactory) CreateFn(ctx context.Context) (*BasicFriendRequest, error) {
 	return nil, fmt.Errorf("cannot create new friend request with expire factory")
@@ -299,7 +350,7 @@ func (f *expireFriendRequestFactory) CreateFn(ctx context.Context) (*BasicFriend
 
 func (f *expireFriendRequestFactory) UpdateFn(ctx context.Context, existing *BasicFriendRequest) (*BasicFriendRequest, error) {
 	existing.Status = "expired"
-	existing.UpdatedAt = time.Now()
+	existing.UpdatedAt = f.clock.Now()
 	return existing, nil
 }
 
@@ -308,25 +359,35 @@ func (f *expireFriendRequestFactory) UpdateFn(ctx context.Context, existing *Bas
 // ============================================================================
 
 // BasicBlockRelationFactory BasicBlockRelation을 위한 팩토리
-type BasicBlockRelationFactory struct{}
+type BasicBlockRelationFactory struct {
+	clock Clock // nil이면 시스템 시간
+}
+
+// NewBasicBlockRelationFactory 주어진 시각 소스로 타임스탬프와 만료를 기록하는 BasicBlockRelationFactory 생성
+func NewBasicBlockRelationFactory(clock Clock) *BasicBlockRelationFactory {
+	return &BasicBlockRelationFactory{clock: clock}
+}
 
 func (f *BasicBlockRelationFactory) NewBlockRelation(blockerID, blockedID UserID, reason string) EntityFactory[*BasicBlockRelation] {
 	return &createBlockRelationFactory{
 		blockerID: blockerID,
 		blockedID: blockedID,
 		reason:    reason,
+		clock:     ClockOrSystem(f.clock),
 	}
 }
 
 func (f *BasicBlockRelationFactory) UpdateBlockReason(reason string) EntityFactory[*BasicBlockRelation] {
 	return &updateBlockReasonFactory{
 		reason: reason,
+		clock:  ClockOrSystem(f.clock),
 	}
 }
 
 func (f *BasicBlockRelationFactory) SetBlockExpiration(expiresAt time.Time) EntityFactory[*BasicBlockRelation] {
 	return &setBlockExpirationFactory{
 		expiresAt: expiresAt,
+		clock:     ClockOrSystem(f.clock),
 	}
 }
 
@@ -335,10 +396,11 @@ type createBlockRelationFactory struct {
 	blockerID UserID
 	blockedID UserID
 	reason    string
+	clock     Clock
 }
 
 func (f *createBlockRelationFactory) CreateFn(ctx context.Context) (*BasicBlockRelation, error) {
-	now := time.Now()
+	now := f.clock.Now()
 	return &BasicBlockRelation{
 		ID:        BlockID(fmt.Sprintf("%s_%s_%d", f.blockerID, f.blockedID, now.UnixNano())),
 		BlockerID: f.blockerID,
@@ -362,6 +424,7 @@ func (f *createBlockRelationFactory) UpdateFn(ctx context.Context, existing *Bas
 // updateBlockReasonFactory 차단 이유 업데이트 팩토리
 type updateBlockReasonFactory struct {
 	reason string
+	clock  Clock
 }
 
 func (f *updateBlockReasonFactory) CreateFn(ctx context.Context) (*BasicBlockRelation, error) {
@@ -376,6 +439,7 @@ func (f *updateBlockReasonFactory) UpdateFn(ctx context.Context, existing *Basic
 // setBlockExpirationFactory 차단 만료 설정 팩토리
 type setBlockExpirationFactory struct {
 	expiresAt time.Time
+	clock     Clock
 }
 
 func (f *setBlockExpirationFactory) CreateFn(ctx context.Context) (*BasicBlockRelation, error) {
@@ -385,4 +449,4 @@ func (f *setBlockExpirationFactory) CreateFn(ctx context.Context) (*BasicBlockRe
 func (f *setBlockExpirationFactory) UpdateFn(ctx context.Context, existing *BasicBlockRelation) (*BasicBlockRelation, error) {
 	existing.ExpiresAt = &f.expiresAt
 	return existing, nil
-}
\ No newline at end of file
+}
@@ -39,6 +39,7 @@ func NewFriendRequestService[FR FriendRequestEntity](
 	repo FriendRequestRepository[FR],
 	config *ServiceConfig,
 ) FriendRequestService[FR] {
+	shareClock(config, repo)
 	return &BasicFriendRequestService[FR]{
 		repo:   repo,
 		config: config,
@@ -69,6 +70,7 @@ func (s *BasicFriendRequestService[FR]) SendRequest(ctx context.Context, senderI
 		receiverID: receiverID,
 		config:     config,
 		repo:       s.repo,
+		clock:      s.config.clock(),
 	}
 	
 	// Atomic upsert prevents race conditions and duplicate requests
@@ -92,6 +94,7 @@ func (s *BasicFriendRequestService[FR]) AcceptRequest(ctx context.Context, reque
 	// Create factory for atomic accept operation
 	factory := &acceptFriendRequestEntityFactory[FR]{
 		config: config,
+		clock:  s.config.clock(),
 	}
 	
 	// Atomic accept operation prevents concurrent modifications
@@ -114,6 +117,7 @@ func (s *BasicFriendRequestService[FR]) RejectRequest(ctx context.Context, reque
 	// Create factory for atomic reject operation
 	factory := &rejectFriendRequestEntityFactory[FR]{
 		reason: config.Reason,
+		clock:  s.config.clock(),
 	}
 	
 	// Atomic reject operation prevents concurrent modifications
@@ -161,15 +165,17 @@ type createFriendRequestEntityFactory[FR FriendRequestEntity] struct {
 	receiverID UserID
 	config     *RequestConfig
 	repo       FriendRequestRepository[FR]
+	clock      Clock
 }
 
 func (f *createFriendRequestEntityFactory[FR]) CreateFn(ctx context.Context) (FR, error) {
+	now := f.clock.Now()
 	request := f.repo.NewEntity()
 	request.SetSenderID(f.senderID)
 	request.SetReceiverID(f.receiverID)
 	request.SetStatus("pending")
-	request.SetCreatedAt(time.Now())
-	request.SetUpdatedAt(time.Now())
+	request.SetCreatedAt(now)
+	request.SetUpdatedAt(now)
 	
 	// Apply configuration from options
 	if f.config.Message != "" {
@@ -179,7 +185,7 @@ func (f *createFriendRequestEntityFactory[FR]) CreateFn(ctx context.Context) (FR
 		request.SetExpiresAt(*f.config.ExpiresAt)
 	} else {
 		// Default expiration: 7 days
-		expiresAt := time.Now().Add(7 * 24 * time.Hour)
+		expiresAt := now.Add(7 * 24 * time.Hour)
 		request.SetExpiresAt(expiresAt)
 	}
 	if f.config.Priority != "" {
@@ -194,10 +200,10 @@ func (f *createFriendRequestEntityFactory[FR]) CreateFn(ctx context.Context) (FR
 
 func (f *createFriendRequestEntityFactory[FR]) UpdateFn(ctx context.Context, existing FR) (FR, error) {
 	// If already exists and pending, return as-is or update message
-	if existing.GetStatus() == "pending" && !existing.IsExpired() {
+	if existing.GetStatus() == "pending" && !IsExpiredAt(existing, f.clock.Now()) {
 		if f.config.Message != "" {
 			existing.SetMessage(f.config.Message)
-			existing.SetUpdatedAt(time.Now())
+			existing.SetUpdatedAt(f.clock.Now())
 		}
 		return existing, nil
 	}
@@ -209,6 +215,7 @@ func (f *createFriendRequestEntityFactory[FR]) UpdateFn(ctx context.Context, exi
 // acceptFriendRequestEntityFactory accepts pending requests
 type acceptFriendRequestEntityFactory[FR FriendRequestEntity] struct {
 	config *AcceptConfig
+	clock  Clock
 }
 
 func (f *acceptFriendRequestEntityFactory[FR]) CreateFn(ctx context.Context) (FR, error) {
@@ -220,12 +227,13 @@ func (f *acceptFriendRequestEntityFactory[FR]) UpdateFn(ctx context.Context, exi
 	if existing.GetStatus() != "pending" {
 		return existing, fmt.Errorf("cannot accept non-pending request: status=%s", existing.GetStatus())
 	}
-	if existing.IsExpired() {
+	now := f.clock.Now()
+	if IsExpiredAt(existing, now) {
 		return existing, fmt.Errorf("cannot accept expired request")
 	}
 	
 	existing.SetStatus("accepted")
-	existing.SetUpdatedAt(time.Now())
+	existing.SetUpdatedAt(now)
 	
 	if len(f.config.Metadata) > 0 {
 		existing.SetMetadata(f.config.Metadata)
@@ -237,6 +245,7 @@ func (f *acceptFriendRequestEntityFactory[FR]) UpdateFn(ctx context.Context, exi
 // rejectFriendRequestEntityFactory rejects pending requests
 type rejectFriendRequestEntityFactory[FR FriendRequestEntity] struct {
 	reason string
+	clock  Clock
 }
 
 func (f *rejectFriendRequestEntityFactory[FR]) CreateFn(ctx context.Context) (FR, error) {
@@ -250,7 +259,7 @@ func (f *rejectFriendRequestEntityFactory[FR]) UpdateFn(ctx context.Context, exi
 	}
 	
 	existing.SetStatus("rejected")
-	existing.SetUpdatedAt(time.Now())
+	existing.SetUpdatedAt(f.clock.Now())
 	
 	if f.reason != "" {
 		// Store rejection reason in metadata
@@ -43,6 +43,7 @@ func NewFriendshipService[U UserEntity, F FriendshipEntity](
 	friendshipRepo FriendshipRepository[F],
 	config *ServiceConfig,
 ) FriendshipService[U, F] {
+	shareClock(config, userRepo, friendshipRepo)
 	return &BasicFriendshipService[U, F]{
 		userRepo:       userRepo,
 		friendshipRepo: friendshipRepo,
@@ -74,6 +75,7 @@ func (s *BasicFriendshipService[U, F]) CreateFriendship(ctx context.Context, use
 		user2ID: user2ID,
 		config:  config,
 		repo:    s.friendshipRepo,
+		clock:   s.config.clock(),
 	}
 	
 	// Atomic upsert prevents race conditions and duplicate friendships
@@ -230,7 +232,7 @@ func (s *BasicFriendshipService[U, F]) GetFriendStats(ctx context.Context, userI
 		SentRequests:    0, // Would need FriendRequestService integration
 		BlockedUsers:    0, // Would need BlockService integration
 		MutualFriends:   len(friends), // All friendships are mutual by default
-		RecentActivity:  time.Now(),   // Would be updated with actual activity tracking
+		RecentActivity:  s.config.clock().Now(), // Would be updated with actual activity tracking
 	}
 	
 	return stats, nil
@@ -246,6 +248,7 @@ type createFriendshipEntityFactory[F FriendshipEntity] struct {
 	user2ID UserID
 	config  *FriendshipConfig
 	repo    FriendshipRepository[F]
+	clock   Clock
 }
 
 func (f *createFriendshipEntityFactory[F]) CreateFn(ctx context.Context) (F, error) {
@@ -260,8 +263,9 @@ func (f *createFriendshipEntityFactory[F]) CreateFn(ctx context.Context) (F, err
 	friendship.SetUser1ID(user1ID)
 	friendship.SetUser2ID(user2ID)
 	friendship.SetStatus("active")
-	friendship.SetCreatedAt(time.Now())
-	friendship.SetUpdatedAt(time.Now())
+	now := f.clock.Now()
+	friendship.SetCreatedAt(now)
+	friendship.SetUpdatedAt(now)
 	
 	if f.config.Source != "" {
 		friendship.SetSource(f.config.Source)
@@ -284,7 +288,7 @@ func (f *createFriendshipEntityFactory[F]) UpdateFn(ctx context.Context, existin
 	
 	// If friendship exists but inactive, reactivate it
 	existing.SetStatus("active")
-	existing.SetUpdatedAt(time.Now())
+	existing.SetUpdatedAt(f.clock.Now())
 	
 	// Update source and metadata if provided
 	if f.config.Source != "" {
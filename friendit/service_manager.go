@@ -85,6 +85,46 @@ type ServiceConfig struct {
 	AllowSelfRequests   bool
 	RequireMessage      bool
 	EnableRecommendations bool
+	Clock               Clock
+}
+
+// Clock supplies the current time to services
+// Any type with a Now method works, including dukdakit's FakeClock for tests
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is the default Clock backed by time.Now
+type SystemClock struct{}
+
+// Now returns the current system time
+func (SystemClock) Now() time.Time { return time.Now() }
+
+// clock returns the configured clock, falling back to the system clock
+// ServiceConfig may be built by hand, so a nil config or Clock is allowed
+func (c *ServiceConfig) clock() Clock {
+	if c == nil {
+		return SystemClock{}
+	}
+	return ClockOrSystem(c.Clock)
+}
+
+// ClockOrSystem returns clock, or the system clock when clock is nil
+func ClockOrSystem(clock Clock) Clock {
+	if clock == nil {
+		return SystemClock{}
+	}
+	return clock
+}
+
+// shareClock hands the configured clock to repositories implementing ClockSetter
+// so that timestamps written by adapters agree with the services
+func shareClock(config *ServiceConfig, repos ...any) {
+	for _, repo := range repos {
+		if setter, ok := repo.(ClockSetter); ok {
+			setter.SetClock(config.clock())
+		}
+	}
 }
 
 // ServiceOption configures the service
@@ -120,6 +160,11 @@ func WithRequireMessage(require bool) ServiceOption {
 	return func(c *ServiceConfig) { c.RequireMessage = require }
 }
 
+// WithClock sets the clock used for timestamps and expiry checks
+func WithClock(clock Clock) ServiceOption {
+	return func(c *ServiceConfig) { c.Clock = clock }
+}
+
 func defaultServiceConfig() *ServiceConfig {
 	return &ServiceConfig{
 		MaxFriends:         500,
@@ -130,5 +175,6 @@ func defaultServiceConfig() *ServiceConfig {
 		AllowSelfRequests:  false,
 		RequireMessage:     false,
 		EnableRecommendations: true,
+		Clock:              SystemClock{},
 	}
 }
\ No newline at end of file
@@ -3,7 +3,6 @@ package friendit
 import (
 	"context"
 	"fmt"
-	"time"
 )
 
 // ============================================================================
@@ -40,6 +39,7 @@ func NewUserService[U UserEntity](
 	repo UserRepository[U],
 	config *ServiceConfig,
 ) UserService[U] {
+	shareClock(config, repo)
 	return &BasicUserService[U]{
 		repo:   repo,
 		config: config,
@@ -61,6 +61,7 @@ func (s *BasicUserService[U]) UpdateUserStatus(ctx context.Context, userID UserI
 	factory := &updateUserStatusEntityFactory[U]{
 		newStatus: status,
 		repo:      s.repo,
+		clock:     s.config.clock(),
 	}
 	
 	// Atomic update prevents race conditions
@@ -166,6 +167,7 @@ func (s *BasicUserService[U]) GetUsersByStatus(ctx context.Context, status strin
 type updateUserStatusEntityFactory[U UserEntity] struct {
 	newStatus string
 	repo      UserRepository[U]
+	clock     Clock
 }
 
 func (f *updateUserStatusEntityFactory[U]) CreateFn(ctx context.Context) (U, error) {
@@ -175,7 +177,7 @@ func (f *updateUserStatusEntityFactory[U]) CreateFn(ctx context.Context) (U, err
 
 func (f *updateUserStatusEntityFactory[U]) UpdateFn(ctx context.Context, existing U) (U, error) {
 	existing.SetStatus(f.newStatus)
-	existing.SetLastSeen(time.Now())
+	existing.SetLastSeen(f.clock.Now())
 	return existing, nil
 }
 
@@ -184,6 +186,7 @@ type createUserEntityFactory[U UserEntity] struct {
 	userID UserID
 	status string
 	repo   UserRepository[U]
+	clock  Clock
 }
 
 func (f *createUserEntityFactory[U]) CreateFn(ctx context.Context) (U, error) {
@@ -196,7 +199,7 @@ func (f *createUserEntityFactory[U]) CreateFn(ctx context.Context) (U, error) {
 func (f *createUserEntityFactory[U]) UpdateFn(ctx context.Context, existing U) (U, error) {
 	// If user exists, just update status
 	existing.SetStatus(f.status)
-	existing.SetLastSeen(time.Now())
+	existing.SetLastSeen(f.clock.Now())
 	return existing, nil
 }
 
@@ -0,0 +1,241 @@
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock abstracts the current time and time-based waiting
+// Packages accept a Clock through their options so tests can control time
+type Clock interface {
+	// Now returns the current time
+	Now() time.Time
+
+	// Since returns the time elapsed since t
+	Since(t time.Time) time.Duration
+
+	// After waits for the duration to elapse and then sends the current time
+	After(d time.Duration) <-chan time.Time
+
+	// NewTimer creates a Timer that fires after the given duration
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is the Clock counterpart of *time.Timer
+type Timer interface {
+	// C returns the channel on which the time is delivered
+	C() <-chan time.Time
+
+	// Stop prevents the Timer from firing
+	// Returns false if the timer has already fired or been stopped
+	Stop() bool
+
+	// Reset changes the timer to fire after duration d
+	// Returns true if the timer had been active
+	Reset(d time.Duration) bool
+}
+
+// ============================================================================
+// Real clock
+// ============================================================================
+
+// realClock delegates to the standard time package
+type realClock struct{}
+
+// Real returns a Clock backed by the system time
+func Real() Clock {
+	return realClock{}
+}
+
+// OrReal returns c, or the real clock if c is nil
+// Used by option-configured components to fall back to system time
+func OrReal(c Clock) Clock {
+	if c == nil {
+		return realClock{}
+	}
+	return c
+}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) Since(t time.Time) time.Duration        { return time.Since(t) }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+func (realClock) NewTimer(d time.Duration) Timer         { return &realTimer{timer: time.NewTimer(d)} }
+
+// realTimer wraps *time.Timer to satisfy Timer
+type realTimer struct {
+	timer *time.Timer
+}
+
+func (t *realTimer) C() <-chan time.Time        { return t.timer.C }
+func (t *realTimer) Stop() bool                 { return t.timer.Stop() }
+func (t *realTimer) Reset(d time.Duration) bool { return t.timer.Reset(d) }
+
+// ============================================================================
+// Fake clock
+// ============================================================================
+
+// FakeClock is a manually controlled Clock for tests
+// Time only moves when Advance or Set is called; timers and After channels
+// whose deadline is reached fire during that call
+//
+// Example usage:
+//
+//	fake := clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+//	done := fake.After(time.Hour)
+//	fake.Advance(time.Hour) // done receives 01:00
+type FakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*fakeTimer
+	cond    *sync.Cond
+}
+
+// NewFake creates a FakeClock set to the given time
+func NewFake(now time.Time) *FakeClock {
+	c := &FakeClock{now: now}
+	c.cond = sync.NewCond(&c.mu)
+	return c
+}
+
+// Now returns the fake current time
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Since returns the fake time elapsed since t
+func (c *FakeClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+// After returns a channel that receives the fake time once d has been advanced
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	return c.NewTimer(d).C()
+}
+
+// NewTimer creates a timer that fires when the fake time reaches now+d
+func (c *FakeClock) NewTimer(d time.Duration) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := &fakeTimer{
+		clock:    c,
+		ch:       make(chan time.Time, 1),
+		deadline: c.now.Add(d),
+	}
+
+	if d <= 0 {
+		t.ch <- c.now
+		return t
+	}
+
+	c.addWaiter(t)
+	return t
+}
+
+// Advance moves the fake time forward by d and fires due timers in deadline order
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.setLocked(c.now.Add(d))
+}
+
+// Set moves the fake time to t and fires due timers in deadline order
+// Moving backwards is allowed and never fires timers
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.setLocked(t)
+}
+
+// Waiters returns the number of timers that have not fired or been stopped
+func (c *FakeClock) Waiters() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.waiters)
+}
+
+// BlockUntil blocks until at least n timers are waiting on the clock
+// Useful to make sure a goroutine reached its After/NewTimer call before advancing
+func (c *FakeClock) BlockUntil(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.waiters) < n {
+		c.cond.Wait()
+	}
+}
+
+func (c *FakeClock) setLocked(t time.Time) {
+	c.now = t
+
+	var remaining []*fakeTimer
+	for _, w := range c.waiters {
+		if !w.deadline.After(t) {
+			// Channel is buffered with capacity 1 and a timer fires only once
+			// per arm, so this never blocks
+			w.ch <- t
+			continue
+		}
+		remaining = append(remaining, w)
+	}
+	c.waiters = remaining
+}
+
+func (c *FakeClock) addWaiter(t *fakeTimer) {
+	c.waiters = append(c.waiters, t)
+	sort.SliceStable(c.waiters, func(i, j int) bool {
+		return c.waiters[i].deadline.Before(c.waiters[j].deadline)
+	})
+	c.cond.Broadcast()
+}
+
+func (c *FakeClock) removeWaiter(t *fakeTimer) bool {
+	for i, w := range c.waiters {
+		if w == t {
+			c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// fakeTimer is a Timer driven by a FakeClock
+type fakeTimer struct {
+	clock    *FakeClock
+	ch       chan time.Time
+	deadline time.Time
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.ch
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	return t.clock.removeWaiter(t)
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	active := t.clock.removeWaiter(t)
+
+	// Drain a pending fire so the reset timer delivers a fresh value
+	select {
+	case <-t.ch:
+	default:
+	}
+
+	t.deadline = t.clock.now.Add(d)
+	if d <= 0 {
+		t.ch <- t.clock.now
+		return active
+	}
+
+	t.clock.addWaiter(t)
+	return active
+}
@@ -0,0 +1,109 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestFakeClock_NowAndAdvance(t *testing.T) {
+	fake := NewFake(testStart)
+
+	assert.Equal(t, testStart, fake.Now())
+
+	fake.Advance(90 * time.Minute)
+	assert.Equal(t, testStart.Add(90*time.Minute), fake.Now())
+	assert.Equal(t, 90*time.Minute, fake.Since(testStart))
+
+	target := time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC)
+	fake.Set(target)
+	assert.Equal(t, target, fake.Now())
+}
+
+func TestFakeClock_AfterFiresOnAdvance(t *testing.T) {
+	fake := NewFake(testStart)
+	ch := fake.After(time.Hour)
+
+	fake.Advance(59 * time.Minute)
+	select {
+	case <-ch:
+		t.Fatal("After fired before its deadline")
+	default:
+	}
+
+	fake.Advance(time.Minute)
+	select {
+	case fired := <-ch:
+		assert.Equal(t, testStart.Add(time.Hour), fired)
+	default:
+		t.Fatal("After did not fire at its deadline")
+	}
+	assert.Equal(t, 0, fake.Waiters())
+}
+
+func TestFakeClock_TimerStopAndReset(t *testing.T) {
+	fake := NewFake(testStart)
+	timer := fake.NewTimer(time.Minute)
+
+	assert.True(t, timer.Stop())
+	assert.False(t, timer.Stop())
+
+	fake.Advance(time.Hour)
+	select {
+	case <-timer.C():
+		t.Fatal("stopped timer fired")
+	default:
+	}
+
+	assert.False(t, timer.Reset(time.Minute))
+	fake.Advance(time.Minute)
+	select {
+	case <-timer.C():
+	default:
+		t.Fatal("reset timer did not fire")
+	}
+}
+
+func TestFakeClock_SetBackwardsDoesNotFire(t *testing.T) {
+	fake := NewFake(testStart)
+	ch := fake.After(time.Minute)
+
+	fake.Set(testStart.Add(-time.Hour))
+	select {
+	case <-ch:
+		t.Fatal("timer fired when moving backwards")
+	default:
+	}
+	assert.Equal(t, 1, fake.Waiters())
+}
+
+func TestFakeClock_BlockUntil(t *testing.T) {
+	fake := NewFake(testStart)
+	done := make(chan time.Time)
+
+	go func() {
+		done <- <-fake.After(time.Second)
+	}()
+
+	fake.BlockUntil(1)
+	fake.Advance(time.Second)
+
+	select {
+	case fired := <-done:
+		assert.Equal(t, testStart.Add(time.Second), fired)
+	case <-time.After(time.Second):
+		t.Fatal("goroutine waiting on fake clock was not released")
+	}
+}
+
+func TestOrReal(t *testing.T) {
+	fake := NewFake(testStart)
+	require.Same(t, fake, OrReal(fake))
+
+	system := OrReal(nil)
+	assert.WithinDuration(t, time.Now(), system.Now(), time.Second)
+}
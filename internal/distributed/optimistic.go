@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"sync"
 	"time"
+
+	"github.com/homveloper/dukdakit/internal/clock"
 )
 
 // OptimisticConfig holds configuration for optimistic concurrency control
@@ -13,6 +15,9 @@ type OptimisticConfig struct {
 	RetryDelay    time.Duration
 	VersionField  string
 	EnableMetrics bool
+
+	// Clock is used to wait between conflict retries (default: system clock)
+	Clock clock.Clock
 }
 
 // DefaultOptimisticConfig returns default configuration
@@ -29,6 +34,7 @@ func DefaultOptimisticConfig() OptimisticConfig {
 type OptimisticController struct {
 	config  OptimisticConfig
 	metrics *OptimisticMetrics
+	clock   clock.Clock
 	mu      sync.RWMutex
 }
 
@@ -67,8 +73,9 @@ func NewOptimistic(config ...OptimisticConfig) *OptimisticController {
 	}
 
 	return &OptimisticController{
-		config: cfg,
+		config:  cfg,
 		metrics: &OptimisticMetrics{},
+		clock:   clock.OrReal(cfg.Clock),
 	}
 }
 
@@ -108,7 +115,7 @@ func (oc *OptimisticController) UpdateWithOptimisticLock(
 					select {
 					case <-ctx.Done():
 						return ctx.Err()
-					case <-time.After(oc.config.RetryDelay):
+					case <-oc.clock.After(oc.config.RetryDelay):
 						// Update to latest version and retry
 						originalVersion = conflictErr.ActualVersion
 						continue
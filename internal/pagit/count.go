@@ -0,0 +1,304 @@
+package pagit
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/homveloper/dukdakit/internal/clock"
+)
+
+// CountStrategy selects how PaginateOffset obtains the total count
+type CountStrategy int
+
+const (
+	// CountExact asks the CountProvider on every call (default)
+	CountExact CountStrategy = iota
+
+	// CountCached reuses a count from OffsetConfig.CountCache until its TTL expires
+	// Requires OffsetConfig.CountKey; without one the count is exact, since
+	// queries with different filters would otherwise share one cached total
+	CountCached
+
+	// CountEstimated uses an EstimatedCountProvider, e.g. PostgreSQL reltuples
+	CountEstimated
+
+	// CountFirstPage counts exactly on page 1 only; later pages report no total
+	// Clients keep the total from the first page
+	CountFirstPage
+
+	// CountNone never counts; the page reports no total
+	CountNone
+)
+
+// String returns the strategy name as reported to clients
+func (s CountStrategy) String() string {
+	switch s {
+	case CountExact:
+		return "exact"
+	case CountCached:
+		return "cached"
+	case CountEstimated:
+		return "estimated"
+	case CountFirstPage:
+		return "first_page"
+	case CountNone:
+		return "none"
+	default:
+		return "unknown"
+	}
+}
+
+// CountCacheConfig holds configuration for CountCache
+type CountCacheConfig struct {
+	// TTL is how long a count is reused (default 1 minute)
+	TTL time.Duration
+
+	// Clock is the time source for expiry; nil means the system clock
+	Clock clock.Clock
+
+	// MaxEntries caps the number of cached keys (default 1024)
+	// The least recently used key is evicted first; zero or less means no cap
+	MaxEntries int
+}
+
+// CountCacheOption configures a CountCache
+type CountCacheOption func(*CountCacheConfig)
+
+// WithCountTTL sets how long a cached count is reused
+func WithCountTTL(ttl time.Duration) CountCacheOption {
+	return func(c *CountCacheConfig) {
+		c.TTL = ttl
+	}
+}
+
+// WithCountCacheClock sets the time source used for expiry
+func WithCountCacheClock(source clock.Clock) CountCacheOption {
+	return func(c *CountCacheConfig) {
+		c.Clock = source
+	}
+}
+
+// WithCountCacheSize caps the number of cached keys, evicting the least recently used
+func WithCountCacheSize(maxEntries int) CountCacheOption {
+	return func(c *CountCacheConfig) {
+		c.MaxEntries = maxEntries
+	}
+}
+
+// CountCache keeps total counts for a while so that paging through a big
+// table does not run COUNT(*) on every request. Entries are keyed by
+// OffsetConfig.CountKey, typically a FilterHash of the request filter.
+// Expired entries are dropped when read, and the least recently used key is
+// evicted once MaxEntries is reached, so per-filter keys cannot grow without bound.
+// It is safe for concurrent use.
+//
+// Example usage:
+//
+//	counts := pagit.NewCountCache(pagit.WithCountTTL(30 * time.Second))
+//
+//	key, _ := pagit.FilterHash(filter)
+//	page, err := pagit.PaginateOffset(ctx, provider, pagit.OffsetConfig{
+//	    Page: 2, PageSize: 50,
+//	    Count: pagit.CountCached, CountCache: counts, CountKey: key,
+//	})
+//	// page.CountSource is CountCached when the total came from the cache
+type CountCache struct {
+	mu         sync.Mutex
+	entries    map[string]*list.Element
+	lru        *list.List // front is the most recently used countEntry
+	ttl        time.Duration
+	maxEntries int
+	clock      clock.Clock
+}
+
+type countEntry struct {
+	key       string
+	total     int64
+	countedAt time.Time
+}
+
+// NewCountCache creates a count cache
+func NewCountCache(options ...CountCacheOption) *CountCache {
+	config := CountCacheConfig{TTL: time.Minute, MaxEntries: 1024}
+	for _, option := range options {
+		option(&config)
+	}
+
+	return &CountCache{
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+		ttl:        config.TTL,
+		maxEntries: config.MaxEntries,
+		clock:      clock.OrReal(config.Clock),
+	}
+}
+
+// Count returns the cached total for key, calling counter when it is missing or expired
+// hit reports whether the total came from the cache
+func (c *CountCache) Count(ctx context.Context, key string, counter CountProvider) (total int64, hit bool, err error) {
+	now := c.clock.Now()
+
+	if total, ok := c.get(key, now); ok {
+		return total, true, nil
+	}
+
+	total, err = counter.GetTotalCount(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	if total >= 0 {
+		c.put(key, total, now)
+	}
+	return total, false, nil
+}
+
+// Invalidate drops the cached total for key, e.g. after inserting rows
+func (c *CountCache) Invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+}
+
+// Clear drops every cached total
+func (c *CountCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]*list.Element)
+	c.lru.Init()
+}
+
+// Len returns the number of cached keys, including expired ones not yet dropped
+func (c *CountCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// get returns a fresh total for key, dropping it if it has expired
+func (c *CountCache) get(key string, now time.Time) (int64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return 0, false
+	}
+	entry := element.Value.(*countEntry)
+	if now.Sub(entry.countedAt) >= c.ttl {
+		c.remove(element)
+		return 0, false
+	}
+	c.lru.MoveToFront(element)
+	return entry.total, true
+}
+
+// put stores total for key, evicting expired and then least recently used keys over the cap
+func (c *CountCache) put(key string, total int64, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*countEntry)
+		entry.total, entry.countedAt = total, now
+		c.lru.MoveToFront(element)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&countEntry{key: key, total: total, countedAt: now})
+
+	if c.maxEntries <= 0 || len(c.entries) <= c.maxEntries {
+		return
+	}
+	// Over the cap: drop every expired key first, then the least recently used
+	for element := c.lru.Back(); element != nil; {
+		prev := element.Prev()
+		if now.Sub(element.Value.(*countEntry).countedAt) >= c.ttl {
+			c.remove(element)
+		}
+		element = prev
+	}
+	for len(c.entries) > c.maxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+// remove drops element from both the index and the LRU list
+func (c *CountCache) remove(element *list.Element) {
+	c.lru.Remove(element)
+	delete(c.entries, element.Value.(*countEntry).key)
+}
+
+// resolveTotalCount returns the total count for config and how it was obtained
+// total is -1 and source CountNone when no count is available
+func resolveTotalCount(ctx context.Context, provider any, config OffsetConfig) (total int64, source CountStrategy, err error) {
+	counter, canCount := provider.(CountProvider)
+
+	estimator := config.Estimator
+	if estimator == nil {
+		estimator, _ = provider.(EstimatedCountProvider)
+	}
+
+	switch config.Count {
+	case CountNone:
+		return -1, CountNone, nil
+
+	case CountFirstPage:
+		if config.Page != 1 {
+			return -1, CountNone, nil
+		}
+
+	case CountEstimated:
+		if estimator != nil {
+			total, ok, err := countWith(ctx, estimator.GetEstimatedCount)
+			if err != nil || ok {
+				return total, CountEstimated, err
+			}
+		}
+
+	case CountCached:
+		if canCount && config.CountCache != nil && config.CountKey != "" {
+			total, hit, err := config.CountCache.Count(ctx, config.CountKey, counter)
+			if err != nil && !errors.Is(err, ErrTotalCountUnavailable) {
+				return 0, CountNone, err
+			}
+			if err == nil && total >= 0 {
+				if hit {
+					return total, CountCached, nil
+				}
+				return total, CountExact, nil
+			}
+		}
+	}
+
+	// Exact count, falling back to an estimate when the exact count is unavailable
+	if canCount {
+		total, ok, err := countWith(ctx, counter.GetTotalCount)
+		if err != nil || ok {
+			return total, CountExact, err
+		}
+	}
+	if estimator != nil {
+		total, ok, err := countWith(ctx, estimator.GetEstimatedCount)
+		if err != nil || ok {
+			return total, CountEstimated, err
+		}
+	}
+
+	return -1, CountNone, nil
+}
+
+// countWith runs count, treating ErrTotalCountUnavailable and negative totals as no count
+func countWith(ctx context.Context, count func(context.Context) (int64, error)) (int64, bool, error) {
+	total, err := count(ctx)
+	if errors.Is(err, ErrTotalCountUnavailable) {
+		return -1, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return total, total >= 0, nil
+}
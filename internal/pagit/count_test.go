@@ -0,0 +1,169 @@
+package pagit
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/homveloper/dukdakit/internal/clock"
+)
+
+// countingProvider records how often the exact count runs
+type countingProvider struct {
+	*SliceProvider[TestItem]
+	counts atomic.Int32
+}
+
+func (p *countingProvider) GetTotalCount(ctx context.Context) (int64, error) {
+	p.counts.Add(1)
+	return p.SliceProvider.GetTotalCount(ctx)
+}
+
+type fixedEstimate int64
+
+func (e fixedEstimate) GetEstimatedCount(ctx context.Context) (int64, error) {
+	return int64(e), nil
+}
+
+func TestPaginateOffset_CountCached(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	cache := NewCountCache(WithCountTTL(time.Minute), WithCountCacheClock(fake))
+	provider := &countingProvider{SliceProvider: NewSliceProvider(numberedItems(30))}
+	config := OffsetConfig{Page: 1, PageSize: 10, Count: CountCached, CountCache: cache, CountKey: "all"}
+
+	page, err := PaginateOffset(ctx, provider, config)
+	require.NoError(t, err)
+	assert.Equal(t, CountExact, page.CountSource, "a cache miss counts exactly")
+	assert.False(t, page.TotalEstimated)
+
+	provider.Add(numberedItems(5)...)
+	config.Page = 2
+	page, err = PaginateOffset(ctx, provider, config)
+	require.NoError(t, err)
+	assert.Equal(t, CountCached, page.CountSource)
+	assert.True(t, page.TotalEstimated)
+	assert.Equal(t, int64(30), *page.TotalCount, "stale until the TTL expires")
+	assert.Equal(t, int32(1), provider.counts.Load())
+
+	fake.Advance(time.Minute)
+	page, err = PaginateOffset(ctx, provider, config)
+	require.NoError(t, err)
+	assert.Equal(t, int64(35), *page.TotalCount)
+	assert.Equal(t, int32(2), provider.counts.Load())
+
+	cache.Invalidate("all")
+	_, err = PaginateOffset(ctx, provider, config)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), provider.counts.Load())
+}
+
+func TestPaginateOffset_CountCachedPerFilter(t *testing.T) {
+	ctx := context.Background()
+	cache := NewCountCache(WithCountTTL(time.Minute))
+	items := numberedItems(30)
+	all := &countingProvider{SliceProvider: NewSliceProvider(items)}
+	even := &countingProvider{SliceProvider: NewSliceProvider(items, WithSliceFilter(func(item TestItem) bool { return item.ID%2 == 0 }))}
+
+	// Each filter keeps its own total in the shared cache
+	for i := 0; i < 2; i++ {
+		page, err := PaginateOffset(ctx, all, OffsetConfig{Page: 1, PageSize: 10, Count: CountCached, CountCache: cache, CountKey: "all"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(30), *page.TotalCount)
+
+		page, err = PaginateOffset(ctx, even, OffsetConfig{Page: 1, PageSize: 10, Count: CountCached, CountCache: cache, CountKey: "even"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(15), *page.TotalCount)
+	}
+	assert.Equal(t, int32(1), all.counts.Load())
+	assert.Equal(t, int32(1), even.counts.Load())
+
+	// Without a key the cache is skipped, so filters never share a total
+	cache = NewCountCache(WithCountTTL(time.Minute))
+	for provider, total := range map[*countingProvider]int64{all: 30, even: 15} {
+		page, err := PaginateOffset(ctx, provider, OffsetConfig{Page: 1, PageSize: 10, Count: CountCached, CountCache: cache})
+		require.NoError(t, err)
+		assert.Equal(t, CountExact, page.CountSource)
+		assert.Equal(t, total, *page.TotalCount)
+	}
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestCountCache_Eviction(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	cache := NewCountCache(WithCountTTL(time.Minute), WithCountCacheClock(fake), WithCountCacheSize(2))
+	provider := &countingProvider{SliceProvider: NewSliceProvider(numberedItems(3))}
+
+	count := func(key string) bool {
+		_, hit, err := cache.Count(ctx, key, provider)
+		require.NoError(t, err)
+		return hit
+	}
+
+	count("a")
+	count("b")
+	assert.True(t, count("a"), "a becomes most recently used")
+	count("c")
+	assert.Equal(t, 2, cache.Len(), "capped at two keys")
+	assert.False(t, count("b"), "least recently used key was evicted")
+
+	// Expired keys go first once the cap is reached, and are never served
+	fake.Advance(time.Minute)
+	count("d")
+	assert.Equal(t, 1, cache.Len(), "expired b and c were dropped, not just the oldest")
+	assert.False(t, count("c"))
+	assert.True(t, count("d"))
+
+	cache.Clear()
+	assert.Zero(t, cache.Len())
+}
+
+func TestPaginateOffset_CountFirstPage(t *testing.T) {
+	ctx := context.Background()
+	provider := &countingProvider{SliceProvider: NewSliceProvider(numberedItems(30))}
+
+	page, err := PaginateOffset(ctx, provider, OffsetConfig{Page: 1, PageSize: 10, Count: CountFirstPage})
+	require.NoError(t, err)
+	assert.Equal(t, int64(30), *page.TotalCount)
+	assert.Equal(t, CountExact, page.CountSource)
+
+	page, err = PaginateOffset(ctx, provider, OffsetConfig{Page: 2, PageSize: 10, Count: CountFirstPage})
+	require.NoError(t, err)
+	assert.Nil(t, page.TotalCount)
+	assert.Equal(t, CountNone, page.CountSource)
+	assert.True(t, page.HasNext)
+	assert.Equal(t, int32(1), provider.counts.Load())
+}
+
+func TestPaginateOffset_CountEstimatedOverride(t *testing.T) {
+	ctx := context.Background()
+	provider := &countingProvider{SliceProvider: NewSliceProvider(numberedItems(30))}
+
+	page, err := PaginateOffset(ctx, provider, OffsetConfig{
+		Page: 1, PageSize: 10, Count: CountEstimated, Estimator: fixedEstimate(28),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(28), *page.TotalCount)
+	assert.Equal(t, CountEstimated, page.CountSource)
+	assert.Zero(t, provider.counts.Load())
+
+	// Without any estimator the exact count is used
+	page, err = PaginateOffset(ctx, provider, OffsetConfig{Page: 1, PageSize: 10, Count: CountEstimated})
+	require.NoError(t, err)
+	assert.Equal(t, CountExact, page.CountSource)
+}
+
+func TestPaginateOffset_CountNone(t *testing.T) {
+	provider := &countingProvider{SliceProvider: NewSliceProvider(numberedItems(3))}
+
+	page, err := PaginateOffset(context.Background(), provider, OffsetConfig{Page: 1, PageSize: 10, Count: CountNone})
+	require.NoError(t, err)
+	assert.Nil(t, page.TotalCount)
+	assert.Zero(t, provider.counts.Load())
+	assert.Equal(t, "none", page.CountSource.String())
+}
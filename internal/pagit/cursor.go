@@ -35,80 +35,114 @@ var (
 //	// With database provider
 //	dbProvider := &UserCursorDBProvider{db: myDB}
 //	result := PaginateCursor(ctx, dbProvider, config, extractor)
+//
+//	// With opaque tokens instead of raw cursors
+//	config := CursorConfig[int64]{PageSize: 20, Token: req.Cursor, Codec: codec}
+//	result, err := PaginateCursor(ctx, dbProvider, config, extractor)
+//	// return result.NextToken / result.PrevToken to the client
 func PaginateCursor[T any, C comparable](
 	ctx context.Context,
 	provider CursorDataProvider[T, C],
 	config CursorConfig[C],
 	extractor CursorExtractor[T, C],
 ) (CursorResult[T, C], error) {
+	// Resolve opaque token into cursor, direction and page size
+	config, err := resolveCursorToken(config)
+	if err != nil {
+		return CursorResult[T, C]{}, err
+	}
+
 	// Validate page size
 	if config.PageSize <= 0 || config.PageSize > MaxPageSize {
 		return CursorResult[T, C]{}, ErrInvalidPageSize
 	}
 
+	// Fetch one extra item: its presence tells exactly whether more data
+	// exists in the direction of travel
+	fetchSize := config.PageSize + 1
+
 	var data []T
-	var err error
 
 	// Get data based on direction
+	// Providers return items in forward order for both directions
 	switch config.Direction {
 	case CursorBackward:
-		data, err = provider.GetDataBefore(ctx, config.Cursor, config.PageSize)
+		data, err = provider.GetDataBefore(ctx, config.Cursor, fetchSize)
 	default: // CursorForward or unspecified
-		data, err = provider.GetDataAfter(ctx, config.Cursor, config.PageSize)
+		data, err = provider.GetDataAfter(ctx, config.Cursor, fetchSize)
 	}
 
 	if err != nil {
 		return CursorResult[T, C]{}, err
 	}
 
+	// Drop the extra item, which sits furthest from the cursor
+	hasMore := len(data) > config.PageSize
+	if hasMore {
+		if config.Direction == CursorBackward {
+			data = data[len(data)-config.PageSize:]
+		} else {
+			data = data[:config.PageSize]
+		}
+	}
+
 	result := CursorResult[T, C]{
 		Data:  data,
 		Count: len(data),
 	}
 
-	// Set cursors from data if available
 	if len(data) > 0 {
 		firstCursor := extractor(data[0])
 		lastCursor := extractor(data[len(data)-1])
 
-		// Set navigation cursors
+		// Edge cursors are always returned so clients can poll for items
+		// added beyond either end of the page
+		result.NextCursor = &lastCursor
+		result.PrevCursor = &firstCursor
+
 		if config.Direction == CursorBackward {
-			result.NextCursor = &lastCursor
-			if len(data) == config.PageSize {
-				result.PrevCursor = &firstCursor
-			}
+			result.HasPrev = hasMore
+			result.HasNext, err = hasDataBeyond(ctx, provider, config, lastCursor, CursorForward)
 		} else {
-			result.NextCursor = &lastCursor
-			if config.Cursor != nil {
-				result.PrevCursor = &firstCursor
-			}
+			result.HasNext = hasMore
+			result.HasPrev, err = hasDataBeyond(ctx, provider, config, firstCursor, CursorBackward)
+		}
+		if err != nil {
+			return CursorResult[T, C]{}, err
 		}
 	}
 
-	// Check for more data if provider supports it
-	if checkProvider, ok := provider.(CursorCheckProvider[C]); ok {
-		if len(data) > 0 {
-			lastCursor := extractor(data[len(data)-1])
-			firstCursor := extractor(data[0])
-
-			hasNext, err := checkProvider.HasDataAfter(ctx, lastCursor)
-			if err == nil {
-				result.HasNext = hasNext
-			}
-
-			if config.Cursor != nil {
-				hasPrev, err := checkProvider.HasDataBefore(ctx, firstCursor)
-				if err == nil {
-					result.HasPrev = hasPrev
-				}
-			}
-		}
-	} else {
-		// Fallback: assume there might be more data if we got a full page
-		result.HasNext = len(data) == config.PageSize
-		result.HasPrev = config.Cursor != nil
+	if err := encodeResultTokens(&result, config); err != nil {
+		return CursorResult[T, C]{}, err
 	}
 
 	return result, nil
 }
 
+// hasDataBeyond reports whether items exist past edge on the side opposite to
+// the direction of travel
+//
+// A request without a cursor starts at an end of the data, so nothing lies behind it.
+// Otherwise CursorCheckProvider answers exactly; providers without it fall back to
+// assuming the cursor item itself is still there
+func hasDataBeyond[T any, C comparable](
+	ctx context.Context,
+	provider CursorDataProvider[T, C],
+	config CursorConfig[C],
+	edge C,
+	direction CursorDirection,
+) (bool, error) {
+	if config.Cursor == nil {
+		return false, nil
+	}
+
+	checkProvider, ok := provider.(CursorCheckProvider[C])
+	if !ok {
+		return true, nil
+	}
+
+	if direction == CursorBackward {
+		return checkProvider.HasDataBefore(ctx, edge)
+	}
+	return checkProvider.HasDataAfter(ctx, edge)
+}
@@ -0,0 +1,169 @@
+package pagit
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// dataOnlyProvider hides CursorCheckProvider so the fallback path is exercised
+type dataOnlyProvider[T any, C comparable] struct {
+	inner CursorDataProvider[T, C]
+}
+
+func (p dataOnlyProvider[T, C]) GetDataAfter(ctx context.Context, cursor *C, limit int) ([]T, error) {
+	return p.inner.GetDataAfter(ctx, cursor, limit)
+}
+
+func (p dataOnlyProvider[T, C]) GetDataBefore(ctx context.Context, cursor *C, limit int) ([]T, error) {
+	return p.inner.GetDataBefore(ctx, cursor, limit)
+}
+
+// randomItems returns n items with strictly increasing, randomly spaced IDs
+func randomItems(rng *rand.Rand, n int) []TestItem {
+	items := make([]TestItem, n)
+	id := int64(0)
+	for i := range items {
+		id += 1 + rng.Int63n(5)
+		items[i] = TestItem{ID: id, Name: fmt.Sprintf("Item%d", id)}
+	}
+	return items
+}
+
+func itemIDs(items []TestItem) []int64 {
+	ids := make([]int64, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	return ids
+}
+
+func TestPaginateCursor_RandomWalks(t *testing.T) {
+	extractor := func(item TestItem) int64 { return item.ID }
+	idKeyset := Keyset[int64]{OrderedKey("id", SortAsc, func(c int64) int64 { return c })}
+
+	providers := map[string]func([]TestItem) CursorDataProvider[TestItem, int64]{
+		"slice": func(data []TestItem) CursorDataProvider[TestItem, int64] {
+			return NewSliceCursorProvider(data, extractor)
+		},
+		"keyset": func(data []TestItem) CursorDataProvider[TestItem, int64] {
+			provider, err := NewSliceKeysetProvider(data, extractor, idKeyset)
+			require.NoError(t, err)
+			return provider
+		},
+		"without check provider": func(data []TestItem) CursorDataProvider[TestItem, int64] {
+			return dataOnlyProvider[TestItem, int64]{inner: NewSliceCursorProvider(data, extractor)}
+		},
+	}
+
+	for name, newProvider := range providers {
+		t.Run(name, func(t *testing.T) {
+			for seed := int64(0); seed < 200; seed++ {
+				rng := rand.New(rand.NewSource(seed))
+				data := randomItems(rng, rng.Intn(40))
+				pageSize := 1 + rng.Intn(7)
+				provider := newProvider(data)
+
+				forward := walkCursor(t, provider, extractor, pageSize, CursorForward)
+				backward := walkCursor(t, provider, extractor, pageSize, CursorBackward)
+
+				require.Equal(t, itemIDs(data), flattenPages(forward), "seed %d: forward walk", seed)
+
+				// Backward pages arrive last-first but each page is in forward order
+				reversed := make([][]TestItem, len(backward))
+				for i, page := range backward {
+					reversed[len(backward)-1-i] = page
+				}
+				require.Equal(t, itemIDs(data), flattenPages(reversed), "seed %d: backward walk", seed)
+
+				checkRoundTrip(t, provider, extractor, forward, pageSize, seed)
+			}
+		})
+	}
+}
+
+// walkCursor pages through the whole dataset in one direction, checking the
+// navigation flags of every page, and returns the pages in the order visited
+func walkCursor(
+	t *testing.T,
+	provider CursorDataProvider[TestItem, int64],
+	extractor CursorExtractor[TestItem, int64],
+	pageSize int,
+	direction CursorDirection,
+) [][]TestItem {
+	t.Helper()
+	ctx := context.Background()
+	config := CursorConfig[int64]{PageSize: pageSize, Direction: direction}
+
+	var pages [][]TestItem
+	for {
+		result, err := PaginateCursor(ctx, provider, config, extractor)
+		require.NoError(t, err)
+
+		if len(result.Data) == 0 {
+			require.Empty(t, pages, "only an empty dataset yields an empty page")
+			require.False(t, result.HasNext)
+			require.False(t, result.HasPrev)
+			return pages
+		}
+		pages = append(pages, result.Data)
+
+		for i := 1; i < len(result.Data); i++ {
+			require.Less(t, result.Data[i-1].ID, result.Data[i].ID, "page is in forward order")
+		}
+
+		first := len(pages) == 1
+		more := result.HasNext
+		behind := result.HasPrev
+		if direction == CursorBackward {
+			more, behind = result.HasPrev, result.HasNext
+		}
+		require.Equal(t, !first, behind, "page %d: data behind the walk", len(pages))
+
+		if !more {
+			return pages
+		}
+		require.Len(t, result.Data, pageSize, "only the final page may be short")
+
+		if direction == CursorBackward {
+			config.Cursor = result.PrevCursor
+		} else {
+			config.Cursor = result.NextCursor
+		}
+	}
+}
+
+// checkRoundTrip verifies that stepping back from forward page k yields page k-1
+func checkRoundTrip(
+	t *testing.T,
+	provider CursorDataProvider[TestItem, int64],
+	extractor CursorExtractor[TestItem, int64],
+	pages [][]TestItem,
+	pageSize int,
+	seed int64,
+) {
+	t.Helper()
+	for k := 1; k < len(pages); k++ {
+		cursor := extractor(pages[k][0])
+		result, err := PaginateCursor(context.Background(), provider, CursorConfig[int64]{
+			PageSize:  pageSize,
+			Cursor:    &cursor,
+			Direction: CursorBackward,
+		}, extractor)
+		require.NoError(t, err)
+		require.Equal(t, itemIDs(pages[k-1]), itemIDs(result.Data), "seed %d: back from page %d", seed, k)
+		require.Equal(t, k > 1, result.HasPrev, "seed %d: page %d", seed, k-1)
+		require.True(t, result.HasNext)
+	}
+}
+
+func flattenPages(pages [][]TestItem) []int64 {
+	ids := []int64{}
+	for _, page := range pages {
+		ids = append(ids, itemIDs(page)...)
+	}
+	return ids
+}
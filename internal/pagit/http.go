@@ -0,0 +1,323 @@
+package pagit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// HTTPConfig holds the query parameter names and limits used by the HTTP helpers
+type HTTPConfig struct {
+	// PageParam is the 1-based page number parameter (default "page")
+	PageParam string
+
+	// PageSizeParam is the page size parameter (default "page_size")
+	PageSizeParam string
+
+	// CursorParam is the opaque cursor token parameter (default "cursor")
+	CursorParam string
+
+	// DefaultPageSize is used when the request has no page size
+	DefaultPageSize int
+
+	// MaxPageSize rejects larger page sizes; never above the package MaxPageSize
+	MaxPageSize int
+}
+
+// HTTPOption configures the HTTP helpers
+type HTTPOption func(*HTTPConfig)
+
+// WithPageParams renames the page and page size query parameters
+func WithPageParams(page, pageSize string) HTTPOption {
+	return func(c *HTTPConfig) {
+		c.PageParam = page
+		c.PageSizeParam = pageSize
+	}
+}
+
+// WithCursorParam renames the cursor query parameter
+func WithCursorParam(name string) HTTPOption {
+	return func(c *HTTPConfig) {
+		c.CursorParam = name
+	}
+}
+
+// WithDefaultPageSize sets the page size used when the request has none
+func WithDefaultPageSize(size int) HTTPOption {
+	return func(c *HTTPConfig) {
+		c.DefaultPageSize = size
+	}
+}
+
+// WithMaxPageSize lowers the largest page size a client may request
+func WithMaxPageSize(size int) HTTPOption {
+	return func(c *HTTPConfig) {
+		c.MaxPageSize = size
+	}
+}
+
+func buildHTTPConfig(options []HTTPOption) HTTPConfig {
+	config := HTTPConfig{
+		PageParam:       "page",
+		PageSizeParam:   "page_size",
+		CursorParam:     "cursor",
+		DefaultPageSize: DefaultOffsetPageSize,
+		MaxPageSize:     MaxPageSize,
+	}
+	for _, option := range options {
+		option(&config)
+	}
+	if config.MaxPageSize <= 0 || config.MaxPageSize > MaxPageSize {
+		config.MaxPageSize = MaxPageSize
+	}
+	if config.DefaultPageSize <= 0 || config.DefaultPageSize > config.MaxPageSize {
+		config.DefaultPageSize = min(DefaultOffsetPageSize, config.MaxPageSize)
+	}
+	return config
+}
+
+// ParseOffsetConfig reads page and page_size from the request query
+// Missing values default to page 1 and the default page size. Malformed or
+// out of range values return an error wrapping ErrInvalidPage or
+// ErrInvalidPageSize, which handlers should answer with 400 Bad Request.
+//
+// Example usage:
+//
+//	config, err := pagit.ParseOffsetConfig(r, pagit.WithMaxPageSize(100))
+//	if err != nil {
+//	    http.Error(w, err.Error(), http.StatusBadRequest)
+//	    return
+//	}
+//	page, err := pagit.PaginateOffset(r.Context(), provider, config)
+//	...
+//	pagit.WriteOffsetPage(w, r, page)
+func ParseOffsetConfig(r *http.Request, options ...HTTPOption) (OffsetConfig, error) {
+	httpConfig := buildHTTPConfig(options)
+	query := r.URL.Query()
+
+	config := OffsetConfig{Page: 1, PageSize: httpConfig.DefaultPageSize}
+
+	if raw := query.Get(httpConfig.PageParam); raw != "" {
+		page, err := strconv.Atoi(raw)
+		if err != nil || page <= 0 {
+			return OffsetConfig{}, fmt.Errorf("%w: %s=%q", ErrInvalidPage, httpConfig.PageParam, raw)
+		}
+		config.Page = page
+	}
+
+	pageSize, err := parsePageSize(query, httpConfig)
+	if err != nil {
+		return OffsetConfig{}, err
+	}
+	if pageSize > 0 {
+		config.PageSize = pageSize
+	}
+
+	return config, nil
+}
+
+// ParseCursorConfig reads cursor and page_size from the request query
+// The cursor parameter is an opaque token issued by codec; it is verified here
+// so tampered or expired tokens fail with ErrInvalidCursor before any query runs.
+// Without a page size the token's page size is used, or the default on the first page.
+//
+// Example usage:
+//
+//	config, err := pagit.ParseCursorConfig(r, codec)
+//	if err != nil {
+//	    http.Error(w, err.Error(), http.StatusBadRequest)
+//	    return
+//	}
+//	result, err := pagit.PaginateCursor(r.Context(), provider, config, extractor)
+//	...
+//	pagit.WriteCursorPage(w, r, result)
+func ParseCursorConfig[C comparable](r *http.Request, codec *CursorCodec[C], options ...HTTPOption) (CursorConfig[C], error) {
+	httpConfig := buildHTTPConfig(options)
+	query := r.URL.Query()
+
+	config := CursorConfig[C]{Codec: codec}
+
+	pageSize, err := parsePageSize(query, httpConfig)
+	if err != nil {
+		return CursorConfig[C]{}, err
+	}
+	config.PageSize = pageSize
+
+	token := query.Get(httpConfig.CursorParam)
+	if token == "" {
+		if config.PageSize == 0 {
+			config.PageSize = httpConfig.DefaultPageSize
+		}
+		return config, nil
+	}
+
+	if codec == nil {
+		return CursorConfig[C]{}, fmt.Errorf("%w: cursor given without codec", ErrInvalidCursor)
+	}
+	decoded, err := codec.Decode(token)
+	if err != nil {
+		return CursorConfig[C]{}, err
+	}
+	if config.PageSize == 0 && decoded.PageSize > httpConfig.MaxPageSize {
+		return CursorConfig[C]{}, fmt.Errorf("%w: cursor page size %d exceeds %d", ErrInvalidPageSize, decoded.PageSize, httpConfig.MaxPageSize)
+	}
+
+	config.Token = token
+	return config, nil
+}
+
+// parsePageSize returns the validated page size parameter, or 0 when absent
+func parsePageSize(query url.Values, config HTTPConfig) (int, error) {
+	raw := query.Get(config.PageSizeParam)
+	if raw == "" {
+		return 0, nil
+	}
+
+	size, err := strconv.Atoi(raw)
+	if err != nil || size <= 0 || size > config.MaxPageSize {
+		return 0, fmt.Errorf("%w: %s=%q must be between 1 and %d", ErrInvalidPageSize, config.PageSizeParam, raw, config.MaxPageSize)
+	}
+	return size, nil
+}
+
+// PageEnvelope is the JSON body written by WriteOffsetPage and WriteCursorPage
+type PageEnvelope[T any] struct {
+	Data       []T            `json:"data"`
+	Pagination PaginationMeta `json:"pagination"`
+}
+
+// PaginationMeta describes the page inside a PageEnvelope
+type PaginationMeta struct {
+	Page           int    `json:"page,omitempty"`
+	PageSize       int    `json:"page_size,omitempty"`
+	TotalCount     *int64 `json:"total_count,omitempty"`
+	TotalPages     int    `json:"total_pages,omitempty"`
+	TotalEstimated bool   `json:"total_estimated,omitempty"`
+	CountSource    string `json:"count_source,omitempty"`
+	Count          int    `json:"count"`
+	HasNext        bool   `json:"has_next"`
+	HasPrev        bool   `json:"has_prev"`
+	NextCursor     string `json:"next_cursor,omitempty"`
+	PrevCursor     string `json:"prev_cursor,omitempty"`
+}
+
+// WriteOffsetPage writes page as a JSON envelope with RFC 8288 Link headers
+// Links keep the request's other query parameters and point to the first,
+// previous, next and (with an exact total) last pages.
+//
+// Example response:
+//
+//	Link: </items?page=1&page_size=20>; rel="first", </items?page=1&page_size=20>; rel="prev", </items?page=3&page_size=20>; rel="next"
+//
+//	{"data":[...],"pagination":{"page":2,"page_size":20,"total_count":57,"total_pages":3,"count":20,"has_next":true,"has_prev":true}}
+func WriteOffsetPage[T any](w http.ResponseWriter, r *http.Request, page OffsetPage[T], options ...HTTPOption) error {
+	config := buildHTTPConfig(options)
+
+	pageLink := func(n int) string {
+		return linkURL(r, map[string]string{
+			config.PageParam:     strconv.Itoa(n),
+			config.PageSizeParam: strconv.Itoa(page.PageSize),
+		})
+	}
+
+	var links []string
+	links = append(links, formatLink(pageLink(1), "first"))
+	if page.HasPrev {
+		links = append(links, formatLink(pageLink(page.Page-1), "prev"))
+	}
+	if page.HasNext {
+		links = append(links, formatLink(pageLink(page.Page+1), "next"))
+	}
+	if page.TotalCount != nil && !page.TotalEstimated && page.TotalPages > 0 {
+		links = append(links, formatLink(pageLink(page.TotalPages), "last"))
+	}
+
+	return writeEnvelope(w, links, PageEnvelope[T]{
+		Data: nonNil(page.Data),
+		Pagination: PaginationMeta{
+			Page:           page.Page,
+			PageSize:       page.PageSize,
+			TotalCount:     page.TotalCount,
+			TotalPages:     page.TotalPages,
+			TotalEstimated: page.TotalEstimated,
+			CountSource:    countSource(page),
+			Count:          page.Count,
+			HasNext:        page.HasNext,
+			HasPrev:        page.HasPrev,
+		},
+	})
+}
+
+// WriteCursorPage writes result as a JSON envelope with RFC 8288 Link headers
+// The next and prev links carry result.NextToken and result.PrevToken, so the
+// request config must have had a Codec; the first link drops the cursor.
+func WriteCursorPage[T any, C comparable](w http.ResponseWriter, r *http.Request, result CursorResult[T, C], options ...HTTPOption) error {
+	config := buildHTTPConfig(options)
+
+	links := []string{formatLink(linkURL(r, map[string]string{config.CursorParam: ""}), "first")}
+	if result.PrevToken != "" {
+		links = append(links, formatLink(linkURL(r, map[string]string{config.CursorParam: result.PrevToken}), "prev"))
+	}
+	if result.NextToken != "" {
+		links = append(links, formatLink(linkURL(r, map[string]string{config.CursorParam: result.NextToken}), "next"))
+	}
+
+	return writeEnvelope(w, links, PageEnvelope[T]{
+		Data: nonNil(result.Data),
+		Pagination: PaginationMeta{
+			Count:      result.Count,
+			HasNext:    result.HasNext,
+			HasPrev:    result.HasPrev,
+			NextCursor: result.NextToken,
+			PrevCursor: result.PrevToken,
+		},
+	})
+}
+
+// countSource names how the total was obtained, omitted when there is no total
+func countSource[T any](page OffsetPage[T]) string {
+	if page.TotalCount == nil {
+		return ""
+	}
+	return page.CountSource.String()
+}
+
+// linkURL returns the request path and query with params replaced
+// An empty value removes the parameter
+func linkURL(r *http.Request, params map[string]string) string {
+	query := r.URL.Query()
+	for name, value := range params {
+		if value == "" {
+			query.Del(name)
+		} else {
+			query.Set(name, value)
+		}
+	}
+
+	link := url.URL{Path: r.URL.Path, RawQuery: query.Encode()}
+	return link.String()
+}
+
+func formatLink(target, rel string) string {
+	return fmt.Sprintf("<%s>; rel=%q", target, rel)
+}
+
+func writeEnvelope[T any](w http.ResponseWriter, links []string, envelope PageEnvelope[T]) error {
+	if len(links) > 0 {
+		w.Header().Set("Link", strings.Join(links, ", "))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	return json.NewEncoder(w).Encode(envelope)
+}
+
+// nonNil keeps empty pages rendering as [] rather than null
+func nonNil[T any](data []T) []T {
+	if data == nil {
+		return []T{}
+	}
+	return data
+}
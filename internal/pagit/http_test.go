@@ -0,0 +1,139 @@
+package pagit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOffsetConfig(t *testing.T) {
+	config, err := ParseOffsetConfig(httptest.NewRequest("GET", "/items", nil))
+	require.NoError(t, err)
+	assert.Equal(t, OffsetConfig{Page: 1, PageSize: DefaultOffsetPageSize}, config)
+
+	config, err = ParseOffsetConfig(httptest.NewRequest("GET", "/items?page=3&page_size=50", nil))
+	require.NoError(t, err)
+	assert.Equal(t, OffsetConfig{Page: 3, PageSize: 50}, config)
+
+	config, err = ParseOffsetConfig(httptest.NewRequest("GET", "/items?p=2&n=5", nil), WithPageParams("p", "n"))
+	require.NoError(t, err)
+	assert.Equal(t, OffsetConfig{Page: 2, PageSize: 5}, config)
+}
+
+func TestParseOffsetConfig_Invalid(t *testing.T) {
+	cases := []struct {
+		url     string
+		options []HTTPOption
+		want    error
+	}{
+		{"/items?page=0", nil, ErrInvalidPage},
+		{"/items?page=abc", nil, ErrInvalidPage},
+		{"/items?page_size=-1", nil, ErrInvalidPageSize},
+		{"/items?page_size=1001", nil, ErrInvalidPageSize},
+		{"/items?page_size=101", []HTTPOption{WithMaxPageSize(100)}, ErrInvalidPageSize},
+	}
+	for _, tc := range cases {
+		_, err := ParseOffsetConfig(httptest.NewRequest("GET", tc.url, nil), tc.options...)
+		assert.True(t, errors.Is(err, tc.want), "%s: %v", tc.url, err)
+	}
+}
+
+func TestParseCursorConfig(t *testing.T) {
+	codec := NewCursorCodec[int64](WithTokenSecret([]byte("secret")))
+
+	config, err := ParseCursorConfig(httptest.NewRequest("GET", "/feed", nil), codec, WithDefaultPageSize(10))
+	require.NoError(t, err)
+	assert.Equal(t, 10, config.PageSize)
+	assert.Empty(t, config.Token)
+
+	token, err := codec.Encode(CursorToken[int64]{Cursor: 42, Direction: CursorBackward, PageSize: 25})
+	require.NoError(t, err)
+
+	config, err = ParseCursorConfig(httptest.NewRequest("GET", "/feed?cursor="+token, nil), codec)
+	require.NoError(t, err)
+	assert.Equal(t, token, config.Token)
+	assert.Equal(t, 0, config.PageSize, "the token supplies its page size")
+
+	_, err = ParseCursorConfig(httptest.NewRequest("GET", "/feed?cursor=forged", nil), codec)
+	assert.True(t, errors.Is(err, ErrInvalidCursor))
+
+	_, err = ParseCursorConfig(httptest.NewRequest("GET", "/feed?cursor="+token, nil), codec, WithMaxPageSize(20))
+	assert.True(t, errors.Is(err, ErrInvalidPageSize))
+}
+
+func TestWriteOffsetPage(t *testing.T) {
+	provider := NewSliceProvider(numberedItems(45))
+	r := httptest.NewRequest("GET", "/items?page=2&page_size=20&sort=name", nil)
+
+	config, err := ParseOffsetConfig(r)
+	require.NoError(t, err)
+	page, err := PaginateOffset(context.Background(), provider, config)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	require.NoError(t, WriteOffsetPage(w, r, page))
+
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(t,
+		`</items?page=1&page_size=20&sort=name>; rel="first", `+
+			`</items?page=1&page_size=20&sort=name>; rel="prev", `+
+			`</items?page=3&page_size=20&sort=name>; rel="next", `+
+			`</items?page=3&page_size=20&sort=name>; rel="last"`,
+		w.Header().Get("Link"))
+
+	var body PageEnvelope[TestItem]
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Len(t, body.Data, 20)
+	assert.Equal(t, int64(21), body.Data[0].ID)
+	assert.Equal(t, int64(45), *body.Pagination.TotalCount)
+	assert.Equal(t, 3, body.Pagination.TotalPages)
+	assert.True(t, body.Pagination.HasNext)
+}
+
+func TestWriteOffsetPage_EmptyWithoutTotal(t *testing.T) {
+	r := httptest.NewRequest("GET", "/items", nil)
+	w := httptest.NewRecorder()
+	require.NoError(t, WriteOffsetPage(w, r, OffsetPage[TestItem]{Page: 1, PageSize: 20}))
+
+	assert.Equal(t, `</items?page=1&page_size=20>; rel="first"`, w.Header().Get("Link"))
+	assert.JSONEq(t,
+		`{"data":[],"pagination":{"page":1,"page_size":20,"count":0,"has_next":false,"has_prev":false}}`,
+		w.Body.String())
+}
+
+func TestWriteCursorPage(t *testing.T) {
+	codec := NewCursorCodec[int64]()
+	provider := NewSliceCursorProvider(numberedItems(10), func(item TestItem) int64 { return item.ID })
+
+	first := httptest.NewRequest("GET", "/feed?page_size=4", nil)
+	config, err := ParseCursorConfig(first, codec)
+	require.NoError(t, err)
+	result, err := PaginateCursor(context.Background(), provider, config, func(item TestItem) int64 { return item.ID })
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	require.NoError(t, WriteCursorPage(w, first, result))
+
+	var body PageEnvelope[TestItem]
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, []int64{1, 2, 3, 4}, itemIDs(body.Data))
+	assert.NotEmpty(t, body.Pagination.NextCursor)
+	assert.Empty(t, body.Pagination.PrevCursor)
+	assert.Equal(t,
+		`</feed?page_size=4>; rel="first", </feed?cursor=`+body.Pagination.NextCursor+`&page_size=4>; rel="next"`,
+		w.Header().Get("Link"))
+
+	// Follow the next link
+	second := httptest.NewRequest("GET", "/feed?cursor="+body.Pagination.NextCursor, nil)
+	config, err = ParseCursorConfig(second, codec)
+	require.NoError(t, err)
+	result, err = PaginateCursor(context.Background(), provider, config, func(item TestItem) int64 { return item.ID })
+	require.NoError(t, err)
+	assert.Equal(t, []int64{5, 6, 7, 8}, itemIDs(result.Data))
+	assert.NotEmpty(t, result.PrevToken)
+}
@@ -17,6 +17,13 @@ type CountProvider interface {
 	GetTotalCount(ctx context.Context) (int64, error)
 }
 
+// EstimatedCountProvider provides a cheap approximate total count
+// e.g. pg_class.reltuples in PostgreSQL or collection stats in MongoDB
+type EstimatedCountProvider interface {
+	// GetEstimatedCount returns an approximate number of items
+	GetEstimatedCount(ctx context.Context) (int64, error)
+}
+
 // CursorDataProvider provides data for cursor-based pagination
 type CursorDataProvider[T any, C comparable] interface {
 	// GetDataAfter retrieves items after the given cursor
@@ -48,6 +55,8 @@ type FullCursorProvider[T any, C comparable] interface {
 }
 
 // OffsetResultWithoutTotal represents offset pagination result when total count is unknown
+//
+// Deprecated: PaginateOffset returns OffsetPage, whose TotalCount is nil when unknown.
 type OffsetResultWithoutTotal[T any] struct {
 	// Data contains the paginated items
 	Data []T
@@ -0,0 +1,288 @@
+package pagit
+
+import (
+	"context"
+	"errors"
+)
+
+// Progress reports how far a PageIterator has walked
+type Progress struct {
+	// Pages is the number of pages delivered so far
+	Pages int
+
+	// Items is the number of items delivered so far
+	Items int64
+
+	// Total is the total number of items if known, -1 otherwise
+	Total int64
+}
+
+// Fraction returns the completed share between 0 and 1, or -1 if the total is unknown
+func (p Progress) Fraction() float64 {
+	if p.Total < 0 {
+		return -1
+	}
+	if p.Total == 0 {
+		return 1
+	}
+	return float64(p.Items) / float64(p.Total)
+}
+
+// IteratorConfig holds configuration for page iterators
+type IteratorConfig struct {
+	// PageSize is the number of items fetched per page
+	PageSize int
+
+	// Prefetch fetches the next page while the current one is being processed
+	Prefetch bool
+
+	// Total is the known total number of items, -1 when unknown
+	// Offset iterators fill it from CountProvider when available
+	Total int64
+
+	// OnProgress is called after each page is delivered
+	OnProgress func(Progress)
+}
+
+// IteratorOption configures page iterators
+type IteratorOption func(*IteratorConfig)
+
+// WithIteratorPageSize sets the number of items fetched per page
+func WithIteratorPageSize(pageSize int) IteratorOption {
+	return func(config *IteratorConfig) {
+		config.PageSize = pageSize
+	}
+}
+
+// WithPrefetch fetches the next page concurrently while the current page is processed
+func WithPrefetch() IteratorOption {
+	return func(config *IteratorConfig) {
+		config.Prefetch = true
+	}
+}
+
+// WithIteratorTotal sets the expected total for progress reporting
+func WithIteratorTotal(total int64) IteratorOption {
+	return func(config *IteratorConfig) {
+		config.Total = total
+	}
+}
+
+// WithProgress registers a callback invoked after each page is delivered
+func WithProgress(fn func(Progress)) IteratorOption {
+	return func(config *IteratorConfig) {
+		config.OnProgress = fn
+	}
+}
+
+// pageFetcher returns the next page and whether more pages may follow
+type pageFetcher[T any] func(ctx context.Context) (page []T, more bool, err error)
+
+// PageIterator walks every page of a provider lazily
+// Each walk starts from the beginning, so an iterator can be reused
+//
+// Example usage:
+//
+//	it := NewCursorIterator(provider, extractor,
+//	    WithIteratorPageSize(500), WithPrefetch(),
+//	    WithProgress(func(p Progress) { log.Printf("rewarded %d players", p.Items) }))
+//
+//	err := it.ForEach(ctx, func(player Player) error {
+//	    return grantSeasonReward(ctx, player)
+//	})
+type PageIterator[T any] struct {
+	config IteratorConfig
+	start  func(ctx context.Context) (pageFetcher[T], int64, error)
+}
+
+// NewOffsetIterator creates an iterator over an offset-based DataProvider
+// If the provider also implements CountProvider, the total is used for progress
+func NewOffsetIterator[T any](provider DataProvider[T], options ...IteratorOption) *PageIterator[T] {
+	config := newIteratorConfig(DefaultOffsetPageSize, options)
+
+	return &PageIterator[T]{
+		config: config,
+		start: func(ctx context.Context) (pageFetcher[T], int64, error) {
+			total := config.Total
+			if countProvider, ok := provider.(CountProvider); ok && total < 0 {
+				count, err := countProvider.GetTotalCount(ctx)
+				if err != nil && !errors.Is(err, ErrTotalCountUnavailable) {
+					return nil, -1, err
+				}
+				if err == nil && count >= 0 {
+					total = count
+				}
+			}
+
+			offset := 0
+			fetch := func(ctx context.Context) ([]T, bool, error) {
+				page, err := provider.GetData(ctx, offset, config.PageSize)
+				if err != nil {
+					return nil, false, err
+				}
+				offset += len(page)
+
+				more := len(page) == config.PageSize
+				if total >= 0 && int64(offset) >= total {
+					more = false
+				}
+				return page, more, nil
+			}
+			return fetch, total, nil
+		},
+	}
+}
+
+// NewCursorIterator creates an iterator over a CursorDataProvider, walking forward
+func NewCursorIterator[T any, C comparable](
+	provider CursorDataProvider[T, C],
+	extractor CursorExtractor[T, C],
+	options ...IteratorOption,
+) *PageIterator[T] {
+	config := newIteratorConfig(DefaultCursorPageSize, options)
+
+	return &PageIterator[T]{
+		config: config,
+		start: func(ctx context.Context) (pageFetcher[T], int64, error) {
+			var cursor *C
+			fetch := func(ctx context.Context) ([]T, bool, error) {
+				result, err := PaginateCursor(ctx, provider, CursorConfig[C]{
+					PageSize: config.PageSize,
+					Cursor:   cursor,
+				}, extractor)
+				if err != nil {
+					return nil, false, err
+				}
+				cursor = result.NextCursor
+				return result.Data, result.HasNext, nil
+			}
+			return fetch, config.Total, nil
+		},
+	}
+}
+
+// ForEachPage calls fn for every page in order
+// Returns the first error from the provider or fn, or ctx.Err() on cancellation
+func (it *PageIterator[T]) ForEachPage(ctx context.Context, fn func(page []T) error) error {
+	var fnErr error
+	err := it.run(ctx, func(page []T) bool {
+		fnErr = fn(page)
+		return fnErr == nil
+	})
+	if fnErr != nil {
+		return fnErr
+	}
+	return err
+}
+
+// ForEach calls fn for every item in order
+// Returns the first error from the provider or fn, or ctx.Err() on cancellation
+func (it *PageIterator[T]) ForEach(ctx context.Context, fn func(item T) error) error {
+	return it.ForEachPage(ctx, func(page []T) error {
+		for _, item := range page {
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Collect reads every item into a slice
+// Intended for tests and small datasets
+func (it *PageIterator[T]) Collect(ctx context.Context) ([]T, error) {
+	var items []T
+	err := it.ForEachPage(ctx, func(page []T) error {
+		items = append(items, page...)
+		return nil
+	})
+	return items, err
+}
+
+// fetchResult carries a page fetched in the background
+type fetchResult[T any] struct {
+	page []T
+	more bool
+	err  error
+}
+
+// run walks all pages, calling yield for each non-empty one until yield returns false
+func (it *PageIterator[T]) run(ctx context.Context, yield func(page []T) bool) error {
+	if it.config.PageSize <= 0 || it.config.PageSize > MaxPageSize {
+		return ErrInvalidPageSize
+	}
+
+	fetch, total, err := it.start(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Only one fetch is ever in flight, so fetcher state needs no locking
+	fetchAsync := func() <-chan fetchResult[T] {
+		ch := make(chan fetchResult[T], 1)
+		go func() {
+			page, more, err := fetch(ctx)
+			ch <- fetchResult[T]{page: page, more: more, err: err}
+		}()
+		return ch
+	}
+
+	var pending <-chan fetchResult[T]
+	if it.config.Prefetch {
+		pending = fetchAsync()
+	}
+
+	progress := Progress{Total: total}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var next fetchResult[T]
+		if pending != nil {
+			select {
+			case next = <-pending:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			pending = nil
+		} else {
+			next.page, next.more, next.err = fetch(ctx)
+		}
+
+		if next.err != nil {
+			return next.err
+		}
+
+		if next.more && it.config.Prefetch {
+			pending = fetchAsync()
+		}
+
+		if len(next.page) > 0 {
+			if !yield(next.page) {
+				return nil
+			}
+
+			progress.Pages++
+			progress.Items += int64(len(next.page))
+			if it.config.OnProgress != nil {
+				it.config.OnProgress(progress)
+			}
+		}
+
+		if !next.more {
+			return nil
+		}
+	}
+}
+
+func newIteratorConfig(defaultPageSize int, options []IteratorOption) IteratorConfig {
+	config := IteratorConfig{
+		PageSize: defaultPageSize,
+		Total:    -1,
+	}
+	for _, option := range options {
+		option(&config)
+	}
+	return config
+}
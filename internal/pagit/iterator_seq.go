@@ -0,0 +1,65 @@
+//go:build go1.23
+
+package pagit
+
+import (
+	"context"
+	"iter"
+)
+
+// Pages returns a range-over-func sequence of pages
+// A provider error or cancellation is yielded once as the final element
+//
+// Example usage:
+//
+//	for page, err := range it.Pages(ctx) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    bulkGrant(page)
+//	}
+func (it *PageIterator[T]) Pages(ctx context.Context) iter.Seq2[[]T, error] {
+	return func(yield func([]T, error) bool) {
+		stopped := false
+		err := it.run(ctx, func(page []T) bool {
+			if !yield(page, nil) {
+				stopped = true
+				return false
+			}
+			return true
+		})
+		if err != nil && !stopped {
+			yield(nil, err)
+		}
+	}
+}
+
+// All returns a range-over-func sequence of items
+// A provider error or cancellation is yielded once as the final element
+//
+// Example usage:
+//
+//	for player, err := range it.All(ctx) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    grantSeasonReward(ctx, player)
+//	}
+func (it *PageIterator[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		stopped := false
+		err := it.run(ctx, func(page []T) bool {
+			for _, item := range page {
+				if !yield(item, nil) {
+					stopped = true
+					return false
+				}
+			}
+			return true
+		})
+		if err != nil && !stopped {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+//go:build go1.23
+
+package pagit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageIterator_All(t *testing.T) {
+	it := NewOffsetIterator[TestItem](NewSliceProvider(numberedItems(12)), WithIteratorPageSize(5), WithPrefetch())
+
+	var ids []int64
+	for item, err := range it.All(context.Background()) {
+		require.NoError(t, err)
+		ids = append(ids, item.ID)
+		if item.ID == 8 {
+			break
+		}
+	}
+	assert.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7, 8}, ids)
+}
+
+func TestPageIterator_PagesYieldsError(t *testing.T) {
+	provider := &blockingProvider{SliceProvider: NewSliceProvider(numberedItems(30)), failAt: 10}
+	it := NewOffsetIterator[TestItem](provider, WithIteratorPageSize(10))
+
+	var pages int
+	var lastErr error
+	for page, err := range it.Pages(context.Background()) {
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		pages++
+		assert.Len(t, page, 10)
+	}
+
+	assert.Equal(t, 1, pages)
+	assert.EqualError(t, lastErr, "storage unavailable")
+}
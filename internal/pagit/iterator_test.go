@@ -0,0 +1,141 @@
+package pagit
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func numberedItems(n int) []TestItem {
+	items := make([]TestItem, n)
+	for i := range items {
+		items[i] = TestItem{ID: int64(i + 1)}
+	}
+	return items
+}
+
+// blockingProvider records concurrent fetches and can fail or block on demand
+type blockingProvider struct {
+	*SliceProvider[TestItem]
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+	failAt      int
+	delay       time.Duration
+}
+
+func (p *blockingProvider) GetData(ctx context.Context, offset, limit int) ([]TestItem, error) {
+	current := p.inFlight.Add(1)
+	defer p.inFlight.Add(-1)
+	for {
+		max := p.maxInFlight.Load()
+		if current <= max || p.maxInFlight.CompareAndSwap(max, current) {
+			break
+		}
+	}
+
+	if p.delay > 0 {
+		select {
+		case <-time.After(p.delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if p.failAt > 0 && offset >= p.failAt {
+		return nil, errors.New("storage unavailable")
+	}
+	return p.SliceProvider.GetData(ctx, offset, limit)
+}
+
+func TestOffsetIterator_ForEachWithProgress(t *testing.T) {
+	provider := NewSliceProvider(numberedItems(25))
+
+	var reports []Progress
+	it := NewOffsetIterator[TestItem](provider,
+		WithIteratorPageSize(10),
+		WithProgress(func(p Progress) { reports = append(reports, p) }))
+
+	var ids []int64
+	err := it.ForEach(context.Background(), func(item TestItem) error {
+		ids = append(ids, item.ID)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, ids, 25)
+	assert.Equal(t, int64(25), ids[24])
+	require.Len(t, reports, 3)
+	assert.Equal(t, Progress{Pages: 3, Items: 25, Total: 25}, reports[2])
+	assert.Equal(t, 0.4, reports[0].Fraction())
+}
+
+func TestCursorIterator_Collect(t *testing.T) {
+	data := numberedItems(7)
+	extractor := func(item TestItem) int64 { return item.ID }
+	it := NewCursorIterator[TestItem](NewSliceCursorProvider(data, extractor), extractor, WithIteratorPageSize(3))
+
+	items, err := it.Collect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, data, items)
+
+	// Iterators restart from the beginning on every walk
+	again, err := it.Collect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, data, again)
+}
+
+func TestPageIterator_Prefetch(t *testing.T) {
+	provider := &blockingProvider{SliceProvider: NewSliceProvider(numberedItems(50)), delay: time.Millisecond}
+	it := NewOffsetIterator[TestItem](provider, WithIteratorPageSize(10), WithPrefetch())
+
+	var pages int
+	err := it.ForEachPage(context.Background(), func(page []TestItem) error {
+		pages++
+		time.Sleep(2 * time.Millisecond) // slow consumer lets the prefetch run
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, pages)
+	assert.Equal(t, int32(1), provider.maxInFlight.Load(), "at most one fetch is in flight")
+}
+
+func TestPageIterator_Errors(t *testing.T) {
+	t.Run("provider error", func(t *testing.T) {
+		provider := &blockingProvider{SliceProvider: NewSliceProvider(numberedItems(30)), failAt: 20}
+		it := NewOffsetIterator[TestItem](provider, WithIteratorPageSize(10), WithPrefetch())
+
+		var seen int
+		err := it.ForEach(context.Background(), func(TestItem) error { seen++; return nil })
+		assert.EqualError(t, err, "storage unavailable")
+		assert.Equal(t, 20, seen)
+	})
+
+	t.Run("callback error stops the walk", func(t *testing.T) {
+		it := NewOffsetIterator[TestItem](NewSliceProvider(numberedItems(30)), WithIteratorPageSize(10))
+		stop := errors.New("stop")
+
+		var pages int
+		err := it.ForEachPage(context.Background(), func([]TestItem) error { pages++; return stop })
+		assert.ErrorIs(t, err, stop)
+		assert.Equal(t, 1, pages)
+	})
+
+	t.Run("cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		it := NewOffsetIterator[TestItem](NewSliceProvider(numberedItems(30)), WithIteratorPageSize(10))
+
+		err := it.ForEachPage(ctx, func([]TestItem) error { cancel(); return nil })
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("invalid page size", func(t *testing.T) {
+		it := NewOffsetIterator[TestItem](NewSliceProvider(numberedItems(3)), WithIteratorPageSize(0))
+		_, err := it.Collect(context.Background())
+		assert.ErrorIs(t, err, ErrInvalidPageSize)
+	})
+}
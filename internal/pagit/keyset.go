@@ -0,0 +1,162 @@
+package pagit
+
+import (
+	"cmp"
+	"errors"
+	"time"
+)
+
+// ErrEmptyKeyset is returned when a keyset has no fields to order by
+var ErrEmptyKeyset = errors.New("keyset must have at least one field")
+
+// SortDirection specifies the sort direction of a keyset field
+type SortDirection int
+
+const (
+	// SortAsc orders a field from smallest to largest
+	SortAsc SortDirection = iota
+	// SortDesc orders a field from largest to smallest
+	SortDesc
+)
+
+// String returns the SQL keyword for the direction
+func (d SortDirection) String() string {
+	if d == SortDesc {
+		return "DESC"
+	}
+	return "ASC"
+}
+
+// Operator returns the comparison selecting values after a cursor in this direction
+// ">" for ascending fields and "<" for descending fields
+func (d SortDirection) Operator() string {
+	if d == SortDesc {
+		return "<"
+	}
+	return ">"
+}
+
+// KeyField describes one column of a composite (multi-column) cursor
+type KeyField[C any] struct {
+	// Column is the column name used by database providers
+	Column string
+
+	// Direction is the sort direction of this column
+	Direction SortDirection
+
+	// Compare compares this field of two cursors in ascending order
+	// Returns a negative number, zero or a positive number
+	Compare func(a, b C) int
+
+	// Value returns this field of a cursor, used as a query argument
+	Value func(c C) any
+}
+
+// OrderedKey creates a KeyField for a field with an ordered type (numbers, strings)
+//
+// Example usage:
+//
+//	type RankCursor struct {
+//	    Score     int64
+//	    UpdatedAt time.Time
+//	    ID        string
+//	}
+//
+//	keyset := Keyset[RankCursor]{
+//	    OrderedKey("score", SortDesc, func(c RankCursor) int64 { return c.Score }),
+//	    TimeKey("updated_at", SortAsc, func(c RankCursor) time.Time { return c.UpdatedAt }),
+//	    OrderedKey("id", SortAsc, func(c RankCursor) string { return c.ID }),
+//	}
+func OrderedKey[C any, V cmp.Ordered](column string, direction SortDirection, get func(C) V) KeyField[C] {
+	return KeyField[C]{
+		Column:    column,
+		Direction: direction,
+		Compare:   func(a, b C) int { return cmp.Compare(get(a), get(b)) },
+		Value:     func(c C) any { return get(c) },
+	}
+}
+
+// TimeKey creates a KeyField for a time.Time field
+func TimeKey[C any](column string, direction SortDirection, get func(C) time.Time) KeyField[C] {
+	return KeyField[C]{
+		Column:    column,
+		Direction: direction,
+		Compare:   func(a, b C) int { return get(a).Compare(get(b)) },
+		Value:     func(c C) any { return get(c) },
+	}
+}
+
+// Keyset is an ordered list of fields forming a composite cursor
+// The last field should be unique (e.g. the primary key) so that rows with
+// equal leading fields are never skipped or returned twice
+type Keyset[C any] []KeyField[C]
+
+// NewKeyset creates a keyset from fields in order of significance
+// Returns ErrEmptyKeyset when no field is given
+func NewKeyset[C any](fields ...KeyField[C]) (Keyset[C], error) {
+	keyset := Keyset[C](fields)
+	if err := keyset.Validate(); err != nil {
+		return nil, err
+	}
+	return keyset, nil
+}
+
+// Validate reports ErrEmptyKeyset for a keyset without fields
+// Providers call it at construction so queries never run without an order
+func (k Keyset[C]) Validate() error {
+	if len(k) == 0 {
+		return ErrEmptyKeyset
+	}
+	return nil
+}
+
+// Uniform reports whether every field shares one direction
+// Uniform keysets can be compared as a single row value, e.g. (score, id) < (?, ?)
+func (k Keyset[C]) Uniform() bool {
+	for _, field := range k {
+		if field.Direction != k[0].Direction {
+			return false
+		}
+	}
+	return true
+}
+
+// Compare compares two cursors as a tuple in keyset order, honouring each
+// field's direction. A negative result means a sorts before b
+func (k Keyset[C]) Compare(a, b C) int {
+	for _, field := range k {
+		result := field.Compare(a, b)
+		if result == 0 {
+			continue
+		}
+		if field.Direction == SortDesc {
+			return -result
+		}
+		return result
+	}
+	return 0
+}
+
+// Reverse returns the keyset with every direction flipped
+// Used to fetch the rows preceding a cursor
+func (k Keyset[C]) Reverse() Keyset[C] {
+	reversed := make(Keyset[C], len(k))
+	for i, field := range k {
+		if field.Direction == SortDesc {
+			field.Direction = SortAsc
+		} else {
+			field.Direction = SortDesc
+		}
+		reversed[i] = field
+	}
+	return reversed
+}
+
+// Values returns the cursor's field values in keyset order
+func (k Keyset[C]) Values(cursor C) []any {
+	values := make([]any, len(k))
+	for i, field := range k {
+		values[i] = field.Value(cursor)
+	}
+	return values
+}
@@ -0,0 +1,132 @@
+package pagit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type rankEntry struct {
+	ID        string
+	Score     int64
+	UpdatedAt time.Time
+}
+
+type rankCursor struct {
+	Score     int64
+	UpdatedAt time.Time
+	ID        string
+}
+
+var rankKeyset = Keyset[rankCursor]{
+	OrderedKey("score", SortDesc, func(c rankCursor) int64 { return c.Score }),
+	TimeKey("updated_at", SortAsc, func(c rankCursor) time.Time { return c.UpdatedAt }),
+	OrderedKey("id", SortAsc, func(c rankCursor) string { return c.ID }),
+}
+
+func rankOf(e rankEntry) rankCursor {
+	return rankCursor{Score: e.Score, UpdatedAt: e.UpdatedAt, ID: e.ID}
+}
+
+func TestKeyset_Compare(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	higher := rankCursor{Score: 200, UpdatedAt: base, ID: "b"}
+	lower := rankCursor{Score: 100, UpdatedAt: base, ID: "a"}
+	earlier := rankCursor{Score: 100, UpdatedAt: base.Add(-time.Minute), ID: "z"}
+
+	assert.Negative(t, rankKeyset.Compare(higher, lower), "higher score sorts first")
+	assert.Negative(t, rankKeyset.Compare(earlier, lower), "earlier update wins a tie")
+	assert.Zero(t, rankKeyset.Compare(lower, lower))
+	assert.Positive(t, rankKeyset.Reverse().Compare(higher, lower))
+	assert.Equal(t, []any{int64(200), base, "b"}, rankKeyset.Values(higher))
+}
+
+func TestKeyset_UniformAndOperator(t *testing.T) {
+	assert.False(t, rankKeyset.Uniform(), "score DESC, updated_at ASC")
+	assert.True(t, rankKeyset[1:].Uniform())
+	assert.True(t, rankKeyset.Reverse()[1:].Uniform())
+
+	assert.Equal(t, "<", rankKeyset[0].Direction.Operator())
+	assert.Equal(t, ">", rankKeyset[1].Direction.Operator())
+}
+
+func TestKeyset_RejectsEmpty(t *testing.T) {
+	_, err := NewKeyset[rankCursor]()
+	assert.ErrorIs(t, err, ErrEmptyKeyset)
+
+	keyset, err := NewKeyset(rankKeyset...)
+	require.NoError(t, err)
+	assert.Len(t, keyset, 3)
+
+	_, err = NewSliceKeysetProvider([]rankEntry{{ID: "a"}}, rankOf, Keyset[rankCursor]{})
+	assert.ErrorIs(t, err, ErrEmptyKeyset)
+}
+
+func TestSliceKeysetProvider_TiesAreNeverSkipped(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	entries := []rankEntry{
+		{ID: "e", Score: 100, UpdatedAt: base},
+		{ID: "a", Score: 300, UpdatedAt: base},
+		{ID: "d", Score: 100, UpdatedAt: base},
+		{ID: "c", Score: 100, UpdatedAt: base.Add(-time.Hour)},
+		{ID: "b", Score: 200, UpdatedAt: base},
+		{ID: "f", Score: 100, UpdatedAt: base.Add(time.Hour)},
+	}
+
+	provider, err := NewSliceKeysetProvider(entries, rankOf, rankKeyset)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	var seen []string
+	config := CursorConfig[rankCursor]{PageSize: 2}
+	for {
+		result, err := PaginateCursor(ctx, provider, config, rankOf)
+		require.NoError(t, err)
+		for _, e := range result.Data {
+			seen = append(seen, e.ID)
+		}
+		if !result.HasNext {
+			break
+		}
+		config.Cursor = result.NextCursor
+	}
+
+	assert.Equal(t, []string{"a", "b", "c", "d", "e", "f"}, seen)
+	assert.Equal(t, "e", entries[0].ID, "input slice is not reordered")
+}
+
+func TestSliceKeysetProvider_RemovedCursorRow(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	entries := []rankEntry{
+		{ID: "a", Score: 300, UpdatedAt: base},
+		{ID: "c", Score: 100, UpdatedAt: base},
+	}
+	provider, err := NewSliceKeysetProvider(entries, rankOf, rankKeyset)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// Cursor of a row that no longer exists still positions correctly
+	gone := rankCursor{Score: 200, UpdatedAt: base, ID: "b"}
+
+	after, err := provider.GetDataAfter(ctx, &gone, 10)
+	require.NoError(t, err)
+	require.Len(t, after, 1)
+	assert.Equal(t, "c", after[0].ID)
+
+	before, err := provider.GetDataBefore(ctx, &gone, 10)
+	require.NoError(t, err)
+	require.Len(t, before, 1)
+	assert.Equal(t, "a", before[0].ID)
+
+	hasAfter, err := provider.HasDataAfter(ctx, rankOf(entries[1]))
+	require.NoError(t, err)
+	assert.False(t, hasAfter)
+
+	hasBefore, err := provider.HasDataBefore(ctx, gone)
+	require.NoError(t, err)
+	assert.True(t, hasBefore)
+}
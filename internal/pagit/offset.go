@@ -7,6 +7,9 @@ import (
 
 var (
 	ErrInvalidPage = errors.New("page must be greater than 0")
+
+	// ErrOffsetTooLarge is returned when a page starts beyond OffsetConfig.MaxOffset
+	ErrOffsetTooLarge = errors.New("offset exceeds the maximum allowed offset")
 )
 
 // PaginateOffset performs offset-based pagination using a data provider
@@ -22,57 +25,79 @@ var (
 //   - provider: Data provider that fetches paginated data
 //   - config: Configuration for offset pagination
 //
-// Returns:
-//   - OffsetResult if total count is available
-//   - OffsetResultWithoutTotal if total count is unavailable
+// config.Count selects how the total is obtained (see CountStrategy); by default
+// CountProvider is asked on every call. When the exact count is unavailable an
+// EstimatedCountProvider is used instead. The page reports the strategy that
+// produced the total in CountSource and flags approximate totals TotalEstimated.
+// HasNext is always exact: one extra item is fetched to detect a next page.
 //
 // Example usage:
 //
 //	// With slice data (in-memory)
 //	sliceProvider := NewSliceProvider(products)
-//	result := PaginateOffset(ctx, sliceProvider, OffsetConfig{Page: 1, PageSize: 10})
+//	page, err := PaginateOffset(ctx, sliceProvider, OffsetConfig{Page: 1, PageSize: 10})
+//	if page.TotalCount != nil {
+//	    fmt.Printf("page %d of %d\n", page.Page, page.TotalPages)
+//	}
 //
-//	// With database provider
+//	// Huge table: estimated total, and no scans past 10,000 rows
 //	dbProvider := &UserDBProvider{db: myDB}
-//	result := PaginateOffset(ctx, dbProvider, OffsetConfig{Page: 1, PageSize: 10})
+//	page, err = PaginateOffset(ctx, dbProvider, OffsetConfig{
+//	    Page: 3, PageSize: 50, Count: CountEstimated, MaxOffset: 10_000,
+//	})
 func PaginateOffset[T any](
 	ctx context.Context,
 	provider DataProvider[T],
 	config OffsetConfig,
-) (any, error) {
+) (OffsetPage[T], error) {
 	// Validate input
 	if config.Page <= 0 {
-		return nil, ErrInvalidPage
+		return OffsetPage[T]{}, ErrInvalidPage
 	}
 
 	if config.PageSize <= 0 || config.PageSize > MaxPageSize {
-		return nil, ErrInvalidPageSize
+		return OffsetPage[T]{}, ErrInvalidPageSize
 	}
 
 	// Calculate offset
 	offset := (config.Page - 1) * config.PageSize
+	if config.MaxOffset > 0 && offset > config.MaxOffset {
+		return OffsetPage[T]{}, ErrOffsetTooLarge
+	}
 
-	// Get page data
-	data, err := provider.GetData(ctx, offset, config.PageSize)
+	// Fetch one extra item to know whether a next page exists
+	data, err := provider.GetData(ctx, offset, config.PageSize+1)
 	if err != nil {
-		return nil, err
+		return OffsetPage[T]{}, err
 	}
 
-	// Try to get total count if provider supports it
-	if countProvider, ok := provider.(CountProvider); ok {
-		totalCount, err := countProvider.GetTotalCount(ctx)
-		if err != nil && !errors.Is(err, ErrTotalCountUnavailable) {
-			return nil, err
-		}
+	hasMore := len(data) > config.PageSize
+	if hasMore {
+		data = data[:config.PageSize]
+	}
 
-		// If total count is available, return full result
-		if err == nil && totalCount >= 0 {
-			return buildOffsetResult(data, totalCount, config, offset), nil
-		}
+	page := OffsetPage[T]{
+		Data:     data,
+		Page:     config.Page,
+		PageSize: config.PageSize,
+		HasNext:  hasMore,
+		HasPrev:  config.Page > 1,
+		Offset:   offset,
+		Count:    len(data),
+	}
+
+	total, source, err := resolveTotalCount(ctx, provider, config)
+	if err != nil {
+		return OffsetPage[T]{}, err
+	}
+	page.CountSource = source
+	if total >= 0 {
+		page.TotalCount = &total
+		page.TotalEstimated = source == CountEstimated || source == CountCached
+		page.TotalPages = calculateTotalPages(total, config.PageSize)
 	}
 
-	// Return result without total count
-	return buildOffsetResultWithoutTotal(data, config, offset), nil
+	return page, nil
 }
 
 // OffsetFromPageSize creates an OffsetConfig with default page 1
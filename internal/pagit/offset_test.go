@@ -2,6 +2,7 @@ package pagit
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -28,21 +29,20 @@ func TestPaginateOffset_BasicPagination(t *testing.T) {
 
 	require.NoError(t, err)
 	
-	// Should return OffsetResult since SliceProvider supports counting
-	offsetResult, ok := result.(OffsetResult[TestItem])
-	require.True(t, ok, "Should return OffsetResult")
+	// SliceProvider supports counting, so the total is exact
+	require.NotNil(t, result.TotalCount)
 	
-	assert.Len(t, offsetResult.Data, 2)
-	assert.Equal(t, int64(1), offsetResult.Data[0].ID)
-	assert.Equal(t, int64(2), offsetResult.Data[1].ID)
-	assert.Equal(t, int64(5), offsetResult.TotalCount)
-	assert.Equal(t, 1, offsetResult.Page)
-	assert.Equal(t, 2, offsetResult.PageSize)
-	assert.Equal(t, 3, offsetResult.TotalPages)
-	assert.True(t, offsetResult.HasNext)
-	assert.False(t, offsetResult.HasPrev)
-	assert.Equal(t, 0, offsetResult.Offset)
-	assert.Equal(t, 2, offsetResult.Count)
+	assert.Len(t, result.Data, 2)
+	assert.Equal(t, int64(1), result.Data[0].ID)
+	assert.Equal(t, int64(2), result.Data[1].ID)
+	assert.Equal(t, int64(5), *result.TotalCount)
+	assert.Equal(t, 1, result.Page)
+	assert.Equal(t, 2, result.PageSize)
+	assert.Equal(t, 3, result.TotalPages)
+	assert.True(t, result.HasNext)
+	assert.False(t, result.HasPrev)
+	assert.Equal(t, 0, result.Offset)
+	assert.Equal(t, 2, result.Count)
 }
 
 func TestPaginateOffset_SecondPage(t *testing.T) {
@@ -65,18 +65,17 @@ func TestPaginateOffset_SecondPage(t *testing.T) {
 
 	require.NoError(t, err)
 	
-	offsetResult, ok := result.(OffsetResult[TestItem])
-	require.True(t, ok)
+	require.NotNil(t, result.TotalCount)
 	
-	assert.Len(t, offsetResult.Data, 2)
-	assert.Equal(t, int64(3), offsetResult.Data[0].ID)
-	assert.Equal(t, int64(4), offsetResult.Data[1].ID)
-	assert.Equal(t, int64(5), offsetResult.TotalCount)
-	assert.Equal(t, 2, offsetResult.Page)
-	assert.Equal(t, 3, offsetResult.TotalPages)
-	assert.True(t, offsetResult.HasNext)
-	assert.True(t, offsetResult.HasPrev)
-	assert.Equal(t, 2, offsetResult.Offset)
+	assert.Len(t, result.Data, 2)
+	assert.Equal(t, int64(3), result.Data[0].ID)
+	assert.Equal(t, int64(4), result.Data[1].ID)
+	assert.Equal(t, int64(5), *result.TotalCount)
+	assert.Equal(t, 2, result.Page)
+	assert.Equal(t, 3, result.TotalPages)
+	assert.True(t, result.HasNext)
+	assert.True(t, result.HasPrev)
+	assert.Equal(t, 2, result.Offset)
 }
 
 func TestPaginateOffset_LastPage(t *testing.T) {
@@ -99,18 +98,17 @@ func TestPaginateOffset_LastPage(t *testing.T) {
 
 	require.NoError(t, err)
 	
-	offsetResult, ok := result.(OffsetResult[TestItem])
-	require.True(t, ok)
+	require.NotNil(t, result.TotalCount)
 	
-	assert.Len(t, offsetResult.Data, 1)
-	assert.Equal(t, int64(5), offsetResult.Data[0].ID)
-	assert.Equal(t, int64(5), offsetResult.TotalCount)
-	assert.Equal(t, 3, offsetResult.Page)
-	assert.Equal(t, 3, offsetResult.TotalPages)
-	assert.False(t, offsetResult.HasNext)
-	assert.True(t, offsetResult.HasPrev)
-	assert.Equal(t, 4, offsetResult.Offset)
-	assert.Equal(t, 1, offsetResult.Count)
+	assert.Len(t, result.Data, 1)
+	assert.Equal(t, int64(5), result.Data[0].ID)
+	assert.Equal(t, int64(5), *result.TotalCount)
+	assert.Equal(t, 3, result.Page)
+	assert.Equal(t, 3, result.TotalPages)
+	assert.False(t, result.HasNext)
+	assert.True(t, result.HasPrev)
+	assert.Equal(t, 4, result.Offset)
+	assert.Equal(t, 1, result.Count)
 }
 
 func TestPaginateOffset_EmptyData(t *testing.T) {
@@ -126,16 +124,15 @@ func TestPaginateOffset_EmptyData(t *testing.T) {
 
 	require.NoError(t, err)
 	
-	offsetResult, ok := result.(OffsetResult[TestItem])
-	require.True(t, ok)
+	require.NotNil(t, result.TotalCount)
 	
-	assert.Empty(t, offsetResult.Data)
-	assert.Equal(t, int64(0), offsetResult.TotalCount)
-	assert.Equal(t, 1, offsetResult.Page)
-	assert.Equal(t, 0, offsetResult.TotalPages)
-	assert.False(t, offsetResult.HasNext)
-	assert.False(t, offsetResult.HasPrev)
-	assert.Equal(t, 0, offsetResult.Count)
+	assert.Empty(t, result.Data)
+	assert.Equal(t, int64(0), *result.TotalCount)
+	assert.Equal(t, 1, result.Page)
+	assert.Equal(t, 0, result.TotalPages)
+	assert.False(t, result.HasNext)
+	assert.False(t, result.HasPrev)
+	assert.Equal(t, 0, result.Count)
 }
 
 func TestPaginateOffset_InvalidPage(t *testing.T) {
@@ -177,15 +174,95 @@ func TestPaginateOffset_BeyondData(t *testing.T) {
 
 	require.NoError(t, err)
 	
-	offsetResult, ok := result.(OffsetResult[TestItem])
-	require.True(t, ok)
+	require.NotNil(t, result.TotalCount)
 	
-	assert.Empty(t, offsetResult.Data)
-	assert.Equal(t, int64(1), offsetResult.TotalCount)
-	assert.Equal(t, 10, offsetResult.Page)
-	assert.Equal(t, 1, offsetResult.TotalPages)
-	assert.False(t, offsetResult.HasNext)
-	assert.True(t, offsetResult.HasPrev)
-	assert.Equal(t, 90, offsetResult.Offset)
-	assert.Equal(t, 0, offsetResult.Count)
-}
\ No newline at end of file
+	assert.Empty(t, result.Data)
+	assert.Equal(t, int64(1), *result.TotalCount)
+	assert.Equal(t, 10, result.Page)
+	assert.Equal(t, 1, result.TotalPages)
+	assert.False(t, result.HasNext)
+	assert.True(t, result.HasPrev)
+	assert.Equal(t, 90, result.Offset)
+	assert.Equal(t, 0, result.Count)
+}
+// uncountedProvider hides SliceProvider's CountProvider implementation
+type uncountedProvider struct {
+	slice *SliceProvider[TestItem]
+}
+
+func (p uncountedProvider) GetData(ctx context.Context, offset, limit int) ([]TestItem, error) {
+	return p.slice.GetData(ctx, offset, limit)
+}
+
+// estimatingProvider counts exactly and also offers a rough estimate
+type estimatingProvider struct {
+	*SliceProvider[TestItem]
+	estimate int64
+	countErr error
+}
+
+func (p estimatingProvider) GetTotalCount(ctx context.Context) (int64, error) {
+	if p.countErr != nil {
+		return 0, p.countErr
+	}
+	return p.SliceProvider.GetTotalCount(ctx)
+}
+
+func (p estimatingProvider) GetEstimatedCount(ctx context.Context) (int64, error) {
+	return p.estimate, nil
+}
+
+func TestPaginateOffset_UnknownTotal(t *testing.T) {
+	provider := uncountedProvider{NewSliceProvider(numberedItems(4))}
+
+	page, err := PaginateOffset(context.Background(), provider, OffsetConfig{Page: 1, PageSize: 2})
+	require.NoError(t, err)
+	assert.Nil(t, page.TotalCount)
+	assert.Equal(t, 0, page.TotalPages)
+	assert.True(t, page.HasNext)
+
+	// A full last page still knows there is nothing after it
+	page, err = PaginateOffset(context.Background(), provider, OffsetConfig{Page: 2, PageSize: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 4}, itemIDs(page.Data))
+	assert.False(t, page.HasNext)
+	assert.True(t, page.HasPrev)
+}
+
+func TestPaginateOffset_EstimatedCount(t *testing.T) {
+	provider := estimatingProvider{SliceProvider: NewSliceProvider(numberedItems(5)), estimate: 1000}
+
+	// Exact count is preferred by default
+	page, err := PaginateOffset(context.Background(), provider, OffsetConfig{Page: 1, PageSize: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), *page.TotalCount)
+	assert.False(t, page.TotalEstimated)
+
+	page, err = PaginateOffset(context.Background(), provider, OffsetConfig{Page: 3, PageSize: 2, Count: CountEstimated})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), *page.TotalCount)
+	assert.Equal(t, 500, page.TotalPages)
+	assert.True(t, page.TotalEstimated)
+	assert.False(t, page.HasNext, "HasNext stays exact even when the estimate is off")
+
+	// The estimate is the fallback when the exact count is unavailable
+	provider.countErr = ErrTotalCountUnavailable
+	page, err = PaginateOffset(context.Background(), provider, OffsetConfig{Page: 1, PageSize: 2})
+	require.NoError(t, err)
+	assert.True(t, page.TotalEstimated)
+
+	provider.countErr = errors.New("database down")
+	_, err = PaginateOffset(context.Background(), provider, OffsetConfig{Page: 1, PageSize: 2})
+	assert.EqualError(t, err, "database down")
+}
+
+func TestPaginateOffset_MaxOffset(t *testing.T) {
+	provider := NewSliceProvider(numberedItems(100))
+
+	page, err := PaginateOffset(context.Background(), provider, OffsetConfig{Page: 5, PageSize: 10, MaxOffset: 40})
+	require.NoError(t, err)
+	assert.Equal(t, 40, page.Offset)
+
+	_, err = PaginateOffset(context.Background(), provider, OffsetConfig{Page: 6, PageSize: 10, MaxOffset: 40})
+	assert.Equal(t, ErrOffsetTooLarge, err)
+}
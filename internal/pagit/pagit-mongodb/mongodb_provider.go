@@ -0,0 +1,265 @@
+package pagitmongodb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/homveloper/dukdakit/internal/pagit"
+)
+
+// Collection is the subset of *mongo.Collection used by the providers
+// *mongo.Collection satisfies it; tests can supply an in-memory double that
+// builds results with mongo.NewCursorFromDocuments
+type Collection interface {
+	Find(ctx context.Context, filter interface{}, opts ...*options.FindOptions) (*mongo.Cursor, error)
+	CountDocuments(ctx context.Context, filter interface{}, opts ...*options.CountOptions) (int64, error)
+}
+
+// MongoOffsetProvider provides offset-based pagination for MongoDB collections
+// Ideal for game scenarios like: item catalogs, mail boxes, admin listings
+type MongoOffsetProvider[T any] struct {
+	collection Collection
+	filter     bson.M
+	sort       bson.D
+	projection interface{}
+}
+
+// MongoOffsetConfig holds configuration for MongoDB offset provider
+type MongoOffsetConfig[T any] struct {
+	Collection Collection
+	Filter     bson.M      // Optional query filter, e.g. bson.M{"guild_id": guildID}
+	Sort       bson.D      // e.g. bson.D{{Key: "level", Value: -1}, {Key: "_id", Value: 1}}
+	Projection interface{} // Optional projection, e.g. bson.M{"inventory": 0}
+}
+
+// NewMongoOffsetProvider creates a MongoDB offset provider
+func NewMongoOffsetProvider[T any](config MongoOffsetConfig[T]) *MongoOffsetProvider[T] {
+	if len(config.Sort) == 0 {
+		config.Sort = bson.D{{Key: "_id", Value: 1}} // Default ordering
+	}
+	if config.Filter == nil {
+		config.Filter = bson.M{}
+	}
+
+	return &MongoOffsetProvider[T]{
+		collection: config.Collection,
+		filter:     config.Filter,
+		sort:       config.Sort,
+		projection: config.Projection,
+	}
+}
+
+// GetData implements pagit.DataProvider interface for MongoDB
+func (p *MongoOffsetProvider[T]) GetData(ctx context.Context, offset, limit int) ([]T, error) {
+	opts := options.Find().
+		SetSort(p.sort).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	if p.projection != nil {
+		opts.SetProjection(p.projection)
+	}
+
+	cursor, err := p.collection.Find(ctx, p.filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute find: %w", err)
+	}
+
+	return decodeAll[T](ctx, cursor)
+}
+
+// GetTotalCount implements pagit.CountProvider interface for MongoDB
+func (p *MongoOffsetProvider[T]) GetTotalCount(ctx context.Context) (int64, error) {
+	count, err := p.collection.CountDocuments(ctx, p.filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count documents: %w", err)
+	}
+	return count, nil
+}
+
+// MongoCursorProvider provides keyset (cursor) pagination for MongoDB collections
+// Ideal for game scenarios like: leaderboards, activity feeds, chat history
+//
+// Queries are range predicates on the keyset fields sorted in keyset order,
+// so a compound index on the same fields, e.g. {score: -1, _id: 1}, serves
+// every page without skipping documents
+type MongoCursorProvider[T any, C comparable] struct {
+	collection Collection
+	filter     bson.M
+	projection interface{}
+	keyset     pagit.Keyset[C]
+}
+
+// MongoCursorConfig holds configuration for MongoDB cursor provider
+type MongoCursorConfig[T any, C comparable] struct {
+	Collection Collection
+	Filter     bson.M      // Optional query filter
+	Projection interface{} // Optional projection; must keep the keyset fields
+
+	// Keyset lists the document fields forming the cursor, with their sort direction
+	// The last field should be unique, typically "_id"
+	Keyset pagit.Keyset[C]
+}
+
+// NewMongoCursorProvider creates a MongoDB cursor provider
+// Returns pagit.ErrEmptyKeyset when the config has no keyset fields
+func NewMongoCursorProvider[T any, C comparable](config MongoCursorConfig[T, C]) (*MongoCursorProvider[T, C], error) {
+	if err := config.Keyset.Validate(); err != nil {
+		return nil, err
+	}
+	if config.Filter == nil {
+		config.Filter = bson.M{}
+	}
+
+	return &MongoCursorProvider[T, C]{
+		collection: config.Collection,
+		filter:     config.Filter,
+		projection: config.Projection,
+		keyset:     config.Keyset,
+	}, nil
+}
+
+// GetDataAfter implements pagit.CursorDataProvider interface
+func (p *MongoCursorProvider[T, C]) GetDataAfter(
+	ctx context.Context,
+	cursor *C,
+	limit int,
+) ([]T, error) {
+	return p.find(ctx, cursor, p.keyset, limit)
+}
+
+// GetDataBefore implements pagit.CursorDataProvider interface
+func (p *MongoCursorProvider[T, C]) GetDataBefore(
+	ctx context.Context,
+	cursor *C,
+	limit int,
+) ([]T, error) {
+	// Walk the reversed order from the cursor, then restore the forward order
+	items, err := p.find(ctx, cursor, p.keyset.Reverse(), limit)
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(items)
+	return items, nil
+}
+
+// HasDataAfter implements pagit.CursorCheckProvider interface
+func (p *MongoCursorProvider[T, C]) HasDataAfter(
+	ctx context.Context,
+	cursor C,
+) (bool, error) {
+	return p.exists(ctx, cursor, p.keyset)
+}
+
+// HasDataBefore implements pagit.CursorCheckProvider interface
+func (p *MongoCursorProvider[T, C]) HasDataBefore(
+	ctx context.Context,
+	cursor C,
+) (bool, error) {
+	return p.exists(ctx, cursor, p.keyset.Reverse())
+}
+
+// find fetches documents following cursor in keyset order
+func (p *MongoCursorProvider[T, C]) find(
+	ctx context.Context,
+	cursor *C,
+	keyset pagit.Keyset[C],
+	limit int,
+) ([]T, error) {
+	filter := p.filter
+	if cursor != nil {
+		filter = p.withCondition(keysetFilter(keyset, *cursor))
+	}
+
+	opts := options.Find().
+		SetSort(keysetSort(keyset)).
+		SetLimit(int64(limit))
+	if p.projection != nil {
+		opts.SetProjection(p.projection)
+	}
+
+	result, err := p.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute cursor find: %w", err)
+	}
+
+	return decodeAll[T](ctx, result)
+}
+
+// exists checks whether any document follows cursor in keyset order
+func (p *MongoCursorProvider[T, C]) exists(
+	ctx context.Context,
+	cursor C,
+	keyset pagit.Keyset[C],
+) (bool, error) {
+	filter := p.withCondition(keysetFilter(keyset, cursor))
+
+	count, err := p.collection.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil {
+		return false, fmt.Errorf("failed to check cursor: %w", err)
+	}
+	return count > 0, nil
+}
+
+// withCondition combines the configured filter with a cursor condition
+func (p *MongoCursorProvider[T, C]) withCondition(condition bson.M) bson.M {
+	if len(p.filter) == 0 {
+		return condition
+	}
+	return bson.M{"$and": bson.A{p.filter, condition}}
+}
+
+// keysetSort renders the keyset as a MongoDB sort document
+func keysetSort[C any](keyset pagit.Keyset[C]) bson.D {
+	sort := make(bson.D, len(keyset))
+	for i, field := range keyset {
+		direction := 1
+		if field.Direction == pagit.SortDesc {
+			direction = -1
+		}
+		sort[i] = bson.E{Key: field.Column, Value: direction}
+	}
+	return sort
+}
+
+// keysetFilter builds the range predicate selecting documents after cursor
+//
+// A single field becomes {score: {$lt: 900}}; several fields expand to
+//
+//	{$or: [{score: {$lt: 900}}, {score: 900, _id: {$gt: "p3"}}]}
+func keysetFilter[C any](keyset pagit.Keyset[C], cursor C) bson.M {
+	values := keyset.Values(cursor)
+
+	branches := make(bson.A, len(keyset))
+	for i, field := range keyset {
+		branch := bson.M{}
+		for j := 0; j < i; j++ {
+			branch[keyset[j].Column] = values[j]
+		}
+		branch[field.Column] = bson.M{mongoOperators[field.Direction.Operator()]: values[i]}
+		branches[i] = branch
+	}
+
+	if len(branches) == 1 {
+		return branches[0].(bson.M)
+	}
+	return bson.M{"$or": branches}
+}
+
+// mongoOperators maps SortDirection.Operator to MongoDB comparison operators
+var mongoOperators = map[string]string{">": "$gt", "<": "$lt"}
+
+// decodeAll decodes every document of cursor into T
+func decodeAll[T any](ctx context.Context, cursor *mongo.Cursor) ([]T, error) {
+	defer cursor.Close(ctx)
+
+	result := []T{}
+	if err := cursor.All(ctx, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode documents: %w", err)
+	}
+	return result, nil
+}
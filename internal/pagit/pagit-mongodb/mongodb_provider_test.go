@@ -0,0 +1,342 @@
+package pagitmongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/homveloper/dukdakit/internal/pagit"
+)
+
+// ============================================================================
+// In-memory collection double
+// ============================================================================
+
+// memoryCollection evaluates the subset of the query language the providers emit
+type memoryCollection struct {
+	docs    []bson.M
+	filters []bson.M // Filters received by Find, for assertions
+}
+
+func (c *memoryCollection) Find(ctx context.Context, filter interface{}, opts ...*options.FindOptions) (*mongo.Cursor, error) {
+	f := filter.(bson.M)
+	c.filters = append(c.filters, f)
+
+	var matched []bson.M
+	for _, doc := range c.docs {
+		if matches(doc, f) {
+			matched = append(matched, doc)
+		}
+	}
+
+	opt := options.MergeFindOptions(opts...)
+	if opt.Sort != nil {
+		order := opt.Sort.(bson.D)
+		sort.SliceStable(matched, func(i, j int) bool {
+			for _, e := range order {
+				cmp := compareValues(matched[i][e.Key], matched[j][e.Key])
+				if cmp == 0 {
+					continue
+				}
+				if e.Value.(int) < 0 {
+					return cmp > 0
+				}
+				return cmp < 0
+			}
+			return false
+		})
+	}
+	if opt.Skip != nil {
+		skip := int(*opt.Skip)
+		if skip > len(matched) {
+			skip = len(matched)
+		}
+		matched = matched[skip:]
+	}
+	if opt.Limit != nil && int(*opt.Limit) < len(matched) {
+		matched = matched[:*opt.Limit]
+	}
+
+	docs := make([]interface{}, len(matched))
+	for i, doc := range matched {
+		docs[i] = doc
+	}
+	return mongo.NewCursorFromDocuments(docs, nil, nil)
+}
+
+func (c *memoryCollection) CountDocuments(ctx context.Context, filter interface{}, opts ...*options.CountOptions) (int64, error) {
+	var count int64
+	for _, doc := range c.docs {
+		if matches(doc, filter.(bson.M)) {
+			count++
+		}
+	}
+	if opt := options.MergeCountOptions(opts...); opt.Limit != nil && count > *opt.Limit {
+		count = *opt.Limit
+	}
+	return count, nil
+}
+
+func matches(doc bson.M, filter bson.M) bool {
+	for key, cond := range filter {
+		switch key {
+		case "$and":
+			for _, sub := range cond.(bson.A) {
+				if !matches(doc, sub.(bson.M)) {
+					return false
+				}
+			}
+		case "$or":
+			any := false
+			for _, sub := range cond.(bson.A) {
+				if matches(doc, sub.(bson.M)) {
+					any = true
+					break
+				}
+			}
+			if !any {
+				return false
+			}
+		default:
+			ops, ok := cond.(bson.M)
+			if !ok {
+				ops = bson.M{"$eq": cond}
+			}
+			for op, want := range ops {
+				cmp := compareValues(doc[key], want)
+				var ok bool
+				switch op {
+				case "$eq":
+					ok = cmp == 0
+				case "$gt":
+					ok = cmp > 0
+				case "$gte":
+					ok = cmp >= 0
+				case "$lt":
+					ok = cmp < 0
+				case "$lte":
+					ok = cmp <= 0
+				default:
+					panic(fmt.Sprintf("unsupported operator %s", op))
+				}
+				if !ok {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+func compareValues(a, b interface{}) int {
+	switch av := a.(type) {
+	case int:
+		bv := b.(int)
+		switch {
+		case av < bv:
+			return -1
+		case av > bv:
+			return 1
+		}
+		return 0
+	case string:
+		bv := b.(string)
+		switch {
+		case av < bv:
+			return -1
+		case av > bv:
+			return 1
+		}
+		return 0
+	}
+	panic(fmt.Sprintf("unsupported value %T", a))
+}
+
+// ============================================================================
+// Fixtures
+// ============================================================================
+
+type Player struct {
+	ID     string `bson:"_id"`
+	Name   string `bson:"name"`
+	Score  int    `bson:"score"`
+	Region string `bson:"region"`
+}
+
+type playerRank struct {
+	Score int
+	ID    string
+}
+
+func playerRankOf(p Player) playerRank {
+	return playerRank{Score: p.Score, ID: p.ID}
+}
+
+// Score DESC, _id ASC: backed by the index {score: -1, _id: 1}
+var rankKeyset = pagit.Keyset[playerRank]{
+	pagit.OrderedKey("score", pagit.SortDesc, func(c playerRank) int { return c.Score }),
+	pagit.OrderedKey("_id", pagit.SortAsc, func(c playerRank) string { return c.ID }),
+}
+
+func newPlayers() *memoryCollection {
+	return &memoryCollection{docs: []bson.M{
+		{"_id": "p1", "name": "A", "score": 900, "region": "kr"},
+		{"_id": "p2", "name": "B", "score": 950, "region": "us"},
+		{"_id": "p3", "name": "C", "score": 900, "region": "kr"},
+		{"_id": "p4", "name": "D", "score": 800, "region": "kr"},
+		{"_id": "p5", "name": "E", "score": 900, "region": "us"},
+		{"_id": "p6", "name": "F", "score": 700, "region": "kr"},
+	}}
+}
+
+func ids(players []Player) []string {
+	result := make([]string, len(players))
+	for i, p := range players {
+		result[i] = p.ID
+	}
+	return result
+}
+
+// ============================================================================
+// Offset provider
+// ============================================================================
+
+func TestMongoOffsetProvider(t *testing.T) {
+	ctx := context.Background()
+	provider := NewMongoOffsetProvider(MongoOffsetConfig[Player]{
+		Collection: newPlayers(),
+		Filter:     bson.M{"region": "kr"},
+		Sort:       bson.D{{Key: "score", Value: -1}, {Key: "_id", Value: 1}},
+	})
+
+	players, err := provider.GetData(ctx, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"p1", "p3"}, ids(players))
+
+	players, err = provider.GetData(ctx, 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"p4", "p6"}, ids(players))
+
+	total, err := provider.GetTotalCount(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), total)
+}
+
+func TestMongoOffsetProvider_DefaultSort(t *testing.T) {
+	provider := NewMongoOffsetProvider(MongoOffsetConfig[Player]{Collection: newPlayers()})
+
+	players, err := provider.GetData(context.Background(), 4, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"p5", "p6"}, ids(players))
+}
+
+type failingCollection struct{ memoryCollection }
+
+func (*failingCollection) Find(context.Context, interface{}, ...*options.FindOptions) (*mongo.Cursor, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestMongoOffsetProvider_FindError(t *testing.T) {
+	provider := NewMongoOffsetProvider(MongoOffsetConfig[Player]{Collection: &failingCollection{}})
+
+	_, err := provider.GetData(context.Background(), 0, 10)
+	assert.ErrorContains(t, err, "connection refused")
+}
+
+// ============================================================================
+// Cursor provider
+// ============================================================================
+
+func TestMongoCursorProvider_ForwardAndBackward(t *testing.T) {
+	ctx := context.Background()
+	provider, err := NewMongoCursorProvider(MongoCursorConfig[Player, playerRank]{
+		Collection: newPlayers(),
+		Keyset:     rankKeyset,
+	})
+	require.NoError(t, err)
+
+	var forward []string
+	var pages []pagit.CursorResult[Player, playerRank]
+	config := pagit.CursorConfig[playerRank]{PageSize: 2}
+	for {
+		result, err := pagit.PaginateCursor(ctx, provider, config, playerRankOf)
+		require.NoError(t, err)
+		pages = append(pages, result)
+		forward = append(forward, ids(result.Data)...)
+		if !result.HasNext {
+			break
+		}
+		config.Cursor = result.NextCursor
+	}
+	assert.Equal(t, []string{"p2", "p1", "p3", "p5", "p4", "p6"}, forward)
+	assert.False(t, pages[0].HasPrev)
+
+	// Step back from the last page
+	last := pages[len(pages)-1]
+	result, err := pagit.PaginateCursor(ctx, provider, pagit.CursorConfig[playerRank]{
+		Cursor:    last.PrevCursor,
+		PageSize:  2,
+		Direction: pagit.CursorBackward,
+	}, playerRankOf)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"p3", "p5"}, ids(result.Data))
+	assert.True(t, result.HasPrev)
+	assert.True(t, result.HasNext)
+}
+
+func TestMongoCursorProvider_RangeFilter(t *testing.T) {
+	players := newPlayers()
+	provider, err := NewMongoCursorProvider(MongoCursorConfig[Player, playerRank]{
+		Collection: players,
+		Filter:     bson.M{"region": "kr"},
+		Keyset:     rankKeyset,
+	})
+	require.NoError(t, err)
+
+	cursor := playerRank{Score: 900, ID: "p1"}
+	got, err := provider.GetDataAfter(context.Background(), &cursor, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"p3", "p4", "p6"}, ids(got))
+
+	// The user filter is combined with an index-friendly range on the keyset
+	assert.Equal(t, bson.M{"$and": bson.A{
+		bson.M{"region": "kr"},
+		bson.M{"$or": bson.A{
+			bson.M{"score": bson.M{"$lt": 900}},
+			bson.M{"score": 900, "_id": bson.M{"$gt": "p1"}},
+		}},
+	}}, players.filters[len(players.filters)-1])
+}
+
+func TestMongoCursorProvider_SingleField(t *testing.T) {
+	players := newPlayers()
+	provider, err := NewMongoCursorProvider(MongoCursorConfig[Player, string]{
+		Collection: players,
+		Keyset: pagit.Keyset[string]{
+			pagit.OrderedKey("_id", pagit.SortAsc, func(id string) string { return id }),
+		},
+	})
+	require.NoError(t, err)
+
+	cursor := "p4"
+	got, err := provider.GetDataBefore(context.Background(), &cursor, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"p2", "p3"}, ids(got))
+	assert.Equal(t, bson.M{"_id": bson.M{"$lt": "p4"}}, players.filters[0])
+
+	has, err := provider.HasDataAfter(context.Background(), "p6")
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	has, err = provider.HasDataBefore(context.Background(), "p2")
+	require.NoError(t, err)
+	assert.True(t, has)
+}
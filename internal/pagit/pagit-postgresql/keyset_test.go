@@ -0,0 +1,125 @@
+package pagitpostgresql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/homveloper/dukdakit/internal/pagit"
+)
+
+type playerRank struct {
+	Score     int
+	CreatedAt string
+	ID        int64
+}
+
+func playerRankOf(p Player) playerRank {
+	return playerRank{Score: p.Score, CreatedAt: p.CreatedAt, ID: p.ID}
+}
+
+// Score DESC, created_at ASC, id ASC: mixed directions use the expanded OR form
+var mixedRankKeyset = pagit.Keyset[playerRank]{
+	pagit.OrderedKey("score", pagit.SortDesc, func(c playerRank) int { return c.Score }),
+	pagit.OrderedKey("created_at", pagit.SortAsc, func(c playerRank) string { return c.CreatedAt }),
+	pagit.OrderedKey("id", pagit.SortAsc, func(c playerRank) int64 { return c.ID }),
+}
+
+func seedTiedPlayers(t *testing.T, provider *PostgreSQLCursorProvider[Player, playerRank]) {
+	players := []Player{
+		{ID: 1, Name: "A", Score: 900, Level: 1, CreatedAt: "2024-01-02"},
+		{ID: 2, Name: "B", Score: 900, Level: 1, CreatedAt: "2024-01-01"},
+		{ID: 3, Name: "C", Score: 900, Level: 1, CreatedAt: "2024-01-02"},
+		{ID: 4, Name: "D", Score: 950, Level: 1, CreatedAt: "2024-01-05"},
+		{ID: 5, Name: "E", Score: 800, Level: 1, CreatedAt: "2024-01-01"},
+		{ID: 6, Name: "F", Score: 900, Level: 1, CreatedAt: "2024-01-03"},
+	}
+	for _, p := range players {
+		_, err := provider.db.Exec(
+			"INSERT INTO players (id, name, score, level, created_at) VALUES (?, ?, ?, ?, ?)",
+			p.ID, p.Name, p.Score, p.Level, p.CreatedAt,
+		)
+		require.NoError(t, err)
+	}
+}
+
+func newRankProvider(t *testing.T, keyset pagit.Keyset[playerRank]) *PostgreSQLCursorProvider[Player, playerRank] {
+	db := setupTestDB(t)
+	t.Cleanup(func() { db.Close() })
+
+	provider := NewPostgreSQLCursorProvider(PostgreSQLCursorConfig[Player, playerRank]{
+		DB:        db,
+		TableName: "players",
+		Columns:   []string{"id", "name", "score", "level", "created_at"},
+		Scanner:   ScanPlayer,
+		Extractor: playerRankOf,
+		Keyset:    keyset,
+	})
+	seedTiedPlayers(t, provider)
+	return provider
+}
+
+func collectForward(t *testing.T, provider *PostgreSQLCursorProvider[Player, playerRank], pageSize int) []int64 {
+	var ids []int64
+	config := pagit.CursorConfig[playerRank]{PageSize: pageSize}
+	for {
+		result, err := pagit.PaginateCursor(context.Background(), provider, config, playerRankOf)
+		require.NoError(t, err)
+		for _, p := range result.Data {
+			ids = append(ids, p.ID)
+		}
+		if !result.HasNext {
+			return ids
+		}
+		config.Cursor = result.NextCursor
+	}
+}
+
+func TestPostgreSQLCursorProvider_KeysetMixedDirections(t *testing.T) {
+	provider := newRankProvider(t, mixedRankKeyset)
+
+	assert.Equal(t, "score DESC, created_at ASC, id ASC", provider.orderBy)
+	assert.Equal(t, []int64{4, 2, 1, 3, 6, 5}, collectForward(t, provider, 2))
+
+	// Backward from the tie in the middle returns the preceding rows in forward order
+	cursor := playerRankOf(Player{ID: 3, Score: 900, CreatedAt: "2024-01-02"})
+	before, err := provider.GetDataBefore(context.Background(), &cursor, 2)
+	require.NoError(t, err)
+	require.Len(t, before, 2)
+	assert.Equal(t, int64(2), before[0].ID)
+	assert.Equal(t, int64(1), before[1].ID)
+
+	hasBefore, err := provider.HasDataBefore(context.Background(), cursor)
+	require.NoError(t, err)
+	assert.True(t, hasBefore)
+}
+
+func TestPostgreSQLCursorProvider_KeysetRowValue(t *testing.T) {
+	sameDirection := pagit.Keyset[playerRank]{
+		pagit.OrderedKey("score", pagit.SortDesc, func(c playerRank) int { return c.Score }),
+		pagit.OrderedKey("id", pagit.SortDesc, func(c playerRank) int64 { return c.ID }),
+	}
+	provider := newRankProvider(t, sameDirection)
+
+	assert.Equal(t, []int64{4, 6, 3, 2, 1, 5}, collectForward(t, provider, 4))
+
+	last := playerRank{Score: 800, ID: 5}
+	hasAfter, err := provider.HasDataAfter(context.Background(), last)
+	require.NoError(t, err)
+	assert.False(t, hasAfter)
+}
+
+func TestKeysetCondition(t *testing.T) {
+	cursor := playerRank{Score: 900, CreatedAt: "2024-01-02", ID: 3}
+
+	condition, args := keysetCondition(mixedRankKeyset, cursor, []interface{}{"guild"})
+	assert.Equal(t,
+		"((score < $2) OR (score = $2 AND created_at > $3) OR (score = $2 AND created_at = $3 AND id > $4))",
+		condition)
+	assert.Equal(t, []interface{}{"guild", 900, "2024-01-02", int64(3)}, args)
+
+	rowValue, _ := keysetCondition(mixedRankKeyset[1:], cursor, nil)
+	assert.Equal(t, "(created_at, id) > ($1, $2)", rowValue)
+}
@@ -4,6 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/homveloper/dukdakit/internal/pagit"
 )
 
 // PostgreSQLOffsetProvider provides offset-based pagination for PostgreSQL tables
@@ -85,6 +89,29 @@ func (p *PostgreSQLOffsetProvider[T]) GetTotalCount(ctx context.Context) (int64,
 	return count, nil
 }
 
+// GetEstimatedCount implements pagit.EstimatedCountProvider using the planner's
+// row estimate (pg_class.reltuples), which is instant on tables of any size.
+// The estimate covers the whole table, so it is unavailable when Where is set
+// and for tables that have never been analyzed.
+func (p *PostgreSQLOffsetProvider[T]) GetEstimatedCount(ctx context.Context) (int64, error) {
+	if p.where != "" {
+		return 0, pagit.ErrTotalCountUnavailable
+	}
+
+	var estimate float64
+	err := p.db.QueryRowContext(ctx,
+		"SELECT reltuples FROM pg_class WHERE oid = $1::regclass", p.tableName,
+	).Scan(&estimate)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get estimated count: %w", err)
+	}
+	if estimate < 0 {
+		return 0, pagit.ErrTotalCountUnavailable
+	}
+
+	return int64(estimate), nil
+}
+
 // buildSelectQuery constructs the SELECT query for pagination
 func (p *PostgreSQLOffsetProvider[T]) buildSelectQuery(limit, offset int) string {
 	columnsStr := "*"
@@ -133,6 +160,7 @@ type PostgreSQLCursorProvider[T any, C comparable] struct {
 	args       []interface{}
 	scanner    func(*sql.Rows) (T, error)
 	extractor  func(T) C
+	keyset     pagit.Keyset[C]
 }
 
 // PostgreSQLCursorConfig holds configuration for PostgreSQL cursor provider
@@ -146,12 +174,22 @@ type PostgreSQLCursorConfig[T any, C comparable] struct {
 	Args       []interface{} // Arguments for WHERE clause
 	Scanner    func(*sql.Rows) (T, error) // Function to scan SQL row into T
 	Extractor  func(T) C // Function to extract cursor value from T
+
+	// Keyset enables composite cursors ordered by several columns,
+	// e.g. (score DESC, updated_at ASC, id ASC). When set, CursorCol is ignored,
+	// OrderBy defaults to the keyset order and cursor predicates compare the
+	// whole tuple so ties never skip or duplicate rows
+	Keyset pagit.Keyset[C]
 }
 
 // NewPostgreSQLCursorProvider creates a PostgreSQL cursor provider
 func NewPostgreSQLCursorProvider[T any, C comparable](config PostgreSQLCursorConfig[T, C]) *PostgreSQLCursorProvider[T, C] {
 	if config.OrderBy == "" {
-		config.OrderBy = fmt.Sprintf("%s ASC", config.CursorCol)
+		if len(config.Keyset) > 0 {
+			config.OrderBy = keysetOrderBy(config.Keyset)
+		} else {
+			config.OrderBy = fmt.Sprintf("%s ASC", config.CursorCol)
+		}
 	}
 	
 	return &PostgreSQLCursorProvider[T, C]{
@@ -164,6 +202,7 @@ func NewPostgreSQLCursorProvider[T any, C comparable](config PostgreSQLCursorCon
 		args:      config.Args,
 		scanner:   config.Scanner,
 		extractor: config.Extractor,
+		keyset:    config.Keyset,
 	}
 }
 
@@ -173,7 +212,11 @@ func (p *PostgreSQLCursorProvider[T, C]) GetDataAfter(
 	cursor *C,
 	limit int,
 ) ([]T, error) {
-	query, args := p.buildCursorQuery(cursor, ">", limit)
+	if len(p.keyset) > 0 {
+		return p.queryKeyset(ctx, cursor, p.keyset, limit)
+	}
+
+	query, args := p.buildCursorQuery(cursor, p.afterOperator(), p.orderBy, limit)
 	
 	rows, err := p.db.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -190,20 +233,19 @@ func (p *PostgreSQLCursorProvider[T, C]) GetDataBefore(
 	cursor *C,
 	limit int,
 ) ([]T, error) {
-	if cursor == nil {
-		// For backward pagination from nil cursor, get the highest values
-		query, args := p.buildReverseCursorQuery(limit)
-		
-		rows, err := p.db.QueryContext(ctx, query, args...)
+	if len(p.keyset) > 0 {
+		// Walk the reversed order from the cursor, then restore the forward order
+		items, err := p.queryKeyset(ctx, cursor, p.keyset.Reverse(), limit)
 		if err != nil {
-			return nil, fmt.Errorf("failed to execute reverse cursor query: %w", err)
+			return nil, err
 		}
-		defer rows.Close()
-
-		return p.scanRows(rows)
+		slices.Reverse(items)
+		return items, nil
 	}
 
-	query, args := p.buildCursorQuery(cursor, "<", limit)
+	// Walk the reversed order from the cursor (or from the end when cursor is nil),
+	// then restore the forward order so both directions return data the same way
+	query, args := p.buildCursorQuery(cursor, p.beforeOperator(), p.reverseOrderBy(), limit)
 	
 	rows, err := p.db.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -211,7 +253,12 @@ func (p *PostgreSQLCursorProvider[T, C]) GetDataBefore(
 	}
 	defer rows.Close()
 
-	return p.scanRows(rows)
+	items, err := p.scanRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(items)
+	return items, nil
 }
 
 // HasDataAfter implements pagit.CursorCheckProvider interface
@@ -219,7 +266,11 @@ func (p *PostgreSQLCursorProvider[T, C]) HasDataAfter(
 	ctx context.Context,
 	cursor C,
 ) (bool, error) {
-	query, args := p.buildExistsQuery(&cursor, ">")
+	if len(p.keyset) > 0 {
+		return p.existsKeyset(ctx, cursor, p.keyset)
+	}
+
+	query, args := p.buildExistsQuery(&cursor, p.afterOperator())
 	
 	var exists bool
 	err := p.db.QueryRowContext(ctx, query, args...).Scan(&exists)
@@ -235,7 +286,11 @@ func (p *PostgreSQLCursorProvider[T, C]) HasDataBefore(
 	ctx context.Context,
 	cursor C,
 ) (bool, error) {
-	query, args := p.buildExistsQuery(&cursor, "<")
+	if len(p.keyset) > 0 {
+		return p.existsKeyset(ctx, cursor, p.keyset.Reverse())
+	}
+
+	query, args := p.buildExistsQuery(&cursor, p.beforeOperator())
 	
 	var exists bool
 	err := p.db.QueryRowContext(ctx, query, args...).Scan(&exists)
@@ -247,7 +302,7 @@ func (p *PostgreSQLCursorProvider[T, C]) HasDataBefore(
 }
 
 // buildCursorQuery constructs cursor-based query
-func (p *PostgreSQLCursorProvider[T, C]) buildCursorQuery(cursor *C, operator string, limit int) (string, []interface{}) {
+func (p *PostgreSQLCursorProvider[T, C]) buildCursorQuery(cursor *C, operator, orderBy string, limit int) (string, []interface{}) {
 	columnsStr := "*"
 	if len(p.columns) > 0 {
 		columnsStr = ""
@@ -283,36 +338,7 @@ func (p *PostgreSQLCursorProvider[T, C]) buildCursorQuery(cursor *C, operator st
 		query += " WHERE " + whereClause
 	}
 	
-	query += fmt.Sprintf(" ORDER BY %s", p.orderBy)
-	query += fmt.Sprintf(" LIMIT %d", limit)
-	
-	return query, args
-}
-
-// buildReverseCursorQuery constructs reverse cursor query for backward pagination from nil
-func (p *PostgreSQLCursorProvider[T, C]) buildReverseCursorQuery(limit int) (string, []interface{}) {
-	columnsStr := "*"
-	if len(p.columns) > 0 {
-		columnsStr = ""
-		for i, col := range p.columns {
-			if i > 0 {
-				columnsStr += ", "
-			}
-			columnsStr += col
-		}
-	}
-
-	query := fmt.Sprintf("SELECT %s FROM %s", columnsStr, p.tableName)
-	args := make([]interface{}, len(p.args))
-	copy(args, p.args)
-	
-	if p.where != "" {
-		query += fmt.Sprintf(" WHERE %s", p.where)
-	}
-	
-	// Reverse the order for backward pagination
-	reverseOrder := p.reverseOrderBy()
-	query += fmt.Sprintf(" ORDER BY %s", reverseOrder)
+	query += fmt.Sprintf(" ORDER BY %s", orderBy)
 	query += fmt.Sprintf(" LIMIT %d", limit)
 	
 	return query, args
@@ -344,19 +370,172 @@ func (p *PostgreSQLCursorProvider[T, C]) buildExistsQuery(cursor *C, operator st
 }
 
 // reverseOrderBy reverses the ORDER BY clause for backward pagination
+// Each term's direction is flipped, e.g. "score DESC, id ASC" becomes "score ASC, id DESC"
 func (p *PostgreSQLCursorProvider[T, C]) reverseOrderBy() string {
-	// Simple implementation - for production, you'd want more sophisticated parsing
-	// This handles common cases like "score DESC, id ASC"
-	orderBy := p.orderBy
-	
-	// Replace DESC with ASC and vice versa
-	// This is a simplified implementation
-	if len(orderBy) > 0 {
-		// For now, return the same order - in real implementation you'd parse and reverse
-		return orderBy
+	terms := strings.Split(p.orderBy, ",")
+	for i, term := range terms {
+		fields := strings.Fields(term)
+		if len(fields) == 0 {
+			continue
+		}
+
+		direction := "DESC"
+		if len(fields) > 1 && strings.EqualFold(fields[1], "DESC") {
+			direction = "ASC"
+		}
+		terms[i] = fmt.Sprintf("%s %s", fields[0], direction)
 	}
-	
-	return fmt.Sprintf("%s DESC", p.cursorCol)
+	return strings.Join(terms, ", ")
+}
+
+// cursorDescending reports whether the cursor column is sorted descending
+func (p *PostgreSQLCursorProvider[T, C]) cursorDescending() bool {
+	for _, term := range strings.Split(p.orderBy, ",") {
+		fields := strings.Fields(term)
+		if len(fields) > 0 && fields[0] == p.cursorCol {
+			return len(fields) > 1 && strings.EqualFold(fields[1], "DESC")
+		}
+	}
+	return false
+}
+
+// afterOperator returns the comparison selecting rows after the cursor in sort order
+func (p *PostgreSQLCursorProvider[T, C]) afterOperator() string {
+	if p.cursorDescending() {
+		return "<"
+	}
+	return ">"
+}
+
+// beforeOperator returns the comparison selecting rows before the cursor in sort order
+func (p *PostgreSQLCursorProvider[T, C]) beforeOperator() string {
+	if p.cursorDescending() {
+		return ">"
+	}
+	return "<"
+}
+
+// queryKeyset fetches rows following cursor in keyset order
+func (p *PostgreSQLCursorProvider[T, C]) queryKeyset(
+	ctx context.Context,
+	cursor *C,
+	keyset pagit.Keyset[C],
+	limit int,
+) ([]T, error) {
+	query, args := p.buildKeysetQuery(cursor, keyset, limit)
+
+	rows, err := p.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute keyset query: %w", err)
+	}
+	defer rows.Close()
+
+	return p.scanRows(rows)
+}
+
+// existsKeyset checks whether any row follows cursor in keyset order
+func (p *PostgreSQLCursorProvider[T, C]) existsKeyset(
+	ctx context.Context,
+	cursor C,
+	keyset pagit.Keyset[C],
+) (bool, error) {
+	args := make([]interface{}, len(p.args))
+	copy(args, p.args)
+
+	condition, args := keysetCondition(keyset, cursor, args)
+	query := fmt.Sprintf("SELECT EXISTS(SELECT 1 FROM %s WHERE %s)", p.tableName, p.joinWhere(condition))
+
+	var exists bool
+	if err := p.db.QueryRowContext(ctx, query, args...).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check keyset cursor: %w", err)
+	}
+
+	return exists, nil
+}
+
+// buildKeysetQuery constructs a composite cursor query ordered by keyset
+func (p *PostgreSQLCursorProvider[T, C]) buildKeysetQuery(cursor *C, keyset pagit.Keyset[C], limit int) (string, []interface{}) {
+	columnsStr := "*"
+	if len(p.columns) > 0 {
+		columnsStr = strings.Join(p.columns, ", ")
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s", columnsStr, p.tableName)
+	args := make([]interface{}, len(p.args))
+	copy(args, p.args)
+
+	var condition string
+	if cursor != nil {
+		condition, args = keysetCondition(keyset, *cursor, args)
+	}
+
+	if where := p.joinWhere(condition); where != "" {
+		query += " WHERE " + where
+	}
+
+	query += fmt.Sprintf(" ORDER BY %s", keysetOrderBy(keyset))
+	query += fmt.Sprintf(" LIMIT %d", limit)
+
+	return query, args
+}
+
+// joinWhere combines the configured WHERE clause with a cursor condition
+func (p *PostgreSQLCursorProvider[T, C]) joinWhere(condition string) string {
+	switch {
+	case p.where == "":
+		return condition
+	case condition == "":
+		return p.where
+	default:
+		return fmt.Sprintf("(%s) AND %s", p.where, condition)
+	}
+}
+
+// keysetOrderBy renders the keyset as an ORDER BY list
+func keysetOrderBy[C any](keyset pagit.Keyset[C]) string {
+	parts := make([]string, len(keyset))
+	for i, field := range keyset {
+		parts[i] = fmt.Sprintf("%s %s", field.Column, field.Direction)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// keysetCondition builds the predicate selecting rows after cursor in keyset order
+//
+// When every field shares a direction a row-value comparison is used, which
+// PostgreSQL can serve from a matching composite index:
+//
+//	(score, id) < ($1, $2)
+//
+// Mixed directions fall back to the expanded form:
+//
+//	(score < $1) OR (score = $1 AND updated_at > $2) OR (score = $1 AND updated_at = $2 AND id > $3)
+func keysetCondition[C any](keyset pagit.Keyset[C], cursor C, args []interface{}) (string, []interface{}) {
+	placeholders := make([]string, len(keyset))
+	for i, value := range keyset.Values(cursor) {
+		args = append(args, value)
+		placeholders[i] = fmt.Sprintf("$%d", len(args))
+	}
+
+	if len(keyset) > 1 && keyset.Uniform() {
+		columns := make([]string, len(keyset))
+		for i, field := range keyset {
+			columns[i] = field.Column
+		}
+		return fmt.Sprintf("(%s) %s (%s)",
+			strings.Join(columns, ", "), keyset[0].Direction.Operator(), strings.Join(placeholders, ", ")), args
+	}
+
+	branches := make([]string, len(keyset))
+	for i, field := range keyset {
+		terms := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			terms = append(terms, fmt.Sprintf("%s = %s", keyset[j].Column, placeholders[j]))
+		}
+		terms = append(terms, fmt.Sprintf("%s %s %s", field.Column, field.Direction.Operator(), placeholders[i]))
+		branches[i] = "(" + strings.Join(terms, " AND ") + ")"
+	}
+	return "(" + strings.Join(branches, " OR ") + ")", args
 }
 
 // scanRows scans multiple rows into slice
@@ -131,17 +131,16 @@ func TestPostgreSQLOffsetProvider_BasicPagination(t *testing.T) {
 		result, err := dukdakit.PaginateOffset(ctx, provider, pageConfig)
 		require.NoError(t, err)
 
-		offsetResult, ok := result.(pagit.OffsetResult[Player])
-		require.True(t, ok)
-
-		assert.Len(t, offsetResult.Data, 2)
-		assert.Equal(t, "TopPlayer", offsetResult.Data[0].Name)     // Highest score first
-		assert.Equal(t, "SecondPlayer", offsetResult.Data[1].Name) // Second highest
-		assert.Equal(t, int64(5), offsetResult.TotalCount)
-		assert.Equal(t, 1, offsetResult.Page)
-		assert.Equal(t, 3, offsetResult.TotalPages)
-		assert.True(t, offsetResult.HasNext)
-		assert.False(t, offsetResult.HasPrev)
+		require.NotNil(t, result.TotalCount)
+
+		assert.Len(t, result.Data, 2)
+		assert.Equal(t, "TopPlayer", result.Data[0].Name)     // Highest score first
+		assert.Equal(t, "SecondPlayer", result.Data[1].Name) // Second highest
+		assert.Equal(t, int64(5), *result.TotalCount)
+		assert.Equal(t, 1, result.Page)
+		assert.Equal(t, 3, result.TotalPages)
+		assert.True(t, result.HasNext)
+		assert.False(t, result.HasPrev)
 	})
 
 	t.Run("SecondPage", func(t *testing.T) {
@@ -153,14 +152,13 @@ func TestPostgreSQLOffsetProvider_BasicPagination(t *testing.T) {
 		result, err := dukdakit.PaginateOffset(ctx, provider, pageConfig)
 		require.NoError(t, err)
 
-		offsetResult, ok := result.(pagit.OffsetResult[Player])
-		require.True(t, ok)
+		require.NotNil(t, result.TotalCount)
 
-		assert.Len(t, offsetResult.Data, 2)
-		assert.Equal(t, "ThirdPlayer", offsetResult.Data[0].Name)
-		assert.Equal(t, "FourthPlayer", offsetResult.Data[1].Name)
-		assert.True(t, offsetResult.HasNext)
-		assert.True(t, offsetResult.HasPrev)
+		assert.Len(t, result.Data, 2)
+		assert.Equal(t, "ThirdPlayer", result.Data[0].Name)
+		assert.Equal(t, "FourthPlayer", result.Data[1].Name)
+		assert.True(t, result.HasNext)
+		assert.True(t, result.HasPrev)
 	})
 
 	t.Run("WithWhereClause", func(t *testing.T) {
@@ -184,11 +182,10 @@ func TestPostgreSQLOffsetProvider_BasicPagination(t *testing.T) {
 		result, err := dukdakit.PaginateOffset(ctx, providerWithWhere, pageConfig)
 		require.NoError(t, err)
 
-		offsetResult, ok := result.(pagit.OffsetResult[Player])
-		require.True(t, ok)
+		require.NotNil(t, result.TotalCount)
 
-		assert.Len(t, offsetResult.Data, 3) // Only players with level >= 8
-		assert.Equal(t, int64(3), offsetResult.TotalCount)
+		assert.Len(t, result.Data, 3) // Only players with level >= 8
+		assert.Equal(t, int64(3), *result.TotalCount)
 	})
 }
 
@@ -328,12 +325,11 @@ func TestPostgreSQLProvider_GameScenarios(t *testing.T) {
 		result, err := dukdakit.PaginateOffset(ctx, provider, pageConfig)
 		require.NoError(t, err)
 
-		offsetResult, ok := result.(pagit.OffsetResult[Item])
-		require.True(t, ok)
+		require.NotNil(t, result.TotalCount)
 
-		assert.Len(t, offsetResult.Data, 3)
-		assert.Equal(t, "Steel Armor", offsetResult.Data[0].Name) // Rarity 3
-		assert.Equal(t, int64(5), offsetResult.TotalCount)
+		assert.Len(t, result.Data, 3)
+		assert.Equal(t, "Steel Armor", result.Data[0].Name) // Rarity 3
+		assert.Equal(t, int64(5), *result.TotalCount)
 	})
 
 	t.Run("WeaponsByRarity", func(t *testing.T) {
@@ -357,12 +353,11 @@ func TestPostgreSQLProvider_GameScenarios(t *testing.T) {
 		result, err := dukdakit.PaginateOffset(ctx, provider, pageConfig)
 		require.NoError(t, err)
 
-		offsetResult, ok := result.(pagit.OffsetResult[Item])
-		require.True(t, ok)
+		require.NotNil(t, result.TotalCount)
 
-		assert.Len(t, offsetResult.Data, 1) // Only weapons
-		assert.Equal(t, "Iron Sword", offsetResult.Data[0].Name)
-		assert.Equal(t, int64(1), offsetResult.TotalCount)
+		assert.Len(t, result.Data, 1) // Only weapons
+		assert.Equal(t, "Iron Sword", result.Data[0].Name)
+		assert.Equal(t, int64(1), *result.TotalCount)
 	})
 
 	t.Run("RecentGameEvents", func(t *testing.T) {
@@ -384,14 +379,13 @@ func TestPostgreSQLProvider_GameScenarios(t *testing.T) {
 		result, err := dukdakit.PaginateOffset(ctx, provider, pageConfig)
 		require.NoError(t, err)
 
-		offsetResult, ok := result.(pagit.OffsetResult[GameEvent])
-		require.True(t, ok)
+		require.NotNil(t, result.TotalCount)
 
-		assert.Len(t, offsetResult.Data, 3)
-		assert.Equal(t, "battle", offsetResult.Data[0].Type)      // Most recent (timestamp 5000)
-		assert.Equal(t, "logout", offsetResult.Data[1].Type)     // Second most recent (4000)
-		assert.Equal(t, "purchase", offsetResult.Data[2].Type)   // Third most recent (3000)
-		assert.Equal(t, int64(5), offsetResult.TotalCount)
+		assert.Len(t, result.Data, 3)
+		assert.Equal(t, "battle", result.Data[0].Type)      // Most recent (timestamp 5000)
+		assert.Equal(t, "logout", result.Data[1].Type)     // Second most recent (4000)
+		assert.Equal(t, "purchase", result.Data[2].Type)   // Third most recent (3000)
+		assert.Equal(t, int64(5), *result.TotalCount)
 	})
 
 	t.Run("PlayerSpecificEvents", func(t *testing.T) {
@@ -468,4 +462,71 @@ func TestPostgreSQLProvider_ErrorHandling(t *testing.T) {
 		_, err := dukdakit.PaginateOffset(ctx, provider, pageConfig)
 		assert.Error(t, err)
 	})
-}
\ No newline at end of file
+}
+func TestPostgreSQLCursorProvider_BackwardDescending(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	seedPlayers(t, db)
+
+	ctx := context.Background()
+	extractor := func(p Player) int { return p.Score }
+
+	provider := NewPostgreSQLCursorProvider(PostgreSQLCursorConfig[Player, int]{
+		DB:        db,
+		TableName: "players",
+		Columns:   []string{"id", "name", "score", "level", "created_at"},
+		CursorCol: "score",
+		OrderBy:   "score DESC",
+		Scanner:   ScanPlayer,
+		Extractor: extractor,
+	})
+
+	assert.Equal(t, "score ASC", provider.reverseOrderBy())
+
+	t.Run("ForwardFollowsDescendingOrder", func(t *testing.T) {
+		cursor := 950
+		result, err := dukdakit.PaginateCursor(ctx, provider, pagit.CursorConfig[int]{
+			PageSize:  2,
+			Cursor:    &cursor,
+			Direction: pagit.CursorForward,
+		}, extractor)
+		require.NoError(t, err)
+
+		require.Len(t, result.Data, 2)
+		assert.Equal(t, 900, result.Data[0].Score)
+		assert.Equal(t, 850, result.Data[1].Score)
+		assert.True(t, result.HasNext)
+		assert.True(t, result.HasPrev)
+	})
+
+	t.Run("BackwardFromEnd", func(t *testing.T) {
+		result, err := dukdakit.PaginateCursor(ctx, provider, pagit.CursorConfig[int]{
+			PageSize:  2,
+			Direction: pagit.CursorBackward,
+		}, extractor)
+		require.NoError(t, err)
+
+		require.Len(t, result.Data, 2)
+		assert.Equal(t, 850, result.Data[0].Score)
+		assert.Equal(t, 800, result.Data[1].Score)
+		assert.True(t, result.HasPrev)
+		assert.False(t, result.HasNext)
+	})
+
+	t.Run("BackwardFromCursor", func(t *testing.T) {
+		cursor := 850
+		result, err := dukdakit.PaginateCursor(ctx, provider, pagit.CursorConfig[int]{
+			PageSize:  2,
+			Cursor:    &cursor,
+			Direction: pagit.CursorBackward,
+		}, extractor)
+		require.NoError(t, err)
+
+		require.Len(t, result.Data, 2)
+		assert.Equal(t, 950, result.Data[0].Score)
+		assert.Equal(t, 900, result.Data[1].Score)
+		assert.True(t, result.HasPrev, "1000 is still ahead")
+		assert.True(t, result.HasNext)
+	})
+}
@@ -0,0 +1,42 @@
+package pagitredis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisHyperLogLogEstimator estimates a total count from a HyperLogLog key
+// Maintain the HyperLogLog with PFADD alongside the paged data, e.g. one PFADD
+// per event ID, and pass the estimator as pagit.OffsetConfig.Estimator; PFCOUNT
+// is O(1) with a standard error of 0.81% regardless of cardinality
+//
+// Example usage:
+//
+//	estimator := pagitredis.NewRedisHyperLogLogEstimator(client, "events:hll")
+//	page, err := pagit.PaginateOffset(ctx, provider, pagit.OffsetConfig{
+//	    Page: 1, PageSize: 50, Count: pagit.CountEstimated, Estimator: estimator,
+//	})
+type RedisHyperLogLogEstimator struct {
+	client redis.Cmdable
+	keys   []string
+}
+
+// NewRedisHyperLogLogEstimator creates an estimator over one or more HyperLogLog keys
+// Several keys are counted as their union
+func NewRedisHyperLogLogEstimator(client redis.Cmdable, keys ...string) *RedisHyperLogLogEstimator {
+	return &RedisHyperLogLogEstimator{
+		client: client,
+		keys:   keys,
+	}
+}
+
+// GetEstimatedCount implements pagit.EstimatedCountProvider interface
+func (e *RedisHyperLogLogEstimator) GetEstimatedCount(ctx context.Context) (int64, error) {
+	count, err := e.client.PFCount(ctx, e.keys...).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to estimate cardinality: %w", err)
+	}
+	return count, nil
+}
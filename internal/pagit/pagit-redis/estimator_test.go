@@ -0,0 +1,33 @@
+package pagitredis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/homveloper/dukdakit/internal/pagit"
+)
+
+func TestRedisHyperLogLogEstimator(t *testing.T) {
+	ctx := context.Background()
+	mr, client := setupRedis(t)
+	defer mr.Close()
+	defer client.Close()
+
+	for i := 0; i < 500; i++ {
+		require.NoError(t, client.PFAdd(ctx, "events:hll", i).Err())
+	}
+	require.NoError(t, AddToList(ctx, client, "events", Player{ID: 1}, Player{ID: 2}))
+
+	page, err := pagit.PaginateOffset(ctx, NewRedisListProvider[Player](client, "events"), pagit.OffsetConfig{
+		Page:      1,
+		PageSize:  10,
+		Count:     pagit.CountEstimated,
+		Estimator: NewRedisHyperLogLogEstimator(client, "events:hll"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pagit.CountEstimated, page.CountSource)
+	assert.InDelta(t, 500, *page.TotalCount, 25)
+}
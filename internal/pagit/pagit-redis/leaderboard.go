@@ -0,0 +1,366 @@
+package pagitredis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/homveloper/dukdakit/internal/pagit"
+)
+
+// ErrMemberNotFound is returned when a leaderboard member does not exist
+var ErrMemberNotFound = errors.New("member not found in leaderboard")
+
+// RankedMember is a leaderboard entry with its 1-based rank
+type RankedMember struct {
+	Member string  `json:"member"`
+	Score  float64 `json:"score"`
+	Rank   int64   `json:"rank"`
+}
+
+// ScoreCursor identifies a position in a leaderboard by (score, member)
+// Unlike a bare score it stays exact when many members share a score
+type ScoreCursor struct {
+	Score  float64 `json:"score"`
+	Member string  `json:"member"`
+}
+
+// LeaderboardCursor extracts the cursor of an entry, for use with pagit.PaginateCursor
+func LeaderboardCursor(m RankedMember) ScoreCursor {
+	return ScoreCursor{Score: m.Score, Member: m.Member}
+}
+
+// LeaderboardConfig holds configuration for RedisLeaderboard
+type LeaderboardConfig struct {
+	// Order is SortDesc (highest score first, the default) or SortAsc
+	// Ties follow Redis: members in reverse lexicographic order when descending,
+	// lexicographic order when ascending
+	Order pagit.SortDirection
+}
+
+// LeaderboardOption configures a RedisLeaderboard
+type LeaderboardOption func(*LeaderboardConfig)
+
+// WithLeaderboardOrder sets the ranking order
+// Use pagit.SortAsc for "lowest wins" boards such as speedrun times
+func WithLeaderboardOrder(order pagit.SortDirection) LeaderboardOption {
+	return func(c *LeaderboardConfig) {
+		c.Order = order
+	}
+}
+
+// RedisLeaderboard paginates a Redis sorted set of member IDs by rank
+// It implements both offset pagination (pagit.DataProvider, pagit.CountProvider)
+// and (score, member) cursor pagination (pagit.CursorDataProvider,
+// pagit.CursorCheckProvider), and every returned entry carries its rank
+//
+// Example usage:
+//
+//	board := pagitredis.NewRedisLeaderboard(client, "season:3:scores")
+//
+//	// Top 20 by rank
+//	top, _ := board.GetData(ctx, 0, 20)
+//
+//	// The page around a player, then keep scrolling with cursors
+//	page, _ := board.Around(ctx, "player:42", 11)
+//	next, _ := pagit.PaginateCursor(ctx, board, pagit.CursorConfig[pagitredis.ScoreCursor]{
+//	    Cursor:   page.NextCursor,
+//	    PageSize: 20,
+//	}, pagitredis.LeaderboardCursor)
+type RedisLeaderboard struct {
+	client redis.Cmdable
+	key    string
+	order  pagit.SortDirection
+}
+
+// NewRedisLeaderboard creates a leaderboard over the sorted set at key
+func NewRedisLeaderboard(client redis.Cmdable, key string, options ...LeaderboardOption) *RedisLeaderboard {
+	config := LeaderboardConfig{Order: pagit.SortDesc}
+	for _, option := range options {
+		option(&config)
+	}
+
+	return &RedisLeaderboard{
+		client: client,
+		key:    key,
+		order:  config.Order,
+	}
+}
+
+// GetData implements pagit.DataProvider interface, paging by rank
+func (b *RedisLeaderboard) GetData(ctx context.Context, offset, limit int) ([]RankedMember, error) {
+	return b.rangeByRank(ctx, int64(offset), int64(offset+limit-1))
+}
+
+// GetTotalCount implements pagit.CountProvider interface
+func (b *RedisLeaderboard) GetTotalCount(ctx context.Context) (int64, error) {
+	count, err := b.client.ZCard(ctx, b.key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get leaderboard size: %w", err)
+	}
+	return count, nil
+}
+
+// GetDataAfter implements pagit.CursorDataProvider interface
+func (b *RedisLeaderboard) GetDataAfter(
+	ctx context.Context,
+	cursor *ScoreCursor,
+	limit int,
+) ([]RankedMember, error) {
+	var start int64
+	if cursor != nil {
+		before, at, err := b.position(ctx, *cursor)
+		if err != nil {
+			return nil, err
+		}
+		start = before
+		if at {
+			start++
+		}
+	}
+
+	return b.rangeByRank(ctx, start, start+int64(limit)-1)
+}
+
+// GetDataBefore implements pagit.CursorDataProvider interface
+// A nil cursor returns the last limit entries
+func (b *RedisLeaderboard) GetDataBefore(
+	ctx context.Context,
+	cursor *ScoreCursor,
+	limit int,
+) ([]RankedMember, error) {
+	var end int64
+	var err error
+	if cursor == nil {
+		end, err = b.GetTotalCount(ctx)
+	} else {
+		end, _, err = b.position(ctx, *cursor)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	start := max(end-int64(limit), 0)
+	if start >= end {
+		return []RankedMember{}, nil
+	}
+	return b.rangeByRank(ctx, start, end-1)
+}
+
+// HasDataAfter implements pagit.CursorCheckProvider interface
+func (b *RedisLeaderboard) HasDataAfter(
+	ctx context.Context,
+	cursor ScoreCursor,
+) (bool, error) {
+	before, at, err := b.position(ctx, cursor)
+	if err != nil {
+		return false, err
+	}
+	if at {
+		before++
+	}
+
+	total, err := b.GetTotalCount(ctx)
+	if err != nil {
+		return false, err
+	}
+	return before < total, nil
+}
+
+// HasDataBefore implements pagit.CursorCheckProvider interface
+func (b *RedisLeaderboard) HasDataBefore(
+	ctx context.Context,
+	cursor ScoreCursor,
+) (bool, error) {
+	before, _, err := b.position(ctx, cursor)
+	if err != nil {
+		return false, err
+	}
+	return before > 0, nil
+}
+
+// Rank returns a member's entry, or ErrMemberNotFound
+func (b *RedisLeaderboard) Rank(ctx context.Context, member string) (RankedMember, error) {
+	rank, err := b.rank(ctx, member)
+	if err != nil {
+		return RankedMember{}, err
+	}
+
+	score, err := b.client.ZScore(ctx, b.key, member).Result()
+	if errors.Is(err, redis.Nil) {
+		return RankedMember{}, fmt.Errorf("%w: %s", ErrMemberNotFound, member)
+	}
+	if err != nil {
+		return RankedMember{}, fmt.Errorf("failed to get member score: %w", err)
+	}
+
+	return RankedMember{Member: member, Score: score, Rank: rank + 1}, nil
+}
+
+// Around returns a window of size entries centred on member
+// Near the top or bottom of the board the window shifts so it stays full.
+// The result carries cursors and flags, so scrolling can continue with
+// pagit.PaginateCursor from either edge.
+func (b *RedisLeaderboard) Around(
+	ctx context.Context,
+	member string,
+	size int,
+) (pagit.CursorResult[RankedMember, ScoreCursor], error) {
+	if size <= 0 || size > pagit.MaxPageSize {
+		return pagit.CursorResult[RankedMember, ScoreCursor]{}, pagit.ErrInvalidPageSize
+	}
+
+	rank, err := b.rank(ctx, member)
+	if err != nil {
+		return pagit.CursorResult[RankedMember, ScoreCursor]{}, err
+	}
+
+	total, err := b.GetTotalCount(ctx)
+	if err != nil {
+		return pagit.CursorResult[RankedMember, ScoreCursor]{}, err
+	}
+
+	start := max(rank-int64(size-1)/2, 0)
+	if end := start + int64(size); end > total {
+		start = max(total-int64(size), 0)
+	}
+
+	data, err := b.rangeByRank(ctx, start, start+int64(size)-1)
+	if err != nil {
+		return pagit.CursorResult[RankedMember, ScoreCursor]{}, err
+	}
+
+	result := pagit.CursorResult[RankedMember, ScoreCursor]{
+		Data:    data,
+		Count:   len(data),
+		HasPrev: start > 0,
+		HasNext: start+int64(len(data)) < total,
+	}
+	if len(data) > 0 {
+		first := LeaderboardCursor(data[0])
+		last := LeaderboardCursor(data[len(data)-1])
+		result.PrevCursor = &first
+		result.NextCursor = &last
+	}
+	return result, nil
+}
+
+// rank returns the 0-based rank of member in board order
+func (b *RedisLeaderboard) rank(ctx context.Context, member string) (int64, error) {
+	var rank int64
+	var err error
+	if b.order == pagit.SortDesc {
+		rank, err = b.client.ZRevRank(ctx, b.key, member).Result()
+	} else {
+		rank, err = b.client.ZRank(ctx, b.key, member).Result()
+	}
+
+	if errors.Is(err, redis.Nil) {
+		return 0, fmt.Errorf("%w: %s", ErrMemberNotFound, member)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get member rank: %w", err)
+	}
+	return rank, nil
+}
+
+// position locates cursor in board order
+// before is the number of entries ranked ahead of the cursor; at reports
+// whether the cursor member is still on the board with the cursor score.
+// Cursors stay valid after their member moves or leaves the board.
+func (b *RedisLeaderboard) position(ctx context.Context, cursor ScoreCursor) (before int64, at bool, err error) {
+	// Fast path: the member is unchanged, so its rank is the position
+	score, err := b.client.ZScore(ctx, b.key, cursor.Member).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return 0, false, fmt.Errorf("failed to get member score: %w", err)
+	}
+	if err == nil && score == cursor.Score {
+		rank, err := b.rank(ctx, cursor.Member)
+		return rank, err == nil, err
+	}
+
+	// Count strictly better scores, then the tied members ordered ahead
+	below, ties, lexBefore, err := b.tiePosition(ctx, cursor)
+	if err != nil {
+		return 0, false, err
+	}
+	if b.order == pagit.SortAsc {
+		return below + lexBefore, false, nil
+	}
+
+	above, err := b.client.ZCount(ctx, b.key, "("+formatScore(cursor.Score), "+inf").Result()
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to count leaderboard members: %w", err)
+	}
+	// Descending boards order ties in reverse, so the members after cursor.Member come first
+	return above + ties - lexBefore, false, nil
+}
+
+// tiePosition locates cursor.Member among the members sharing cursor.Score
+// below is the number of members with a lower score, ties the size of the tied
+// run and lexBefore how many tied members sort lexicographically before the cursor.
+// Ties occupy consecutive ascending ranks ordered by member, so a binary search
+// reads O(log ties) single entries instead of loading the whole tied group.
+func (b *RedisLeaderboard) tiePosition(ctx context.Context, cursor ScoreCursor) (below, ties, lexBefore int64, err error) {
+	exact := formatScore(cursor.Score)
+	below, err = b.client.ZCount(ctx, b.key, "-inf", "("+exact).Result()
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("failed to count leaderboard members: %w", err)
+	}
+	ties, err = b.client.ZCount(ctx, b.key, exact, exact).Result()
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("failed to count tied members: %w", err)
+	}
+
+	lo, hi := int64(0), ties
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		rank := below + mid
+		entries, err := b.client.ZRange(ctx, b.key, rank, rank).Result()
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("failed to get tied member: %w", err)
+		}
+		if len(entries) == 1 && entries[0] < cursor.Member {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return below, ties, lo, nil
+}
+
+// rangeByRank returns entries with 0-based ranks start..stop inclusive
+func (b *RedisLeaderboard) rangeByRank(ctx context.Context, start, stop int64) ([]RankedMember, error) {
+	if start < 0 || stop < start {
+		return []RankedMember{}, nil
+	}
+
+	var values []redis.Z
+	var err error
+	if b.order == pagit.SortDesc {
+		values, err = b.client.ZRevRangeWithScores(ctx, b.key, start, stop).Result()
+	} else {
+		values, err = b.client.ZRangeWithScores(ctx, b.key, start, stop).Result()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard range: %w", err)
+	}
+
+	result := make([]RankedMember, len(values))
+	for i, z := range values {
+		result[i] = RankedMember{
+			Member: fmt.Sprint(z.Member),
+			Score:  z.Score,
+			Rank:   start + int64(i) + 1,
+		}
+	}
+	return result, nil
+}
+
+// formatScore renders a score exactly for ZCOUNT/ZRANGEBYSCORE bounds
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'g', -1, 64)
+}
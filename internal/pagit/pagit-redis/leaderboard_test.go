@@ -0,0 +1,234 @@
+package pagitredis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/homveloper/dukdakit/internal/pagit"
+)
+
+func seedLeaderboard(t *testing.T) *redis.Client {
+	mr, client := setupRedis(t)
+	t.Cleanup(func() {
+		client.Close()
+		mr.Close()
+	})
+
+	// Descending order: d(950), c(900), b(900), a(900), e(800), f(700)
+	require.NoError(t, client.ZAdd(context.Background(), "board",
+		redis.Z{Score: 900, Member: "a"},
+		redis.Z{Score: 900, Member: "b"},
+		redis.Z{Score: 900, Member: "c"},
+		redis.Z{Score: 950, Member: "d"},
+		redis.Z{Score: 800, Member: "e"},
+		redis.Z{Score: 700, Member: "f"},
+	).Err())
+	return client
+}
+
+func members(entries []RankedMember) []string {
+	result := make([]string, len(entries))
+	for i, e := range entries {
+		result[i] = e.Member
+	}
+	return result
+}
+
+func TestRedisLeaderboard_ByRank(t *testing.T) {
+	ctx := context.Background()
+	board := NewRedisLeaderboard(seedLeaderboard(t), "board")
+
+	page, err := board.GetData(ctx, 2, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []RankedMember{
+		{Member: "b", Score: 900, Rank: 3},
+		{Member: "a", Score: 900, Rank: 4},
+		{Member: "e", Score: 800, Rank: 5},
+	}, page)
+
+	total, err := board.GetTotalCount(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), total)
+}
+
+func TestRedisLeaderboard_Ascending(t *testing.T) {
+	board := NewRedisLeaderboard(seedLeaderboard(t), "board", WithLeaderboardOrder(pagit.SortAsc))
+
+	page, err := board.GetData(context.Background(), 0, 4)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"f", "e", "a", "b"}, members(page))
+	assert.Equal(t, int64(3), page[2].Rank)
+
+	entry, err := board.Rank(context.Background(), "d")
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), entry.Rank)
+}
+
+func TestRedisLeaderboard_CursorThroughTies(t *testing.T) {
+	ctx := context.Background()
+
+	for _, order := range []pagit.SortDirection{pagit.SortDesc, pagit.SortAsc} {
+		t.Run(order.String(), func(t *testing.T) {
+			board := NewRedisLeaderboard(seedLeaderboard(t), "board", WithLeaderboardOrder(order))
+			all, err := board.GetData(ctx, 0, 10)
+			require.NoError(t, err)
+
+			// Forward with a page size that splits the tied block
+			var forward []RankedMember
+			var pages []pagit.CursorResult[RankedMember, ScoreCursor]
+			config := pagit.CursorConfig[ScoreCursor]{PageSize: 2}
+			for {
+				result, err := pagit.PaginateCursor(ctx, board, config, LeaderboardCursor)
+				require.NoError(t, err)
+				pages = append(pages, result)
+				forward = append(forward, result.Data...)
+				if !result.HasNext {
+					break
+				}
+				config.Cursor = result.NextCursor
+			}
+			assert.Equal(t, all, forward)
+
+			// Backward from the end
+			var backward []RankedMember
+			config = pagit.CursorConfig[ScoreCursor]{PageSize: 2, Direction: pagit.CursorBackward}
+			for {
+				result, err := pagit.PaginateCursor(ctx, board, config, LeaderboardCursor)
+				require.NoError(t, err)
+				backward = append(result.Data, backward...)
+				if !result.HasPrev {
+					break
+				}
+				config.Cursor = result.PrevCursor
+			}
+			assert.Equal(t, all, backward)
+			assert.False(t, pages[0].HasPrev)
+		})
+	}
+}
+
+func TestRedisLeaderboard_CursorAfterMemberMoves(t *testing.T) {
+	ctx := context.Background()
+	client := seedLeaderboard(t)
+	board := NewRedisLeaderboard(client, "board")
+
+	first, err := board.GetData(ctx, 0, 3) // d, c, b
+	require.NoError(t, err)
+	cursor := LeaderboardCursor(first[2])
+
+	// b climbs to the top and a new tied member appears behind it
+	require.NoError(t, client.ZAdd(ctx, "board",
+		redis.Z{Score: 1000, Member: "b"},
+		redis.Z{Score: 900, Member: "bb"},
+	).Err())
+
+	next, err := board.GetDataAfter(ctx, &cursor, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "e", "f"}, members(next))
+	assert.Equal(t, int64(5), next[0].Rank)
+
+	// bb sorts ahead of the old cursor position among the 900s
+	has, err := board.HasDataBefore(ctx, cursor)
+	require.NoError(t, err)
+	assert.True(t, has)
+
+	before, err := board.GetDataBefore(ctx, &cursor, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c", "bb"}, members(before))
+}
+
+func TestRedisLeaderboard_LargeTieGroup(t *testing.T) {
+	ctx := context.Background()
+	mr, client := setupRedis(t)
+	t.Cleanup(func() {
+		client.Close()
+		mr.Close()
+	})
+
+	// 1000 members share a score; the cursor member then leaves the tie
+	ties := make([]redis.Z, 1000)
+	for i := range ties {
+		ties[i] = redis.Z{Score: 500, Member: fmt.Sprintf("m%04d", i)}
+	}
+	require.NoError(t, client.ZAdd(ctx, "board", ties...).Err())
+	require.NoError(t, client.ZAdd(ctx, "board",
+		redis.Z{Score: 900, Member: "top"},
+		redis.Z{Score: 100, Member: "bottom"},
+	).Err())
+	cursor := ScoreCursor{Score: 500, Member: "m0420"}
+	require.NoError(t, client.ZAdd(ctx, "board", redis.Z{Score: 50, Member: "m0420"}).Err())
+
+	for _, tc := range []struct {
+		order pagit.SortDirection
+		next  []string
+		rank  int64
+	}{
+		{pagit.SortDesc, []string{"m0419", "m0418"}, 581}, // top + m0999..m0421 ahead
+		{pagit.SortAsc, []string{"m0421", "m0422"}, 423},  // m0420, bottom + m0000..m0419 ahead
+	} {
+		t.Run(tc.order.String(), func(t *testing.T) {
+			board := NewRedisLeaderboard(client, "board", WithLeaderboardOrder(tc.order))
+
+			commands := mr.CommandCount()
+			next, err := board.GetDataAfter(ctx, &cursor, 2)
+			require.NoError(t, err)
+			assert.Equal(t, tc.next, members(next))
+			assert.Equal(t, tc.rank, next[0].Rank)
+
+			// The tied group is binary searched, not loaded
+			assert.Less(t, mr.CommandCount()-commands, 20)
+		})
+	}
+}
+
+func TestRedisLeaderboard_Around(t *testing.T) {
+	ctx := context.Background()
+	board := NewRedisLeaderboard(seedLeaderboard(t), "board")
+
+	result, err := board.Around(ctx, "a", 3)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b", "a", "e"}, members(result.Data))
+	assert.True(t, result.HasPrev)
+	assert.True(t, result.HasNext)
+
+	// Near the top the window shifts down to stay full
+	result, err = board.Around(ctx, "d", 3)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"d", "c", "b"}, members(result.Data))
+	assert.False(t, result.HasPrev)
+
+	// Near the bottom it shifts up
+	result, err = board.Around(ctx, "f", 4)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b", "a", "e", "f"}, members(result.Data))
+	assert.False(t, result.HasNext)
+
+	// Continue scrolling up from the window
+	prev, err := pagit.PaginateCursor(ctx, board, pagit.CursorConfig[ScoreCursor]{
+		Cursor:    result.PrevCursor,
+		PageSize:  5,
+		Direction: pagit.CursorBackward,
+	}, LeaderboardCursor)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"d", "c"}, members(prev.Data))
+	assert.False(t, prev.HasPrev)
+}
+
+func TestRedisLeaderboard_MemberNotFound(t *testing.T) {
+	board := NewRedisLeaderboard(seedLeaderboard(t), "board")
+
+	_, err := board.Around(context.Background(), "nobody", 5)
+	assert.True(t, errors.Is(err, ErrMemberNotFound))
+
+	_, err = board.Rank(context.Background(), "nobody")
+	assert.True(t, errors.Is(err, ErrMemberNotFound))
+
+	_, err = board.Around(context.Background(), "a", 0)
+	assert.Equal(t, pagit.ErrInvalidPageSize, err)
+}
@@ -56,7 +56,9 @@ func (p *RedisListProvider[T]) GetTotalCount(ctx context.Context) (int64, error)
 }
 
 // RedisSortedSetProvider provides cursor-based pagination for Redis Sorted Sets
-// Ideal for game scenarios like: leaderboards, time-ordered events, score rankings
+// Ideal for game scenarios like: time-ordered events with unique scores
+// The cursor is the score alone, so members sharing a score can be skipped;
+// use RedisLeaderboard for rankings where ties are common
 type RedisSortedSetProvider[T any] struct {
 	client    redis.Cmdable
 	key       string
@@ -79,17 +79,16 @@ func TestRedisListProvider_OffsetPagination(t *testing.T) {
 		result, err := dukdakit.PaginateOffset(ctx, provider, config)
 		require.NoError(t, err)
 
-		offsetResult, ok := result.(pagit.OffsetResult[Player])
-		require.True(t, ok)
-
-		assert.Len(t, offsetResult.Data, 2)
-		assert.Equal(t, int64(1), offsetResult.Data[0].ID)
-		assert.Equal(t, int64(2), offsetResult.Data[1].ID)
-		assert.Equal(t, int64(5), offsetResult.TotalCount)
-		assert.Equal(t, 1, offsetResult.Page)
-		assert.Equal(t, 3, offsetResult.TotalPages)
-		assert.True(t, offsetResult.HasNext)
-		assert.False(t, offsetResult.HasPrev)
+		require.NotNil(t, result.TotalCount)
+
+		assert.Len(t, result.Data, 2)
+		assert.Equal(t, int64(1), result.Data[0].ID)
+		assert.Equal(t, int64(2), result.Data[1].ID)
+		assert.Equal(t, int64(5), *result.TotalCount)
+		assert.Equal(t, 1, result.Page)
+		assert.Equal(t, 3, result.TotalPages)
+		assert.True(t, result.HasNext)
+		assert.False(t, result.HasPrev)
 	})
 
 	t.Run("SecondPage", func(t *testing.T) {
@@ -101,14 +100,13 @@ func TestRedisListProvider_OffsetPagination(t *testing.T) {
 		result, err := dukdakit.PaginateOffset(ctx, provider, config)
 		require.NoError(t, err)
 
-		offsetResult, ok := result.(pagit.OffsetResult[Player])
-		require.True(t, ok)
+		require.NotNil(t, result.TotalCount)
 
-		assert.Len(t, offsetResult.Data, 2)
-		assert.Equal(t, int64(3), offsetResult.Data[0].ID)
-		assert.Equal(t, int64(4), offsetResult.Data[1].ID)
-		assert.True(t, offsetResult.HasNext)
-		assert.True(t, offsetResult.HasPrev)
+		assert.Len(t, result.Data, 2)
+		assert.Equal(t, int64(3), result.Data[0].ID)
+		assert.Equal(t, int64(4), result.Data[1].ID)
+		assert.True(t, result.HasNext)
+		assert.True(t, result.HasPrev)
 	})
 
 	t.Run("LastPage", func(t *testing.T) {
@@ -120,13 +118,12 @@ func TestRedisListProvider_OffsetPagination(t *testing.T) {
 		result, err := dukdakit.PaginateOffset(ctx, provider, config)
 		require.NoError(t, err)
 
-		offsetResult, ok := result.(pagit.OffsetResult[Player])
-		require.True(t, ok)
+		require.NotNil(t, result.TotalCount)
 
-		assert.Len(t, offsetResult.Data, 1)
-		assert.Equal(t, int64(5), offsetResult.Data[0].ID)
-		assert.False(t, offsetResult.HasNext)
-		assert.True(t, offsetResult.HasPrev)
+		assert.Len(t, result.Data, 1)
+		assert.Equal(t, int64(5), result.Data[0].ID)
+		assert.False(t, result.HasNext)
+		assert.True(t, result.HasPrev)
 	})
 
 	t.Run("EmptyPage", func(t *testing.T) {
@@ -138,12 +135,11 @@ func TestRedisListProvider_OffsetPagination(t *testing.T) {
 		result, err := dukdakit.PaginateOffset(ctx, provider, config)
 		require.NoError(t, err)
 
-		offsetResult, ok := result.(pagit.OffsetResult[Player])
-		require.True(t, ok)
+		require.NotNil(t, result.TotalCount)
 
-		assert.Empty(t, offsetResult.Data)
-		assert.False(t, offsetResult.HasNext)
-		assert.True(t, offsetResult.HasPrev)
+		assert.Empty(t, result.Data)
+		assert.False(t, result.HasNext)
+		assert.True(t, result.HasPrev)
 	})
 }
 
@@ -284,15 +280,14 @@ func TestRedisHashProvider_OffsetPagination(t *testing.T) {
 		result, err := dukdakit.PaginateOffset(ctx, provider, config)
 		require.NoError(t, err)
 
-		offsetResult, ok := result.(pagit.OffsetResult[Item])
-		require.True(t, ok)
+		require.NotNil(t, result.TotalCount)
 
-		assert.Len(t, offsetResult.Data, 2)
-		assert.Equal(t, int64(5), offsetResult.TotalCount)
-		assert.Equal(t, 1, offsetResult.Page)
-		assert.Equal(t, 3, offsetResult.TotalPages)
-		assert.True(t, offsetResult.HasNext)
-		assert.False(t, offsetResult.HasPrev)
+		assert.Len(t, result.Data, 2)
+		assert.Equal(t, int64(5), *result.TotalCount)
+		assert.Equal(t, 1, result.Page)
+		assert.Equal(t, 3, result.TotalPages)
+		assert.True(t, result.HasNext)
+		assert.False(t, result.HasPrev)
 	})
 
 	t.Run("GetTotalCount", func(t *testing.T) {
@@ -320,11 +315,10 @@ func TestRedisProvider_ErrorHandling(t *testing.T) {
 		result, err := dukdakit.PaginateOffset(ctx, provider, config)
 		require.NoError(t, err)
 
-		offsetResult, ok := result.(pagit.OffsetResult[Player])
-		require.True(t, ok)
+		require.NotNil(t, result.TotalCount)
 
-		assert.Empty(t, offsetResult.Data)
-		assert.Equal(t, int64(0), offsetResult.TotalCount)
+		assert.Empty(t, result.Data)
+		assert.Equal(t, int64(0), *result.TotalCount)
 	})
 
 	t.Run("InvalidJSON", func(t *testing.T) {
@@ -408,12 +402,11 @@ func TestRedisProvider_GameScenarios(t *testing.T) {
 		result, err := dukdakit.PaginateOffset(ctx, provider, config)
 		require.NoError(t, err)
 
-		offsetResult, ok := result.(pagit.OffsetResult[GameEvent])
-		require.True(t, ok)
+		require.NotNil(t, result.TotalCount)
 
-		assert.Len(t, offsetResult.Data, 2)
-		assert.Equal(t, "login", offsetResult.Data[0].Type)
-		assert.Equal(t, "achievement", offsetResult.Data[1].Type)
-		assert.Equal(t, int64(4), offsetResult.TotalCount)
+		assert.Len(t, result.Data, 2)
+		assert.Equal(t, "login", result.Data[0].Type)
+		assert.Equal(t, "achievement", result.Data[1].Type)
+		assert.Equal(t, int64(4), *result.TotalCount)
 	})
 }
@@ -0,0 +1,81 @@
+package pagitsql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Dialect describes the SQL differences between database engines
+// Providers never concatenate raw identifiers or values; every identifier goes
+// through QuoteIdent and every value through a numbered placeholder
+type Dialect interface {
+	// Name returns the dialect name, e.g. "postgresql"
+	Name() string
+
+	// Placeholder returns the bind parameter for the n-th argument (1-based)
+	Placeholder(n int) string
+
+	// QuoteIdent quotes a possibly qualified identifier such as "players" or "p.score"
+	QuoteIdent(name string) string
+
+	// RowValues reports whether (a, b) > (?, ?) comparisons are supported
+	RowValues() bool
+}
+
+// CountEstimator is implemented by dialects that can estimate a table's row count
+// from statistics instead of scanning it
+type CountEstimator interface {
+	// EstimateCountQuery returns a query yielding the estimated row count of table
+	EstimateCountQuery(table string) (query string, args []any)
+}
+
+var (
+	// PostgreSQL uses $1 placeholders and "double quoted" identifiers
+	PostgreSQL Dialect = postgresDialect{}
+
+	// MySQL uses ? placeholders and `backtick quoted` identifiers
+	MySQL Dialect = mysqlDialect{}
+
+	// SQLite uses ? placeholders and "double quoted" identifiers
+	SQLite Dialect = sqliteDialect{}
+)
+
+type postgresDialect struct{}
+
+func (postgresDialect) Name() string                  { return "postgresql" }
+func (postgresDialect) Placeholder(n int) string      { return fmt.Sprintf("$%d", n) }
+func (postgresDialect) QuoteIdent(name string) string { return quoteIdent(name, `"`) }
+func (postgresDialect) RowValues() bool               { return true }
+
+// EstimateCountQuery reads the planner estimate, -1 for never analyzed tables
+func (d postgresDialect) EstimateCountQuery(table string) (string, []any) {
+	return "SELECT reltuples FROM pg_class WHERE oid = $1::regclass", []any{d.QuoteIdent(table)}
+}
+
+type mysqlDialect struct{}
+
+func (mysqlDialect) Name() string                  { return "mysql" }
+func (mysqlDialect) Placeholder(int) string        { return "?" }
+func (mysqlDialect) QuoteIdent(name string) string { return quoteIdent(name, "`") }
+func (mysqlDialect) RowValues() bool               { return true }
+
+// EstimateCountQuery reads the InnoDB statistics estimate
+func (mysqlDialect) EstimateCountQuery(table string) (string, []any) {
+	return "SELECT TABLE_ROWS FROM information_schema.TABLES WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ?", []any{table}
+}
+
+type sqliteDialect struct{}
+
+func (sqliteDialect) Name() string                  { return "sqlite" }
+func (sqliteDialect) Placeholder(int) string        { return "?" }
+func (sqliteDialect) QuoteIdent(name string) string { return quoteIdent(name, `"`) }
+func (sqliteDialect) RowValues() bool               { return true }
+
+// quoteIdent quotes each dot-separated part of name, doubling embedded quotes
+func quoteIdent(name, quote string) string {
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		parts[i] = quote + strings.ReplaceAll(part, quote, quote+quote) + quote
+	}
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,116 @@
+package pagitsql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/homveloper/dukdakit/internal/pagit"
+)
+
+func TestDialect_QuoteIdent(t *testing.T) {
+	assert.Equal(t, `"players"`, PostgreSQL.QuoteIdent("players"))
+	assert.Equal(t, `"p"."score"`, SQLite.QuoteIdent("p.score"))
+	assert.Equal(t, "`order`", MySQL.QuoteIdent("order"))
+
+	// Embedded quotes are doubled so identifiers cannot break out
+	assert.Equal(t, `"x"" OR 1=1 --"`, PostgreSQL.QuoteIdent(`x" OR 1=1 --`))
+	assert.Equal(t, "`a``b`", MySQL.QuoteIdent("a`b"))
+}
+
+func TestFilter_Render(t *testing.T) {
+	filter := And(
+		Eq("region", "kr"),
+		Or(Gte("level", 10), IsNull("banned_at")),
+		In("class", "warrior", "mage"),
+		Not(Like("name", "bot_%")),
+		nil,
+	)
+
+	b := newQueryBuilder(PostgreSQL)
+	filter.appendTo(b)
+	assert.Equal(t,
+		`("region" = $1 AND ("level" >= $2 OR "banned_at" IS NULL) AND "class" IN ($3, $4) AND NOT ("name" LIKE $5))`,
+		b.String())
+	assert.Equal(t, []any{"kr", 10, "warrior", "mage", "bot_%"}, b.args)
+
+	b = newQueryBuilder(MySQL)
+	filter.appendTo(b)
+	assert.Equal(t,
+		"(`region` = ? AND (`level` >= ? OR `banned_at` IS NULL) AND `class` IN (?, ?) AND NOT (`name` LIKE ?))",
+		b.String())
+}
+
+func TestFilter_EmptyGroups(t *testing.T) {
+	cases := []struct {
+		filter Filter
+		want   string
+	}{
+		{And(), "1 = 1"},
+		{Or(), "1 = 0"},
+		{In("id"), "1 = 0"},
+		{IsNotNull("bio"), `"bio" IS NOT NULL`},
+		{Not(nil), "NOT (1 = 1)"},
+		{Not(And()), "NOT (1 = 1)"},
+	}
+	for _, tc := range cases {
+		b := newQueryBuilder(SQLite)
+		tc.filter.appendTo(b)
+		assert.Equal(t, tc.want, b.String())
+	}
+}
+
+func TestKeysetCondition_Dialects(t *testing.T) {
+	mixed := pagit.Keyset[playerRank]{
+		pagit.OrderedKey("score", pagit.SortDesc, func(c playerRank) int { return c.Score }),
+		pagit.OrderedKey("id", pagit.SortAsc, func(c playerRank) int64 { return c.ID }),
+	}
+	cursor := playerRank{Score: 900, ID: 3}
+
+	b := newQueryBuilder(MySQL)
+	writeKeysetCondition(b, mixed, cursor)
+	assert.Equal(t, "((`score` < ?) OR (`score` = ? AND `id` > ?))", b.String())
+	assert.Equal(t, []any{900, 900, int64(3)}, b.args)
+
+	same := pagit.Keyset[playerRank]{
+		pagit.OrderedKey("score", pagit.SortDesc, func(c playerRank) int { return c.Score }),
+		pagit.OrderedKey("id", pagit.SortDesc, func(c playerRank) int64 { return c.ID }),
+	}
+	b = newQueryBuilder(PostgreSQL)
+	writeKeysetCondition(b, same, cursor)
+	assert.Equal(t, `("score", "id") < ($1, $2)`, b.String())
+}
+
+type auditFields struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time `db:"modified_at"`
+}
+
+type taggedPlayer struct {
+	ID       int64  `db:"id"`
+	PlayerID string // player_id
+	Name     string `db:"display_name,omitempty"`
+	Session  string `db:"-"`
+	secret   string
+	auditFields
+}
+
+func TestColumns(t *testing.T) {
+	assert.Equal(t,
+		[]string{"id", "player_id", "display_name", "created_at", "modified_at"},
+		Columns[taggedPlayer]())
+	assert.Nil(t, Columns[int]())
+}
+
+func TestSnakeCase(t *testing.T) {
+	for in, want := range map[string]string{
+		"ID":         "id",
+		"PlayerID":   "player_id",
+		"CreatedAt":  "created_at",
+		"HTTPStatus": "http_status",
+		"Level2Boss": "level2_boss",
+	} {
+		assert.Equal(t, want, snakeCase(in), in)
+	}
+}
@@ -0,0 +1,184 @@
+package pagitsql
+
+import (
+	"strings"
+)
+
+// Filter is a parameterised WHERE condition
+// Values are always sent as bind arguments, never spliced into the query
+//
+// Example usage:
+//
+//	filter := pagitsql.And(
+//	    pagitsql.Eq("region", "kr"),
+//	    pagitsql.Gte("level", 10),
+//	    pagitsql.In("class", "warrior", "mage"),
+//	)
+type Filter interface {
+	appendTo(b *queryBuilder)
+}
+
+// Eq matches rows where column = value
+func Eq(column string, value any) Filter { return comparison{column, "=", value} }
+
+// Ne matches rows where column <> value
+func Ne(column string, value any) Filter { return comparison{column, "<>", value} }
+
+// Gt matches rows where column > value
+func Gt(column string, value any) Filter { return comparison{column, ">", value} }
+
+// Gte matches rows where column >= value
+func Gte(column string, value any) Filter { return comparison{column, ">=", value} }
+
+// Lt matches rows where column < value
+func Lt(column string, value any) Filter { return comparison{column, "<", value} }
+
+// Lte matches rows where column <= value
+func Lte(column string, value any) Filter { return comparison{column, "<=", value} }
+
+// Like matches rows where column LIKE pattern
+func Like(column string, pattern string) Filter { return comparison{column, "LIKE", pattern} }
+
+// In matches rows where column equals any of values
+// An empty value list matches nothing
+func In(column string, values ...any) Filter { return inFilter{column, values} }
+
+// IsNull matches rows where column IS NULL
+func IsNull(column string) Filter { return nullFilter{column: column} }
+
+// IsNotNull matches rows where column IS NOT NULL
+func IsNotNull(column string) Filter { return nullFilter{column: column, not: true} }
+
+// And matches rows satisfying every filter; nil filters are ignored
+// An empty And matches every row
+func And(filters ...Filter) Filter { return group{"AND", filters} }
+
+// Or matches rows satisfying any filter; nil filters are ignored
+// An empty Or matches nothing
+func Or(filters ...Filter) Filter { return group{"OR", filters} }
+
+// Not negates a filter
+// A nil filter is treated as an empty And, so Not(nil) matches nothing
+func Not(filter Filter) Filter { return notFilter{filter} }
+
+type comparison struct {
+	column   string
+	operator string
+	value    any
+}
+
+func (f comparison) appendTo(b *queryBuilder) {
+	b.ident(f.column)
+	b.write(" " + f.operator + " ")
+	b.arg(f.value)
+}
+
+type inFilter struct {
+	column string
+	values []any
+}
+
+func (f inFilter) appendTo(b *queryBuilder) {
+	if len(f.values) == 0 {
+		b.write("1 = 0")
+		return
+	}
+
+	b.ident(f.column)
+	b.write(" IN (")
+	for i, value := range f.values {
+		if i > 0 {
+			b.write(", ")
+		}
+		b.arg(value)
+	}
+	b.write(")")
+}
+
+type nullFilter struct {
+	column string
+	not    bool
+}
+
+func (f nullFilter) appendTo(b *queryBuilder) {
+	b.ident(f.column)
+	if f.not {
+		b.write(" IS NOT NULL")
+	} else {
+		b.write(" IS NULL")
+	}
+}
+
+type group struct {
+	operator string
+	filters  []Filter
+}
+
+func (f group) appendTo(b *queryBuilder) {
+	filters := make([]Filter, 0, len(f.filters))
+	for _, filter := range f.filters {
+		if filter != nil {
+			filters = append(filters, filter)
+		}
+	}
+
+	if len(filters) == 0 {
+		if f.operator == "AND" {
+			b.write("1 = 1")
+		} else {
+			b.write("1 = 0")
+		}
+		return
+	}
+
+	b.write("(")
+	for i, filter := range filters {
+		if i > 0 {
+			b.write(" " + f.operator + " ")
+		}
+		filter.appendTo(b)
+	}
+	b.write(")")
+}
+
+type notFilter struct {
+	filter Filter
+}
+
+func (f notFilter) appendTo(b *queryBuilder) {
+	b.write("NOT (")
+	if f.filter == nil {
+		b.write("1 = 1")
+	} else {
+		f.filter.appendTo(b)
+	}
+	b.write(")")
+}
+
+// queryBuilder accumulates SQL text and bind arguments for one query
+type queryBuilder struct {
+	dialect Dialect
+	sql     strings.Builder
+	args    []any
+}
+
+func newQueryBuilder(dialect Dialect) *queryBuilder {
+	return &queryBuilder{dialect: dialect}
+}
+
+func (b *queryBuilder) write(s string) {
+	b.sql.WriteString(s)
+}
+
+func (b *queryBuilder) ident(name string) {
+	b.sql.WriteString(b.dialect.QuoteIdent(name))
+}
+
+func (b *queryBuilder) arg(value any) {
+	b.args = append(b.args, value)
+	b.sql.WriteString(b.dialect.Placeholder(len(b.args)))
+}
+
+func (b *queryBuilder) String() string {
+	return b.sql.String()
+}
@@ -0,0 +1,151 @@
+package pagitsql
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// structFields caches the column to field index mapping per struct type
+var structFields sync.Map // map[reflect.Type]*fieldMap
+
+// fieldMap maps column names to struct field index paths
+type fieldMap struct {
+	columns []string
+	indexes map[string][]int
+}
+
+// Columns returns the column names of struct T in field order
+//
+// Column names come from the `db` struct tag; untagged fields use the
+// snake_case field name and `db:"-"` skips a field. Embedded structs are flattened.
+//
+// Example usage:
+//
+//	type Player struct {
+//	    ID        int64     `db:"id"`
+//	    Name      string    `db:"name"`
+//	    CreatedAt time.Time // created_at
+//	    Session   string    `db:"-"`
+//	}
+//
+//	pagitsql.Columns[Player]() // [id name created_at]
+func Columns[T any]() []string {
+	fields, err := fieldsOf(reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		return nil
+	}
+	return append([]string(nil), fields.columns...)
+}
+
+// ScanStruct scans the current row into a new T by matching column names to struct tags
+// It can be used as the Scanner of any provider that accepts func(*sql.Rows) (T, error)
+func ScanStruct[T any](rows *sql.Rows) (T, error) {
+	var item T
+
+	fields, err := fieldsOf(reflect.TypeOf(item))
+	if err != nil {
+		return item, err
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return item, fmt.Errorf("failed to read columns: %w", err)
+	}
+
+	value := reflect.ValueOf(&item).Elem()
+	dest := make([]any, len(columns))
+	for i, column := range columns {
+		index, ok := fields.indexes[column]
+		if !ok {
+			return item, fmt.Errorf("column %q has no matching field in %T", column, item)
+		}
+		dest[i] = value.FieldByIndex(index).Addr().Interface()
+	}
+
+	if err := rows.Scan(dest...); err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// fieldsOf returns the cached field map for struct type t
+func fieldsOf(t reflect.Type) (*fieldMap, error) {
+	if cached, ok := structFields.Load(t); ok {
+		return cached.(*fieldMap), nil
+	}
+
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("struct scanning requires a struct type, got %v", t)
+	}
+
+	fields := &fieldMap{indexes: make(map[string][]int)}
+	collectFields(t, nil, fields)
+
+	cached, _ := structFields.LoadOrStore(t, fields)
+	return cached.(*fieldMap), nil
+}
+
+func collectFields(t reflect.Type, parent []int, fields *fieldMap) {
+	var embedded [][]int
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		index := append(append([]int(nil), parent...), i)
+
+		tag, hasTag := field.Tag.Lookup("db")
+		if tag == "-" {
+			continue
+		}
+
+		if field.Anonymous && !hasTag && field.Type.Kind() == reflect.Struct {
+			embedded = append(embedded, index)
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		column := strings.Split(tag, ",")[0]
+		if column == "" {
+			column = snakeCase(field.Name)
+		}
+
+		if _, exists := fields.indexes[column]; exists {
+			continue
+		}
+		fields.columns = append(fields.columns, column)
+		fields.indexes[column] = index
+	}
+
+	// Embedded structs come last so outer fields shadow theirs
+	for _, index := range embedded {
+		collectFields(t.FieldByIndex(index[len(parent):]).Type, index, fields)
+	}
+}
+
+// snakeCase converts a Go field name to snake_case, keeping acronyms together
+// e.g. "PlayerID" becomes "player_id" and "HTTPStatus" becomes "http_status"
+func snakeCase(name string) string {
+	runes := []rune(name)
+
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				prev := runes[i-1]
+				nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+				if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+					b.WriteByte('_')
+				}
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,429 @@
+package pagitsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/homveloper/dukdakit/internal/pagit"
+)
+
+// Querier is satisfied by *sql.DB, *sql.Tx and *sql.Conn
+type Querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Order is one ORDER BY term
+type Order struct {
+	Column    string
+	Direction pagit.SortDirection
+}
+
+// Asc orders by column ascending
+func Asc(column string) Order { return Order{Column: column, Direction: pagit.SortAsc} }
+
+// Desc orders by column descending
+func Desc(column string) Order { return Order{Column: column, Direction: pagit.SortDesc} }
+
+// SQLOffsetProvider provides offset-based pagination for any database/sql driver
+// Ideal for game scenarios like: item catalogs, achievement lists, static data
+type SQLOffsetProvider[T any] struct {
+	db      Querier
+	dialect Dialect
+	table   string
+	columns []string
+	filter  Filter
+	orderBy []Order
+	scanner func(*sql.Rows) (T, error)
+}
+
+// SQLOffsetConfig holds configuration for the database/sql offset provider
+type SQLOffsetConfig[T any] struct {
+	DB      Querier
+	Dialect Dialect // Defaults to PostgreSQL
+	Table   string
+	Columns []string                   // Defaults to the `db` tagged columns of T
+	Filter  Filter                     // Optional parameterised filter, e.g. Eq("region", "kr")
+	OrderBy []Order                    // Defaults to id ASC
+	Scanner func(*sql.Rows) (T, error) // Defaults to ScanStruct[T]
+}
+
+// NewSQLOffsetProvider creates a database/sql offset provider
+//
+// Example usage:
+//
+//	provider := pagitsql.NewSQLOffsetProvider(pagitsql.SQLOffsetConfig[Item]{
+//	    DB:      db,
+//	    Dialect: pagitsql.MySQL,
+//	    Table:   "items",
+//	    Filter:  pagitsql.Gte("rarity", 3),
+//	    OrderBy: []pagitsql.Order{pagitsql.Desc("price"), pagitsql.Asc("id")},
+//	})
+func NewSQLOffsetProvider[T any](config SQLOffsetConfig[T]) *SQLOffsetProvider[T] {
+	if config.Dialect == nil {
+		config.Dialect = PostgreSQL
+	}
+	if len(config.Columns) == 0 {
+		config.Columns = Columns[T]()
+	}
+	if len(config.OrderBy) == 0 {
+		config.OrderBy = []Order{Asc("id")} // Default ordering
+	}
+	if config.Scanner == nil {
+		config.Scanner = ScanStruct[T]
+	}
+
+	return &SQLOffsetProvider[T]{
+		db:      config.DB,
+		dialect: config.Dialect,
+		table:   config.Table,
+		columns: config.Columns,
+		filter:  config.Filter,
+		orderBy: config.OrderBy,
+		scanner: config.Scanner,
+	}
+}
+
+// GetData implements pagit.DataProvider interface
+func (p *SQLOffsetProvider[T]) GetData(ctx context.Context, offset, limit int) ([]T, error) {
+	b := newQueryBuilder(p.dialect)
+	writeSelect(b, p.table, p.columns)
+	writeWhere(b, p.filter, nil)
+	writeOrderBy(b, p.orderBy)
+	b.write(fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset))
+
+	rows, err := p.db.QueryContext(ctx, b.String(), b.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	return scanRows(rows, p.scanner)
+}
+
+// GetTotalCount implements pagit.CountProvider interface
+func (p *SQLOffsetProvider[T]) GetTotalCount(ctx context.Context) (int64, error) {
+	b := newQueryBuilder(p.dialect)
+	b.write("SELECT COUNT(*) FROM ")
+	b.ident(p.table)
+	writeWhere(b, p.filter, nil)
+
+	var count int64
+	if err := p.db.QueryRowContext(ctx, b.String(), b.args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to get total count: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetEstimatedCount implements pagit.EstimatedCountProvider from table statistics
+// The estimate covers the whole table, so it is unavailable when a Filter is set
+// or the dialect has no statistics (SQLite)
+func (p *SQLOffsetProvider[T]) GetEstimatedCount(ctx context.Context) (int64, error) {
+	estimator, ok := p.dialect.(CountEstimator)
+	if !ok || p.filter != nil {
+		return 0, pagit.ErrTotalCountUnavailable
+	}
+
+	query, args := estimator.EstimateCountQuery(p.table)
+
+	var estimate sql.NullFloat64
+	if err := p.db.QueryRowContext(ctx, query, args...).Scan(&estimate); err != nil {
+		return 0, fmt.Errorf("failed to get estimated count: %w", err)
+	}
+	if !estimate.Valid || estimate.Float64 < 0 {
+		return 0, pagit.ErrTotalCountUnavailable
+	}
+
+	return int64(estimate.Float64), nil
+}
+
+// SQLCursorProvider provides keyset (cursor) pagination for any database/sql driver
+// Ideal for game scenarios like: leaderboards, activity feeds, real-time data
+type SQLCursorProvider[T any, C comparable] struct {
+	db      Querier
+	dialect Dialect
+	table   string
+	columns []string
+	filter  Filter
+	keyset  pagit.Keyset[C]
+	scanner func(*sql.Rows) (T, error)
+}
+
+// SQLCursorConfig holds configuration for the database/sql cursor provider
+type SQLCursorConfig[T any, C comparable] struct {
+	DB      Querier
+	Dialect Dialect // Defaults to PostgreSQL
+	Table   string
+	Columns []string // Defaults to the `db` tagged columns of T
+	Filter  Filter   // Optional parameterised filter
+
+	// Keyset lists the cursor columns with their sort direction
+	// The last column should be unique, typically the primary key
+	Keyset pagit.Keyset[C]
+
+	Scanner func(*sql.Rows) (T, error) // Defaults to ScanStruct[T]
+}
+
+// NewSQLCursorProvider creates a database/sql cursor provider
+// Returns pagit.ErrEmptyKeyset when the config has no keyset fields
+//
+// Example usage:
+//
+//	provider, err := pagitsql.NewSQLCursorProvider(pagitsql.SQLCursorConfig[Player, RankCursor]{
+//	    DB:      db,
+//	    Dialect: pagitsql.SQLite,
+//	    Table:   "players",
+//	    Filter:  pagitsql.Eq("season", 3),
+//	    Keyset: pagit.Keyset[RankCursor]{
+//	        pagit.OrderedKey("score", pagit.SortDesc, func(c RankCursor) int { return c.Score }),
+//	        pagit.OrderedKey("id", pagit.SortAsc, func(c RankCursor) int64 { return c.ID }),
+//	    },
+//	})
+func NewSQLCursorProvider[T any, C comparable](config SQLCursorConfig[T, C]) (*SQLCursorProvider[T, C], error) {
+	if err := config.Keyset.Validate(); err != nil {
+		return nil, err
+	}
+	if config.Dialect == nil {
+		config.Dialect = PostgreSQL
+	}
+	if len(config.Columns) == 0 {
+		config.Columns = Columns[T]()
+	}
+	if config.Scanner == nil {
+		config.Scanner = ScanStruct[T]
+	}
+
+	return &SQLCursorProvider[T, C]{
+		db:      config.DB,
+		dialect: config.Dialect,
+		table:   config.Table,
+		columns: config.Columns,
+		filter:  config.Filter,
+		keyset:  config.Keyset,
+		scanner: config.Scanner,
+	}, nil
+}
+
+// GetDataAfter implements pagit.CursorDataProvider interface
+func (p *SQLCursorProvider[T, C]) GetDataAfter(
+	ctx context.Context,
+	cursor *C,
+	limit int,
+) ([]T, error) {
+	return p.query(ctx, cursor, p.keyset, limit)
+}
+
+// GetDataBefore implements pagit.CursorDataProvider interface
+func (p *SQLCursorProvider[T, C]) GetDataBefore(
+	ctx context.Context,
+	cursor *C,
+	limit int,
+) ([]T, error) {
+	// Walk the reversed order from the cursor, then restore the forward order
+	items, err := p.query(ctx, cursor, p.keyset.Reverse(), limit)
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(items)
+	return items, nil
+}
+
+// HasDataAfter implements pagit.CursorCheckProvider interface
+func (p *SQLCursorProvider[T, C]) HasDataAfter(
+	ctx context.Context,
+	cursor C,
+) (bool, error) {
+	return p.exists(ctx, cursor, p.keyset)
+}
+
+// HasDataBefore implements pagit.CursorCheckProvider interface
+func (p *SQLCursorProvider[T, C]) HasDataBefore(
+	ctx context.Context,
+	cursor C,
+) (bool, error) {
+	return p.exists(ctx, cursor, p.keyset.Reverse())
+}
+
+// query fetches rows following cursor in keyset order
+func (p *SQLCursorProvider[T, C]) query(
+	ctx context.Context,
+	cursor *C,
+	keyset pagit.Keyset[C],
+	limit int,
+) ([]T, error) {
+	query, args := p.buildQuery(cursor, keyset, limit)
+
+	rows, err := p.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute cursor query: %w", err)
+	}
+	defer rows.Close()
+
+	return scanRows(rows, p.scanner)
+}
+
+// exists checks whether any row follows cursor in keyset order
+func (p *SQLCursorProvider[T, C]) exists(
+	ctx context.Context,
+	cursor C,
+	keyset pagit.Keyset[C],
+) (bool, error) {
+	b := newQueryBuilder(p.dialect)
+	b.write("SELECT 1 FROM ")
+	b.ident(p.table)
+	writeWhere(b, p.filter, func(b *queryBuilder) { writeKeysetCondition(b, keyset, cursor) })
+	b.write(" LIMIT 1")
+
+	var one int
+	err := p.db.QueryRowContext(ctx, b.String(), b.args...).Scan(&one)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check cursor: %w", err)
+	}
+	return true, nil
+}
+
+// buildQuery constructs a keyset query, returning the SQL and its bind arguments
+func (p *SQLCursorProvider[T, C]) buildQuery(cursor *C, keyset pagit.Keyset[C], limit int) (string, []any) {
+	b := newQueryBuilder(p.dialect)
+	writeSelect(b, p.table, p.columns)
+
+	var condition func(*queryBuilder)
+	if cursor != nil {
+		condition = func(b *queryBuilder) { writeKeysetCondition(b, keyset, *cursor) }
+	}
+	writeWhere(b, p.filter, condition)
+
+	orderBy := make([]Order, len(keyset))
+	for i, field := range keyset {
+		orderBy[i] = Order{Column: field.Column, Direction: field.Direction}
+	}
+	writeOrderBy(b, orderBy)
+	b.write(fmt.Sprintf(" LIMIT %d", limit))
+
+	return b.String(), b.args
+}
+
+// writeSelect writes SELECT columns FROM table
+func writeSelect(b *queryBuilder, table string, columns []string) {
+	b.write("SELECT ")
+	if len(columns) == 0 {
+		b.write("*")
+	}
+	for i, column := range columns {
+		if i > 0 {
+			b.write(", ")
+		}
+		b.ident(column)
+	}
+	b.write(" FROM ")
+	b.ident(table)
+}
+
+// writeWhere writes the WHERE clause combining filter and an optional cursor condition
+func writeWhere(b *queryBuilder, filter Filter, condition func(*queryBuilder)) {
+	switch {
+	case filter == nil && condition == nil:
+		return
+	case condition == nil:
+		b.write(" WHERE ")
+		filter.appendTo(b)
+	case filter == nil:
+		b.write(" WHERE ")
+		condition(b)
+	default:
+		b.write(" WHERE ")
+		filter.appendTo(b)
+		b.write(" AND ")
+		condition(b)
+	}
+}
+
+// writeOrderBy writes the ORDER BY clause
+func writeOrderBy(b *queryBuilder, orderBy []Order) {
+	b.write(" ORDER BY ")
+	for i, order := range orderBy {
+		if i > 0 {
+			b.write(", ")
+		}
+		b.ident(order.Column)
+		b.write(" " + order.Direction.String())
+	}
+}
+
+// writeKeysetCondition writes the predicate selecting rows after cursor in keyset order
+//
+// When every column shares a direction and the dialect supports it, a row-value
+// comparison is used, which a matching composite index can serve:
+//
+//	("score", "id") < ($1, $2)
+//
+// Mixed directions fall back to the expanded form, binding each value once per use
+// so positional ? placeholders stay correct:
+//
+//	(("score" < ?) OR ("score" = ? AND "id" > ?))
+func writeKeysetCondition[C any](b *queryBuilder, keyset pagit.Keyset[C], cursor C) {
+	values := keyset.Values(cursor)
+
+	if len(keyset) > 1 && keyset.Uniform() && b.dialect.RowValues() {
+		b.write("(")
+		for i, field := range keyset {
+			if i > 0 {
+				b.write(", ")
+			}
+			b.ident(field.Column)
+		}
+		b.write(") " + keyset[0].Direction.Operator() + " (")
+		for i, value := range values {
+			if i > 0 {
+				b.write(", ")
+			}
+			b.arg(value)
+		}
+		b.write(")")
+		return
+	}
+
+	b.write("(")
+	for i, field := range keyset {
+		if i > 0 {
+			b.write(" OR ")
+		}
+		b.write("(")
+		for j := 0; j < i; j++ {
+			b.ident(keyset[j].Column)
+			b.write(" = ")
+			b.arg(values[j])
+			b.write(" AND ")
+		}
+		b.ident(field.Column)
+		b.write(" " + field.Direction.Operator() + " ")
+		b.arg(values[i])
+		b.write(")")
+	}
+	b.write(")")
+}
+
+// scanRows scans every row with scanner
+func scanRows[T any](rows *sql.Rows, scanner func(*sql.Rows) (T, error)) ([]T, error) {
+	var result []T
+	for rows.Next() {
+		item, err := scanner(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		result = append(result, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return result, nil
+}
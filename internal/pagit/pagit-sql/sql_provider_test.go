@@ -0,0 +1,255 @@
+package pagitsql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/homveloper/dukdakit/internal/pagit"
+)
+
+type Player struct {
+	ID        int64  `db:"id"`
+	Name      string `db:"name"`
+	Score     int    `db:"score"`
+	Region    string `db:"region"`
+	CreatedAt string `db:"created_at"`
+}
+
+type playerRank struct {
+	Score int
+	ID    int64
+}
+
+func playerRankOf(p Player) playerRank {
+	return playerRank{Score: p.Score, ID: p.ID}
+}
+
+// Score DESC, id ASC: mixed directions use the expanded OR form
+var rankKeyset = pagit.Keyset[playerRank]{
+	pagit.OrderedKey("score", pagit.SortDesc, func(c playerRank) int { return c.Score }),
+	pagit.OrderedKey("id", pagit.SortAsc, func(c playerRank) int64 { return c.ID }),
+}
+
+func setupTestDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	_, err = db.Exec(`CREATE TABLE players (
+		id INTEGER PRIMARY KEY,
+		name TEXT NOT NULL,
+		score INTEGER NOT NULL,
+		region TEXT NOT NULL,
+		created_at TEXT NOT NULL
+	)`)
+	require.NoError(t, err)
+
+	players := []Player{
+		{ID: 1, Name: "A", Score: 900, Region: "kr", CreatedAt: "2024-01-01"},
+		{ID: 2, Name: "B", Score: 950, Region: "us", CreatedAt: "2024-01-02"},
+		{ID: 3, Name: "C", Score: 900, Region: "kr", CreatedAt: "2024-01-03"},
+		{ID: 4, Name: "D", Score: 800, Region: "kr", CreatedAt: "2024-01-04"},
+		{ID: 5, Name: "E", Score: 900, Region: "us", CreatedAt: "2024-01-05"},
+		{ID: 6, Name: "F'); DROP TABLE players; --", Score: 700, Region: "kr", CreatedAt: "2024-01-06"},
+	}
+	for _, p := range players {
+		_, err := db.Exec("INSERT INTO players (id, name, score, region, created_at) VALUES (?, ?, ?, ?, ?)",
+			p.ID, p.Name, p.Score, p.Region, p.CreatedAt)
+		require.NoError(t, err)
+	}
+
+	return db
+}
+
+func ids(players []Player) []int64 {
+	result := make([]int64, len(players))
+	for i, p := range players {
+		result[i] = p.ID
+	}
+	return result
+}
+
+func TestSQLOffsetProvider(t *testing.T) {
+	ctx := context.Background()
+	provider := NewSQLOffsetProvider(SQLOffsetConfig[Player]{
+		DB:      setupTestDB(t),
+		Dialect: SQLite,
+		Table:   "players",
+		Filter:  Eq("region", "kr"),
+		OrderBy: []Order{Desc("score"), Asc("id")},
+	})
+
+	players, err := provider.GetData(ctx, 0, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 3, 4}, ids(players))
+	assert.Equal(t, "A", players[0].Name)
+	assert.Equal(t, "2024-01-01", players[0].CreatedAt)
+
+	players, err = provider.GetData(ctx, 3, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{6}, ids(players))
+
+	total, err := provider.GetTotalCount(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), total)
+}
+
+func TestSQLOffsetProvider_FilterValuesAreBound(t *testing.T) {
+	ctx := context.Background()
+	db := setupTestDB(t)
+	provider := NewSQLOffsetProvider(SQLOffsetConfig[Player]{
+		DB:      db,
+		Dialect: SQLite,
+		Table:   "players",
+		Filter:  Eq("name", "F'); DROP TABLE players; --"),
+	})
+
+	players, err := provider.GetData(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{6}, ids(players))
+
+	var count int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM players").Scan(&count))
+	assert.Equal(t, 6, count)
+}
+
+func TestSQLOffsetProvider_CustomColumnsAndScanner(t *testing.T) {
+	provider := NewSQLOffsetProvider(SQLOffsetConfig[string]{
+		DB:      setupTestDB(t),
+		Dialect: SQLite,
+		Table:   "players",
+		Columns: []string{"name"},
+		Filter:  In("id", 2, 4),
+		Scanner: func(rows *sql.Rows) (string, error) {
+			var name string
+			err := rows.Scan(&name)
+			return name, err
+		},
+	})
+
+	names, err := provider.GetData(context.Background(), 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"B", "D"}, names)
+}
+
+func TestSQLCursorProvider_Paginate(t *testing.T) {
+	ctx := context.Background()
+	provider, err := NewSQLCursorProvider(SQLCursorConfig[Player, playerRank]{
+		DB:      setupTestDB(t),
+		Dialect: SQLite,
+		Table:   "players",
+		Keyset:  rankKeyset,
+	})
+	require.NoError(t, err)
+
+	var forward []int64
+	var last pagit.CursorResult[Player, playerRank]
+	config := pagit.CursorConfig[playerRank]{PageSize: 2}
+	for {
+		result, err := pagit.PaginateCursor(ctx, provider, config, playerRankOf)
+		require.NoError(t, err)
+		forward = append(forward, ids(result.Data)...)
+		last = result
+		if !result.HasNext {
+			break
+		}
+		config.Cursor = result.NextCursor
+	}
+	assert.Equal(t, []int64{2, 1, 3, 5, 4, 6}, forward)
+
+	result, err := pagit.PaginateCursor(ctx, provider, pagit.CursorConfig[playerRank]{
+		Cursor:    last.PrevCursor,
+		PageSize:  2,
+		Direction: pagit.CursorBackward,
+	}, playerRankOf)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 5}, ids(result.Data))
+	assert.True(t, result.HasPrev)
+	assert.True(t, result.HasNext)
+}
+
+func TestSQLCursorProvider_FilterAndExists(t *testing.T) {
+	ctx := context.Background()
+	provider, err := NewSQLCursorProvider(SQLCursorConfig[Player, playerRank]{
+		DB:      setupTestDB(t),
+		Dialect: SQLite,
+		Table:   "players",
+		Filter:  Eq("region", "kr"),
+		Keyset:  rankKeyset,
+	})
+	require.NoError(t, err)
+
+	cursor := playerRank{Score: 900, ID: 1}
+	players, err := provider.GetDataAfter(ctx, &cursor, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 4, 6}, ids(players))
+
+	has, err := provider.HasDataBefore(ctx, cursor)
+	require.NoError(t, err)
+	assert.False(t, has, "player 2 is ahead but filtered out by region")
+
+	has, err = provider.HasDataAfter(ctx, playerRank{Score: 700, ID: 6})
+	require.NoError(t, err)
+	assert.False(t, has)
+}
+
+func TestSQLCursorProvider_PostgreSQLQuery(t *testing.T) {
+	provider, err := NewSQLCursorProvider(SQLCursorConfig[Player, playerRank]{
+		Table:  "players",
+		Filter: Eq("region", "kr"),
+		Keyset: rankKeyset,
+	})
+	require.NoError(t, err)
+
+	cursor := playerRank{Score: 900, ID: 1}
+	query, args := provider.buildQuery(&cursor, rankKeyset, 3)
+	assert.Equal(t,
+		`SELECT "id", "name", "score", "region", "created_at" FROM "players" `+
+			`WHERE "region" = $1 AND (("score" < $2) OR ("score" = $3 AND "id" > $4)) `+
+			`ORDER BY "score" DESC, "id" ASC LIMIT 3`,
+		query)
+	assert.Equal(t, []any{"kr", 900, 900, int64(1)}, args)
+}
+
+func TestScanStruct_UnknownColumn(t *testing.T) {
+	type partial struct {
+		ID int64 `db:"id"`
+	}
+
+	provider := NewSQLOffsetProvider(SQLOffsetConfig[partial]{
+		DB:      setupTestDB(t),
+		Dialect: SQLite,
+		Table:   "players",
+		Columns: []string{"id", "name"},
+	})
+
+	_, err := provider.GetData(context.Background(), 0, 1)
+	assert.ErrorContains(t, err, `column "name" has no matching field`)
+}
+
+func TestSQLOffsetProvider_EstimatedCount(t *testing.T) {
+	ctx := context.Background()
+	provider := NewSQLOffsetProvider(SQLOffsetConfig[Player]{
+		DB:      setupTestDB(t),
+		Dialect: SQLite,
+		Table:   "players",
+	})
+
+	// SQLite keeps no row statistics, so the exact count is used instead
+	_, err := provider.GetEstimatedCount(ctx)
+	assert.ErrorIs(t, err, pagit.ErrTotalCountUnavailable)
+
+	page, err := pagit.PaginateOffset(ctx, provider, pagit.OffsetConfig{Page: 1, PageSize: 2, Count: pagit.CountEstimated})
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), *page.TotalCount)
+	assert.Equal(t, pagit.CountExact, page.CountSource)
+
+	query, args := PostgreSQL.(CountEstimator).EstimateCountQuery("game.players")
+	assert.Equal(t, "SELECT reltuples FROM pg_class WHERE oid = $1::regclass", query)
+	assert.Equal(t, []any{`"game"."players"`}, args)
+}
@@ -1,50 +1,270 @@
 package pagit
 
 import (
+	"cmp"
 	"context"
 	"slices"
+	"sort"
+	"sync"
 )
 
+// SliceConfig holds filtering and ordering for slice providers
+type SliceConfig[T any] struct {
+	// Filters keep only items for which every predicate returns true
+	Filters []func(T) bool
+
+	// Compare orders the items; nil keeps the order they were given in
+	// Ignored by keyset providers, which order by their keyset
+	Compare func(a, b T) int
+}
+
+// SliceOption configures a slice provider
+type SliceOption[T any] func(*SliceConfig[T])
+
+// WithSliceFilter keeps only items matching predicate
+// Several filters can be given; an item must match all of them
+func WithSliceFilter[T any](predicate func(T) bool) SliceOption[T] {
+	return func(c *SliceConfig[T]) {
+		c.Filters = append(c.Filters, predicate)
+	}
+}
+
+// WithSliceSort orders items by several keys; later keys break ties of earlier ones
+// The sort is stable, so items equal on every key keep their original order
+//
+// Example usage:
+//
+//	provider := NewSliceProvider(members,
+//	    WithSliceFilter(func(m Member) bool { return m.Online }),
+//	    WithSliceSort(
+//	        SortBy(func(m Member) int { return m.Level }, SortDesc),
+//	        SortBy(func(m Member) string { return m.Name }, SortAsc),
+//	    ),
+//	)
+func WithSliceSort[T any](keys ...func(a, b T) int) SliceOption[T] {
+	return func(c *SliceConfig[T]) {
+		c.Compare = func(a, b T) int {
+			for _, key := range keys {
+				if result := key(a, b); result != 0 {
+					return result
+				}
+			}
+			return 0
+		}
+	}
+}
+
+// SortBy returns a sort key comparing an ordered field in the given direction
+func SortBy[T any, V cmp.Ordered](get func(T) V, direction SortDirection) func(a, b T) int {
+	return func(a, b T) int {
+		result := cmp.Compare(get(a), get(b))
+		if direction == SortDesc {
+			return -result
+		}
+		return result
+	}
+}
+
+func buildSliceConfig[T any](options []SliceOption[T]) SliceConfig[T] {
+	var config SliceConfig[T]
+	for _, option := range options {
+		option(&config)
+	}
+	return config
+}
+
+// matches reports whether item passes every filter
+func (c SliceConfig[T]) matches(item T) bool {
+	for _, filter := range c.Filters {
+		if !filter(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// buildSliceView filters and sorts source into a new slice
+// Without filters or ordering the source itself is the view
+func buildSliceView[T any](source []T, filters []func(T) bool, compare func(a, b T) int) []T {
+	config := SliceConfig[T]{Filters: filters}
+	if len(filters) == 0 && compare == nil {
+		return source
+	}
+
+	view := make([]T, 0, len(source))
+	for _, item := range source {
+		if config.matches(item) {
+			view = append(view, item)
+		}
+	}
+	if compare != nil {
+		slices.SortStableFunc(view, compare)
+	}
+	return view
+}
+
+// insertSliceView returns a copy of view with the matching items inserted in order
+// Items land after existing equal items, as a stable re-sort would place them
+func insertSliceView[T any](view []T, items []T, filters []func(T) bool, compare func(a, b T) int) []T {
+	config := SliceConfig[T]{Filters: filters}
+	updated := slices.Clip(view)
+	for _, item := range items {
+		if !config.matches(item) {
+			continue
+		}
+		if compare == nil {
+			updated = append(updated, item)
+			continue
+		}
+		at := sort.Search(len(updated), func(i int) bool { return compare(updated[i], item) > 0 })
+		updated = slices.Insert(slices.Clip(updated), at, item)
+	}
+	return updated
+}
+
+// removeSliceItems returns a copy of data without the items matching remove
+func removeSliceItems[T any](data []T, remove func(T) bool) []T {
+	kept := make([]T, 0, len(data))
+	for _, item := range data {
+		if !remove(item) {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
+
 // SliceProvider provides pagination for in-memory slice data
+// Reads work on immutable snapshots and updates swap in a new snapshot,
+// so the provider is safe for concurrent use
 type SliceProvider[T any] struct {
-	data []T
+	mu     sync.RWMutex
+	source []T
+	data   []T // filtered and sorted view of source
+	config SliceConfig[T]
 }
 
 // NewSliceProvider creates a new slice provider for offset-based pagination
-func NewSliceProvider[T any](data []T) *SliceProvider[T] {
-	return &SliceProvider[T]{data: data}
+// Options filter and sort the data; without options it is paged as given
+func NewSliceProvider[T any](data []T, options ...SliceOption[T]) *SliceProvider[T] {
+	config := buildSliceConfig(options)
+	return &SliceProvider[T]{
+		source: data,
+		data:   buildSliceView(data, config.Filters, config.Compare),
+		config: config,
+	}
 }
 
 // GetData implements DataProvider interface
 func (p *SliceProvider[T]) GetData(ctx context.Context, offset, limit int) ([]T, error) {
-	if offset >= len(p.data) {
+	data := p.snapshot()
+	if offset >= len(data) {
 		return []T{}, nil
 	}
 
-	endIndex := min(offset+limit, len(p.data))
-	return p.data[offset:endIndex], nil
+	endIndex := min(offset+limit, len(data))
+	return slices.Clip(data[offset:endIndex]), nil
 }
 
 // GetTotalCount implements CountProvider interface
 func (p *SliceProvider[T]) GetTotalCount(ctx context.Context) (int64, error) {
-	return int64(len(p.data)), nil
+	return int64(len(p.snapshot())), nil
+}
+
+// Replace swaps the backing data, re-applying filters and ordering
+func (p *SliceProvider[T]) Replace(data []T) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.source = data
+	p.data = buildSliceView(data, p.config.Filters, p.config.Compare)
+}
+
+// Add inserts items, keeping the configured order
+func (p *SliceProvider[T]) Add(items ...T) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.source = append(slices.Clip(p.source), items...)
+	p.data = insertSliceView(p.data, items, p.config.Filters, p.config.Compare)
+}
+
+// RemoveFunc removes every item for which remove returns true
+// Returns the number of items removed from the backing data
+func (p *SliceProvider[T]) RemoveFunc(remove func(T) bool) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	before := len(p.source)
+	p.source = removeSliceItems(p.source, remove)
+	p.data = removeSliceItems(p.data, remove)
+	return before - len(p.source)
+}
+
+func (p *SliceProvider[T]) snapshot() []T {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.data
 }
 
 // SliceCursorProvider provides cursor-based pagination for in-memory slice data
+// Reads work on immutable snapshots and updates swap in a new snapshot,
+// so the provider is safe for concurrent use
 type SliceCursorProvider[T any, C comparable] struct {
-	data      []T
+	mu        sync.RWMutex
+	source    []T
+	data      []T // filtered and sorted view of source
+	index     map[C]int
 	extractor CursorExtractor[T, C]
+	config    SliceConfig[T]
+
+	// keyset orders data for composite cursors; nil means cursors are looked up by equality
+	keyset Keyset[C]
 }
 
 // NewSliceCursorProvider creates a new slice cursor provider
+// Options filter and sort the data. Cursors are found through a hash index;
+// a cursor whose item has been removed returns ErrInvalidCursor, so prefer
+// NewSliceKeysetProvider for data that changes while clients page through it
 func NewSliceCursorProvider[T any, C comparable](
 	data []T,
 	extractor CursorExtractor[T, C],
+	options ...SliceOption[T],
 ) *SliceCursorProvider[T, C] {
-	return &SliceCursorProvider[T, C]{
-		data:      data,
+	p := &SliceCursorProvider[T, C]{
 		extractor: extractor,
+		config:    buildSliceConfig(options),
 	}
+	p.replace(data)
+	return p
+}
+
+// NewSliceKeysetProvider creates a slice cursor provider ordered by a composite keyset
+// The data is copied and sorted by the keyset. Cursors are positioned by binary
+// search over the sorted data, so a cursor stays valid even if its row has been removed
+// Returns ErrEmptyKeyset when keyset has no fields
+//
+// Example usage:
+//
+//	provider, err := NewSliceKeysetProvider(entries,
+//	    func(e Entry) RankCursor { return RankCursor{e.Score, e.UpdatedAt, e.ID} },
+//	    rankKeyset)
+func NewSliceKeysetProvider[T any, C comparable](
+	data []T,
+	extractor CursorExtractor[T, C],
+	keyset Keyset[C],
+	options ...SliceOption[T],
+) (*SliceCursorProvider[T, C], error) {
+	if err := keyset.Validate(); err != nil {
+		return nil, err
+	}
+
+	p := &SliceCursorProvider[T, C]{
+		extractor: extractor,
+		config:    buildSliceConfig(options),
+		keyset:    keyset,
+	}
+	p.replace(data)
+	return p, nil
 }
 
 // GetDataAfter implements CursorDataProvider interface
@@ -53,26 +273,27 @@ func (p *SliceCursorProvider[T, C]) GetDataAfter(
 	cursor *C,
 	limit int,
 ) ([]T, error) {
+	data, index := p.snapshot()
+
 	if cursor == nil {
 		// Start from beginning
-		endIndex := min(limit, len(p.data))
-		return p.data[:endIndex], nil
+		endIndex := min(limit, len(data))
+		return slices.Clip(data[:endIndex]), nil
 	}
 
 	// Find cursor position
-	cursorIndex := p.findCursorIndex(*cursor)
-	if cursorIndex == -1 {
-		return []T{}, ErrInvalidCursor
+	startIndex, err := p.indexAfter(data, index, *cursor)
+	if err != nil {
+		return []T{}, err
 	}
 
 	// Return items after cursor
-	startIndex := cursorIndex + 1
-	if startIndex >= len(p.data) {
+	if startIndex >= len(data) {
 		return []T{}, nil
 	}
 
-	endIndex := min(startIndex+limit, len(p.data))
-	return p.data[startIndex:endIndex], nil
+	endIndex := min(startIndex+limit, len(data))
+	return slices.Clip(data[startIndex:endIndex]), nil
 }
 
 // GetDataBefore implements CursorDataProvider interface
@@ -81,25 +302,27 @@ func (p *SliceCursorProvider[T, C]) GetDataBefore(
 	cursor *C,
 	limit int,
 ) ([]T, error) {
+	data, index := p.snapshot()
+
 	if cursor == nil {
-		// No data before the beginning
-		return []T{}, nil
+		// Start from the end and return the last items
+		startIndex := max(0, len(data)-limit)
+		return slices.Clip(data[startIndex:]), nil
 	}
 
 	// Find cursor position
-	cursorIndex := p.findCursorIndex(*cursor)
-	if cursorIndex == -1 {
-		return []T{}, ErrInvalidCursor
+	endIndex, err := p.indexBefore(data, index, *cursor)
+	if err != nil {
+		return []T{}, err
 	}
 
 	// Return items before cursor
-	endIndex := cursorIndex
 	if endIndex <= 0 {
 		return []T{}, nil
 	}
 
 	startIndex := max(0, endIndex-limit)
-	return p.data[startIndex:endIndex], nil
+	return slices.Clip(data[startIndex:endIndex]), nil
 }
 
 // HasDataAfter implements CursorCheckProvider interface
@@ -107,12 +330,14 @@ func (p *SliceCursorProvider[T, C]) HasDataAfter(
 	ctx context.Context,
 	cursor C,
 ) (bool, error) {
-	cursorIndex := p.findCursorIndex(cursor)
-	if cursorIndex == -1 {
-		return false, ErrInvalidCursor
+	data, index := p.snapshot()
+
+	startIndex, err := p.indexAfter(data, index, cursor)
+	if err != nil {
+		return false, err
 	}
 
-	return cursorIndex+1 < len(p.data), nil
+	return startIndex < len(data), nil
 }
 
 // HasDataBefore implements CursorCheckProvider interface
@@ -120,17 +345,112 @@ func (p *SliceCursorProvider[T, C]) HasDataBefore(
 	ctx context.Context,
 	cursor C,
 ) (bool, error) {
-	cursorIndex := p.findCursorIndex(cursor)
-	if cursorIndex == -1 {
-		return false, ErrInvalidCursor
+	data, index := p.snapshot()
+
+	endIndex, err := p.indexBefore(data, index, cursor)
+	if err != nil {
+		return false, err
+	}
+
+	return endIndex > 0, nil
+}
+
+// Replace swaps the backing data, re-applying filters and ordering
+func (p *SliceCursorProvider[T, C]) Replace(data []T) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.replace(data)
+}
+
+// Add inserts items, keeping the configured order
+func (p *SliceCursorProvider[T, C]) Add(items ...T) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.source = append(slices.Clip(p.source), items...)
+	p.data = insertSliceView(p.data, items, p.config.Filters, p.compare())
+	p.index = p.buildIndex(p.data)
+}
+
+// RemoveFunc removes every item for which remove returns true
+// Returns the number of items removed from the backing data
+func (p *SliceCursorProvider[T, C]) RemoveFunc(remove func(T) bool) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	before := len(p.source)
+	p.source = removeSliceItems(p.source, remove)
+	p.data = removeSliceItems(p.data, remove)
+	p.index = p.buildIndex(p.data)
+	return before - len(p.source)
+}
+
+// replace rebuilds the view and index; callers hold the write lock or own p
+func (p *SliceCursorProvider[T, C]) replace(data []T) {
+	p.source = data
+	p.data = buildSliceView(data, p.config.Filters, p.compare())
+	p.index = p.buildIndex(p.data)
+}
+
+// compare returns the item ordering: the keyset when set, else the configured sort
+func (p *SliceCursorProvider[T, C]) compare() func(a, b T) int {
+	if p.keyset != nil {
+		return func(a, b T) int {
+			return p.keyset.Compare(p.extractor(a), p.extractor(b))
+		}
 	}
+	return p.config.Compare
+}
 
-	return cursorIndex > 0, nil
+// buildIndex maps each cursor to the position of its first item
+// Keyset providers seek by binary search and need no index
+func (p *SliceCursorProvider[T, C]) buildIndex(data []T) map[C]int {
+	if p.keyset != nil {
+		return nil
+	}
+
+	index := make(map[C]int, len(data))
+	for i, item := range data {
+		cursor := p.extractor(item)
+		if _, exists := index[cursor]; !exists {
+			index[cursor] = i
+		}
+	}
+	return index
 }
 
-// findCursorIndex finds the index of item with matching cursor value
-func (p *SliceCursorProvider[T, C]) findCursorIndex(cursor C) int {
-	return slices.IndexFunc(p.data, func(item T) bool {
-		return p.extractor(item) == cursor
-	})
+func (p *SliceCursorProvider[T, C]) snapshot() ([]T, map[C]int) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.data, p.index
+}
+
+// indexAfter returns the index of the first item after cursor
+func (p *SliceCursorProvider[T, C]) indexAfter(data []T, index map[C]int, cursor C) (int, error) {
+	if p.keyset != nil {
+		return sort.Search(len(data), func(i int) bool {
+			return p.keyset.Compare(p.extractor(data[i]), cursor) > 0
+		}), nil
+	}
+
+	cursorIndex, ok := index[cursor]
+	if !ok {
+		return 0, ErrInvalidCursor
+	}
+	return cursorIndex + 1, nil
+}
+
+// indexBefore returns the index just past the last item before cursor
+func (p *SliceCursorProvider[T, C]) indexBefore(data []T, index map[C]int, cursor C) (int, error) {
+	if p.keyset != nil {
+		return sort.Search(len(data), func(i int) bool {
+			return p.keyset.Compare(p.extractor(data[i]), cursor) >= 0
+		}), nil
+	}
+
+	cursorIndex, ok := index[cursor]
+	if !ok {
+		return 0, ErrInvalidCursor
+	}
+	return cursorIndex, nil
 }
@@ -0,0 +1,188 @@
+package pagit
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type guildMember struct {
+	ID     int64
+	Name   string
+	Level  int
+	Online bool
+}
+
+func memberID(m guildMember) int64 { return m.ID }
+
+func guildMembers() []guildMember {
+	return []guildMember{
+		{ID: 1, Name: "Aria", Level: 30, Online: true},
+		{ID: 2, Name: "Bolt", Level: 45, Online: false},
+		{ID: 3, Name: "Cyan", Level: 30, Online: true},
+		{ID: 4, Name: "Dusk", Level: 50, Online: true},
+		{ID: 5, Name: "Echo", Level: 10, Online: true},
+	}
+}
+
+func memberIDs(members []guildMember) []int64 {
+	ids := make([]int64, len(members))
+	for i, m := range members {
+		ids[i] = m.ID
+	}
+	return ids
+}
+
+var byLevelThenName = WithSliceSort(
+	SortBy(func(m guildMember) int { return m.Level }, SortDesc),
+	SortBy(func(m guildMember) string { return m.Name }, SortAsc),
+)
+
+func onlineOnly(m guildMember) bool { return m.Online }
+
+func TestSliceProvider_FilterAndSort(t *testing.T) {
+	ctx := context.Background()
+	data := guildMembers()
+	provider := NewSliceProvider(data, WithSliceFilter(onlineOnly), byLevelThenName)
+
+	page, err := PaginateOffset(ctx, provider, OffsetConfig{Page: 1, PageSize: 3})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{4, 1, 3}, memberIDs(page.Data))
+	assert.Equal(t, int64(4), *page.TotalCount)
+
+	// The caller's slice is left untouched
+	assert.Equal(t, memberIDs(guildMembers()), memberIDs(data))
+}
+
+func TestSliceProvider_Updates(t *testing.T) {
+	ctx := context.Background()
+	provider := NewSliceProvider(guildMembers(), WithSliceFilter(onlineOnly), byLevelThenName)
+
+	provider.Add(
+		guildMember{ID: 6, Name: "Bree", Level: 30, Online: true},
+		guildMember{ID: 7, Name: "Fern", Level: 99, Online: false},
+	)
+	all, err := provider.GetData(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{4, 1, 6, 3, 5}, memberIDs(all))
+
+	removed := provider.RemoveFunc(func(m guildMember) bool { return m.Level == 30 })
+	assert.Equal(t, 3, removed)
+	all, err = provider.GetData(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{4, 5}, memberIDs(all))
+
+	provider.Replace([]guildMember{{ID: 9, Online: true}})
+	count, err := provider.GetTotalCount(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestSliceProvider_PagesAreSnapshots(t *testing.T) {
+	ctx := context.Background()
+	provider := NewSliceProvider(guildMembers(), byLevelThenName)
+
+	page, err := provider.GetData(ctx, 0, 2)
+	require.NoError(t, err)
+
+	provider.Add(guildMember{ID: 8, Name: "Apex", Level: 100})
+	assert.Equal(t, []int64{4, 2}, memberIDs(page), "a returned page never changes underneath the caller")
+
+	// Appending to a page must not write into the provider's data
+	_ = append(page, guildMember{ID: 99})
+	next, err := provider.GetData(ctx, 2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2}, memberIDs(next))
+}
+
+func TestSliceCursorProvider_SortedWithIndex(t *testing.T) {
+	ctx := context.Background()
+	provider := NewSliceCursorProvider(guildMembers(), memberID, WithSliceFilter(onlineOnly), byLevelThenName)
+
+	cursor := int64(1)
+	page, err := provider.GetDataAfter(ctx, &cursor, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 5}, memberIDs(page))
+
+	before, err := provider.GetDataBefore(ctx, &cursor, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{4}, memberIDs(before))
+
+	// Filtered out members are not valid cursors
+	hidden := int64(2)
+	_, err = provider.GetDataAfter(ctx, &hidden, 10)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	provider.Add(guildMember{ID: 6, Name: "Bree", Level: 30, Online: true})
+	page, err = provider.GetDataAfter(ctx, &cursor, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{6, 3, 5}, memberIDs(page))
+}
+
+func TestSliceKeysetProvider_FilterAndUpdates(t *testing.T) {
+	ctx := context.Background()
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	entries := []rankEntry{
+		{ID: "a", Score: 100, UpdatedAt: base},
+		{ID: "b", Score: 300, UpdatedAt: base},
+		{ID: "c", Score: 200, UpdatedAt: base},
+		{ID: "x", Score: 999, UpdatedAt: base},
+	}
+	provider, err := NewSliceKeysetProvider(entries, rankOf, rankKeyset,
+		WithSliceFilter(func(e rankEntry) bool { return e.ID != "x" }))
+	require.NoError(t, err)
+
+	result, err := PaginateCursor(ctx, provider, CursorConfig[rankCursor]{PageSize: 2}, rankOf)
+	require.NoError(t, err)
+	assert.Equal(t, "b", result.Data[0].ID)
+	assert.Equal(t, "c", result.Data[1].ID)
+
+	// The row under the cursor disappears and a new one lands after it
+	provider.RemoveFunc(func(e rankEntry) bool { return e.ID == "c" })
+	provider.Add(rankEntry{ID: "d", Score: 150, UpdatedAt: base})
+
+	next, err := PaginateCursor(ctx, provider, CursorConfig[rankCursor]{Cursor: result.NextCursor, PageSize: 2}, rankOf)
+	require.NoError(t, err)
+	require.Len(t, next.Data, 2)
+	assert.Equal(t, "d", next.Data[0].ID)
+	assert.Equal(t, "a", next.Data[1].ID)
+}
+
+func TestSliceCursorProvider_ConcurrentUpdates(t *testing.T) {
+	ctx := context.Background()
+	provider, err := NewSliceKeysetProvider(nil, rankOf, rankKeyset)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				provider.Add(rankEntry{ID: string(rune('a'+w)) + string(rune('0'+i%10)), Score: int64(i)})
+				if i%10 == 9 {
+					provider.RemoveFunc(func(e rankEntry) bool { return e.Score < int64(i-50) })
+				}
+			}
+		}(w)
+	}
+
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				result, err := PaginateCursor(ctx, provider, CursorConfig[rankCursor]{PageSize: 10}, rankOf)
+				assert.NoError(t, err)
+				for j := 1; j < len(result.Data); j++ {
+					assert.LessOrEqual(t, rankKeyset.Compare(rankOf(result.Data[j-1]), rankOf(result.Data[j])), 0)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,270 @@
+package pagit
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// cursorTokenVersion is the current token format version
+// Bump it when the payload layout changes; older versions are rejected
+const cursorTokenVersion = 1
+
+// cursorTokenSigned marks tokens carrying an HMAC-SHA256 signature
+const cursorTokenSigned = 1 << 0
+
+// CursorToken is the decoded content of an opaque cursor token
+type CursorToken[C comparable] struct {
+	// Cursor is the position to continue from
+	Cursor C
+
+	// Direction is the pagination direction the token continues in
+	Direction CursorDirection
+
+	// PageSize is the page size of the request that produced the token
+	PageSize int
+
+	// FilterHash identifies the filter the token was issued for (see FilterHash)
+	FilterHash string
+}
+
+// cursorTokenPayload is the serialized form of CursorToken
+type cursorTokenPayload[C comparable] struct {
+	Cursor     C               `json:"c"`
+	Direction  CursorDirection `json:"d"`
+	PageSize   int             `json:"n"`
+	FilterHash string          `json:"f,omitempty"`
+}
+
+// TokenConfig holds configuration for cursor token encoding
+type TokenConfig struct {
+	// Secret signs tokens with HMAC-SHA256 when set
+	// Unsigned or tampered tokens are rejected by codecs with a secret
+	Secret []byte
+
+	// PreviousSecrets are still accepted when verifying, to allow key rotation
+	PreviousSecrets [][]byte
+}
+
+// TokenOption configures cursor token encoding
+type TokenOption func(*TokenConfig)
+
+// WithTokenSecret signs tokens with secret
+// previous secrets are accepted for verification only, so keys can be rotated
+// without invalidating cursors held by clients
+func WithTokenSecret(secret []byte, previous ...[]byte) TokenOption {
+	return func(config *TokenConfig) {
+		config.Secret = secret
+		config.PreviousSecrets = previous
+	}
+}
+
+// CursorCodec converts cursors to opaque URL-safe tokens and back
+// Clients only ever see the token, so internal IDs and scores are not exposed
+// and, with a secret, cannot be forged
+//
+// Token layout before base64url encoding:
+//
+//	version(1) | flags(1) | JSON payload | HMAC-SHA256(32, signed tokens only)
+//
+// Example usage:
+//
+//	codec := NewCursorCodec[int64](WithTokenSecret(secret))
+//	config := CursorConfig[int64]{PageSize: 20, Token: r.URL.Query().Get("cursor"), Codec: codec}
+//	result, err := PaginateCursor(ctx, provider, config, extractor)
+//	// respond with result.NextToken / result.PrevToken
+type CursorCodec[C comparable] struct {
+	config TokenConfig
+}
+
+// NewCursorCodec creates a cursor codec
+func NewCursorCodec[C comparable](options ...TokenOption) *CursorCodec[C] {
+	config := TokenConfig{}
+	for _, option := range options {
+		option(&config)
+	}
+	return &CursorCodec[C]{config: config}
+}
+
+// Encode serializes token into an opaque string
+func (c *CursorCodec[C]) Encode(token CursorToken[C]) (string, error) {
+	payload, err := json.Marshal(cursorTokenPayload[C]{
+		Cursor:     token.Cursor,
+		Direction:  token.Direction,
+		PageSize:   token.PageSize,
+		FilterHash: token.FilterHash,
+	})
+	if err != nil {
+		return "", fmt.Errorf("encode cursor: %w", err)
+	}
+
+	var flags byte
+	if len(c.config.Secret) > 0 {
+		flags |= cursorTokenSigned
+	}
+
+	raw := make([]byte, 0, 2+len(payload)+sha256.Size)
+	raw = append(raw, cursorTokenVersion, flags)
+	raw = append(raw, payload...)
+	if flags&cursorTokenSigned != 0 {
+		raw = append(raw, signCursorToken(c.config.Secret, raw)...)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// Decode parses and verifies a token produced by Encode
+// Every failure wraps ErrInvalidCursor
+func (c *CursorCodec[C]) Decode(token string) (CursorToken[C], error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(raw) < 2 {
+		return CursorToken[C]{}, fmt.Errorf("%w: malformed token", ErrInvalidCursor)
+	}
+
+	if raw[0] != cursorTokenVersion {
+		return CursorToken[C]{}, fmt.Errorf("%w: unsupported token version %d", ErrInvalidCursor, raw[0])
+	}
+
+	signed := raw[1]&cursorTokenSigned != 0
+	payload := raw[2:]
+
+	if len(c.config.Secret) > 0 {
+		if !signed || len(payload) < sha256.Size {
+			return CursorToken[C]{}, fmt.Errorf("%w: token is not signed", ErrInvalidCursor)
+		}
+		body := raw[:len(raw)-sha256.Size]
+		if !c.verify(body, raw[len(raw)-sha256.Size:]) {
+			return CursorToken[C]{}, fmt.Errorf("%w: signature mismatch", ErrInvalidCursor)
+		}
+		payload = raw[2 : len(raw)-sha256.Size]
+	} else if signed {
+		return CursorToken[C]{}, fmt.Errorf("%w: signed token but no secret configured", ErrInvalidCursor)
+	}
+
+	var decoded cursorTokenPayload[C]
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&decoded); err != nil {
+		return CursorToken[C]{}, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	if decoded.Direction != CursorForward && decoded.Direction != CursorBackward {
+		return CursorToken[C]{}, fmt.Errorf("%w: unknown direction %d", ErrInvalidCursor, decoded.Direction)
+	}
+	if decoded.PageSize <= 0 || decoded.PageSize > MaxPageSize {
+		return CursorToken[C]{}, fmt.Errorf("%w: page size %d out of range", ErrInvalidCursor, decoded.PageSize)
+	}
+
+	return CursorToken[C]{
+		Cursor:     decoded.Cursor,
+		Direction:  decoded.Direction,
+		PageSize:   decoded.PageSize,
+		FilterHash: decoded.FilterHash,
+	}, nil
+}
+
+// verify checks mac against the current and previous secrets
+func (c *CursorCodec[C]) verify(body, mac []byte) bool {
+	if hmac.Equal(mac, signCursorToken(c.config.Secret, body)) {
+		return true
+	}
+	for _, secret := range c.config.PreviousSecrets {
+		if hmac.Equal(mac, signCursorToken(secret, body)) {
+			return true
+		}
+	}
+	return false
+}
+
+func signCursorToken(secret, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// FilterHash returns a short stable hash of a filter value
+// Tokens carry the hash so a cursor issued for one filter cannot be replayed
+// against another; filter must be JSON serializable
+//
+// Example usage:
+//
+//	hash, err := FilterHash(map[string]any{"guild": guildID, "online": true})
+func FilterHash(filter any) (string, error) {
+	if filter == nil {
+		return "", nil
+	}
+
+	encoded, err := json.Marshal(filter)
+	if err != nil {
+		return "", fmt.Errorf("hash filter: %w", err)
+	}
+
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// resolveCursorToken applies config.Token to the cursor, direction and page size
+func resolveCursorToken[C comparable](config CursorConfig[C]) (CursorConfig[C], error) {
+	if config.Token == "" {
+		return config, nil
+	}
+	if config.Codec == nil {
+		return config, fmt.Errorf("%w: token given without codec", ErrInvalidCursor)
+	}
+
+	token, err := config.Codec.Decode(config.Token)
+	if err != nil {
+		return config, err
+	}
+	if token.FilterHash != config.FilterHash {
+		return config, fmt.Errorf("%w: token was issued for a different filter", ErrInvalidCursor)
+	}
+
+	cursor := token.Cursor
+	config.Cursor = &cursor
+	config.Direction = token.Direction
+	if config.PageSize == 0 {
+		config.PageSize = token.PageSize
+	}
+	return config, nil
+}
+
+// encodeResultTokens fills NextToken and PrevToken from the result cursors
+// Tokens are client facing, so they are only issued when there is a page to move to
+func encodeResultTokens[T any, C comparable](result *CursorResult[T, C], config CursorConfig[C]) error {
+	if config.Codec == nil {
+		return nil
+	}
+
+	if result.HasNext && result.NextCursor != nil {
+		token, err := config.Codec.Encode(CursorToken[C]{
+			Cursor:     *result.NextCursor,
+			Direction:  CursorForward,
+			PageSize:   config.PageSize,
+			FilterHash: config.FilterHash,
+		})
+		if err != nil {
+			return err
+		}
+		result.NextToken = token
+	}
+
+	if result.HasPrev && result.PrevCursor != nil {
+		token, err := config.Codec.Encode(CursorToken[C]{
+			Cursor:     *result.PrevCursor,
+			Direction:  CursorBackward,
+			PageSize:   config.PageSize,
+			FilterHash: config.FilterHash,
+		})
+		if err != nil {
+			return err
+		}
+		result.PrevToken = token
+	}
+
+	return nil
+}
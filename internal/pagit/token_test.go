@@ -0,0 +1,171 @@
+package pagit
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorCodec_RoundTrip(t *testing.T) {
+	codec := NewCursorCodec[int64]()
+	token := CursorToken[int64]{Cursor: 42, Direction: CursorBackward, PageSize: 25, FilterHash: "abc"}
+
+	encoded, err := codec.Encode(token)
+	require.NoError(t, err)
+	assert.NotContains(t, encoded, "42", "cursor is not readable in the token")
+
+	decoded, err := codec.Decode(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, token, decoded)
+}
+
+func TestCursorCodec_StructCursor(t *testing.T) {
+	type scoreCursor struct {
+		Score int64
+		ID    string
+	}
+
+	codec := NewCursorCodec[scoreCursor]()
+	token := CursorToken[scoreCursor]{Cursor: scoreCursor{Score: 1500, ID: "p1"}, PageSize: 10}
+
+	encoded, err := codec.Encode(token)
+	require.NoError(t, err)
+
+	decoded, err := codec.Decode(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, token, decoded)
+}
+
+func TestCursorCodec_Signed(t *testing.T) {
+	signer := NewCursorCodec[int64](WithTokenSecret([]byte("secret")))
+	token := CursorToken[int64]{Cursor: 7, PageSize: 10}
+
+	encoded, err := signer.Encode(token)
+	require.NoError(t, err)
+
+	decoded, err := signer.Decode(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, token, decoded)
+
+	t.Run("tampered", func(t *testing.T) {
+		raw, err := base64.RawURLEncoding.DecodeString(encoded)
+		require.NoError(t, err)
+		raw[5] ^= 0xFF
+		_, err = signer.Decode(base64.RawURLEncoding.EncodeToString(raw))
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		other := NewCursorCodec[int64](WithTokenSecret([]byte("other")))
+		_, err := other.Decode(encoded)
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("rotated secret", func(t *testing.T) {
+		rotated := NewCursorCodec[int64](WithTokenSecret([]byte("new"), []byte("secret")))
+		decoded, err := rotated.Decode(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, token, decoded)
+	})
+
+	t.Run("unsigned token rejected", func(t *testing.T) {
+		unsigned, err := NewCursorCodec[int64]().Encode(token)
+		require.NoError(t, err)
+		_, err = signer.Decode(unsigned)
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+}
+
+func TestCursorCodec_InvalidTokens(t *testing.T) {
+	codec := NewCursorCodec[int64]()
+
+	cases := map[string]string{
+		"not base64":      "!!!",
+		"empty":           "",
+		"unknown version": base64.RawURLEncoding.EncodeToString([]byte{99, 0, '{', '}'}),
+		"bad json":        base64.RawURLEncoding.EncodeToString([]byte{cursorTokenVersion, 0, '{'}),
+		"wrong type":      base64.RawURLEncoding.EncodeToString(append([]byte{cursorTokenVersion, 0}, `{"c":"x","d":0,"n":1}`...)),
+		"bad page size":   base64.RawURLEncoding.EncodeToString(append([]byte{cursorTokenVersion, 0}, `{"c":1,"d":0,"n":0}`...)),
+		"bad direction":   base64.RawURLEncoding.EncodeToString(append([]byte{cursorTokenVersion, 0}, `{"c":1,"d":7,"n":5}`...)),
+	}
+
+	for name, token := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := codec.Decode(token)
+			assert.True(t, errors.Is(err, ErrInvalidCursor), "got %v", err)
+		})
+	}
+}
+
+func TestFilterHash(t *testing.T) {
+	a, err := FilterHash(map[string]any{"guild": "g1", "online": true})
+	require.NoError(t, err)
+	b, err := FilterHash(map[string]any{"online": true, "guild": "g1"})
+	require.NoError(t, err)
+	c, err := FilterHash(map[string]any{"guild": "g2", "online": true})
+	require.NoError(t, err)
+
+	assert.Equal(t, a, b, "map key order does not matter")
+	assert.NotEqual(t, a, c)
+
+	empty, err := FilterHash(nil)
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
+func TestPaginateCursor_WithTokens(t *testing.T) {
+	data := []TestItem{
+		{ID: 1, Name: "Item1"},
+		{ID: 2, Name: "Item2"},
+		{ID: 3, Name: "Item3"},
+		{ID: 4, Name: "Item4"},
+		{ID: 5, Name: "Item5"},
+	}
+	extractor := func(item TestItem) int64 { return item.ID }
+	provider := NewSliceCursorProvider(data, extractor)
+	codec := NewCursorCodec[int64](WithTokenSecret([]byte("secret")))
+	ctx := context.Background()
+
+	first, err := PaginateCursor(ctx, provider, CursorConfig[int64]{PageSize: 2, Codec: codec}, extractor)
+	require.NoError(t, err)
+	require.NotEmpty(t, first.NextToken)
+	assert.Empty(t, first.PrevToken)
+
+	// The client only sends back the token; page size comes from the token
+	second, err := PaginateCursor(ctx, provider, CursorConfig[int64]{Token: first.NextToken, Codec: codec}, extractor)
+	require.NoError(t, err)
+	require.Len(t, second.Data, 2)
+	assert.Equal(t, int64(3), second.Data[0].ID)
+	require.NotEmpty(t, second.PrevToken)
+
+	back, err := PaginateCursor(ctx, provider, CursorConfig[int64]{Token: second.PrevToken, Codec: codec}, extractor)
+	require.NoError(t, err)
+	require.Len(t, back.Data, 2)
+	assert.Equal(t, int64(1), back.Data[0].ID)
+}
+
+func TestPaginateCursor_TokenErrors(t *testing.T) {
+	data := []TestItem{{ID: 1, Name: "Item1"}, {ID: 2, Name: "Item2"}}
+	extractor := func(item TestItem) int64 { return item.ID }
+	provider := NewSliceCursorProvider(data, extractor)
+	codec := NewCursorCodec[int64]()
+	ctx := context.Background()
+
+	token, err := codec.Encode(CursorToken[int64]{Cursor: 1, PageSize: 1, FilterHash: "guild-a"})
+	require.NoError(t, err)
+
+	_, err = PaginateCursor(ctx, provider, CursorConfig[int64]{Token: token}, extractor)
+	assert.ErrorIs(t, err, ErrInvalidCursor, "token without codec")
+
+	_, err = PaginateCursor(ctx, provider, CursorConfig[int64]{Token: token, Codec: codec, FilterHash: "guild-b"}, extractor)
+	assert.ErrorIs(t, err, ErrInvalidCursor, "token replayed with another filter")
+
+	result, err := PaginateCursor(ctx, provider, CursorConfig[int64]{Token: token, Codec: codec, FilterHash: "guild-a"}, extractor)
+	require.NoError(t, err)
+	require.Len(t, result.Data, 1)
+	assert.Equal(t, int64(2), result.Data[0].ID)
+}
@@ -15,19 +15,69 @@ type CursorResult[T any, C comparable] struct {
 	// HasPrev indicates if there are items before this page  
 	HasPrev bool
 	
-	// NextCursor is the cursor to use for the next page
-	// Only set if HasNext is true
+	// NextCursor is the cursor to use for the next page (the last item)
+	// Set whenever the page is not empty, so feeds can poll for newer items;
+	// check HasNext to know whether a next page exists right now
 	NextCursor *C
 	
-	// PrevCursor is the cursor to use for the previous page
-	// Only set if HasPrev is true
+	// PrevCursor is the cursor to use for the previous page (the first item)
+	// Set whenever the page is not empty; check HasPrev
 	PrevCursor *C
 	
 	// Count is the number of items in this page
 	Count int
+
+	// NextToken is the opaque token form of NextCursor
+	// Only set when the request config carries a Codec and HasNext is true
+	NextToken string
+
+	// PrevToken is the opaque token form of PrevCursor
+	// Only set when the request config carries a Codec and HasPrev is true
+	PrevToken string
 }
 
-// OffsetResult represents the result of offset-based pagination
+// OffsetPage is the result of PaginateOffset
+type OffsetPage[T any] struct {
+	// Data contains the paginated items
+	Data []T
+
+	// TotalCount is the total number of items, or nil when the provider cannot count
+	TotalCount *int64
+
+	// TotalEstimated reports that TotalCount may differ from the current exact count,
+	// because it is an estimate or was served from a CountCache
+	TotalEstimated bool
+
+	// CountSource is the strategy that produced TotalCount
+	// CountNone when TotalCount is nil
+	CountSource CountStrategy
+
+	// Page is the current page number (1-based)
+	Page int
+
+	// PageSize is the number of items per page
+	PageSize int
+
+	// TotalPages is the total number of pages derived from TotalCount
+	// 0 when TotalCount is nil
+	TotalPages int
+
+	// HasNext indicates if there are more pages
+	// Always exact, even when the total is unknown or estimated
+	HasNext bool
+
+	// HasPrev indicates if there are previous pages
+	HasPrev bool
+
+	// Offset is the starting index of items in this page (0-based)
+	Offset int
+
+	// Count is the number of items in this page
+	Count int
+}
+
+// OffsetResult represents pagination metadata with an exact total count
+// Returned by GetPageInfo; PaginateOffset returns OffsetPage
 type OffsetResult[T any] struct {
 	// Data contains the paginated items
 	Data []T
@@ -68,6 +118,18 @@ type CursorConfig[C comparable] struct {
 	
 	// Direction specifies pagination direction
 	Direction CursorDirection
+
+	// Token is an opaque cursor token from a previous result
+	// When set, it replaces Cursor and Direction, and supplies PageSize if PageSize is 0
+	Token string
+
+	// Codec encodes and decodes tokens; required when Token is set
+	// When set, results carry NextToken and PrevToken
+	Codec *CursorCodec[C]
+
+	// FilterHash binds tokens to the request filter (see FilterHash)
+	// A token issued for a different filter is rejected with ErrInvalidCursor
+	FilterHash string
 }
 
 // OffsetConfig holds configuration for offset-based pagination
@@ -77,6 +139,24 @@ type OffsetConfig struct {
 	
 	// PageSize is the number of items per page
 	PageSize int
+
+	// MaxOffset rejects pages starting beyond this offset with ErrOffsetTooLarge
+	// Deep offsets make the database scan and discard every skipped row;
+	// 0 means no limit
+	MaxOffset int
+
+	// Count selects how the total count is obtained; CountExact by default
+	Count CountStrategy
+
+	// CountCache stores totals for CountCached
+	CountCache *CountCache
+
+	// CountKey identifies the counted query in CountCache, e.g. a FilterHash
+	// Empty keys skip the cache and count exactly
+	CountKey string
+
+	// Estimator overrides the provider's EstimatedCountProvider for CountEstimated
+	Estimator EstimatedCountProvider
 }
 
 // CursorDirection specifies the direction of cursor pagination
@@ -7,6 +7,8 @@ import (
 	"math/rand"
 	"sync"
 	"time"
+
+	"github.com/homveloper/dukdakit/internal/clock"
 )
 
 // Policy defines retry behavior
@@ -25,6 +27,10 @@ type RetryConfig struct {
 	Jitter          bool
 	RetryableErrors []string
 	CircuitBreaker  *CircuitBreakerConfig
+
+	// Clock is used to wait between attempts (default: system clock)
+	// The circuit breaker inherits it unless its own Clock is set
+	Clock clock.Clock
 }
 
 // CircuitBreakerConfig holds circuit breaker configuration
@@ -32,6 +38,9 @@ type CircuitBreakerConfig struct {
 	FailureThreshold int
 	ResetTimeout     time.Duration
 	HalfOpenTimeout  time.Duration
+
+	// Clock is used to track failure times (default: system clock)
+	Clock clock.Clock
 }
 
 // DefaultRetryConfig returns default retry configuration
@@ -129,6 +138,7 @@ type CircuitBreaker struct {
 	failures     int
 	lastFailTime time.Time
 	state        CircuitState
+	clock        clock.Clock
 	mu           sync.RWMutex
 }
 
@@ -137,6 +147,7 @@ func NewCircuitBreaker(config CircuitBreakerConfig) *CircuitBreaker {
 	return &CircuitBreaker{
 		config: config,
 		state:  CircuitClosed,
+		clock:  clock.OrReal(config.Clock),
 	}
 }
 
@@ -159,7 +170,7 @@ func (cb *CircuitBreaker) allowRequest() bool {
 	case CircuitClosed:
 		return true
 	case CircuitOpen:
-		return time.Since(cb.lastFailTime) > cb.config.ResetTimeout
+		return cb.clock.Since(cb.lastFailTime) > cb.config.ResetTimeout
 	case CircuitHalfOpen:
 		return true
 	default:
@@ -187,7 +198,7 @@ func (cb *CircuitBreaker) onSuccess() {
 
 func (cb *CircuitBreaker) onFailure() {
 	cb.failures++
-	cb.lastFailTime = time.Now()
+	cb.lastFailTime = cb.clock.Now()
 
 	if cb.failures >= cb.config.FailureThreshold {
 		cb.state = CircuitOpen
@@ -206,6 +217,7 @@ type Retrier struct {
 	policy         Policy
 	circuitBreaker *CircuitBreaker
 	metrics        *RetryMetrics
+	clock          clock.Clock
 	mu             sync.RWMutex
 }
 
@@ -228,10 +240,15 @@ func New(config ...RetryConfig) *Retrier {
 	retrier := &Retrier{
 		policy:  NewExponentialBackoffPolicy(cfg),
 		metrics: &RetryMetrics{},
+		clock:   clock.OrReal(cfg.Clock),
 	}
 
 	if cfg.CircuitBreaker != nil {
-		retrier.circuitBreaker = NewCircuitBreaker(*cfg.CircuitBreaker)
+		cbConfig := *cfg.CircuitBreaker
+		if cbConfig.Clock == nil {
+			cbConfig.Clock = retrier.clock
+		}
+		retrier.circuitBreaker = NewCircuitBreaker(cbConfig)
 	}
 
 	return retrier
@@ -293,7 +310,7 @@ func (r *Retrier) ExecuteWithResult(ctx context.Context, fn func() (interface{},
 		case <-ctx.Done():
 			r.incrementFailedCalls()
 			return ctx.Err()
-		case <-time.After(delay):
+		case <-r.clock.After(delay):
 			continue
 		}
 	}
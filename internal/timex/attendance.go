@@ -0,0 +1,355 @@
+package timex
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+	"sort"
+	"time"
+)
+
+// ErrInvalidAttendance is returned when persisted attendance data cannot be restored
+var ErrInvalidAttendance = errors.New("invalid attendance data")
+
+// attendanceFormatVersion prefixes the binary encoding of Attendance
+const attendanceFormatVersion = 1
+
+// attendanceRecordSize is the encoded size of one MonthBitmap: year(2) month(1) days(4)
+const attendanceRecordSize = 7
+
+// AttendanceConfig holds configuration for attendance tracking
+type AttendanceConfig struct {
+	// Timezone for day boundaries (default: UTC)
+	Timezone *time.Location
+
+	// DailyResetOffset moves the day boundary away from midnight
+	DailyResetOffset time.Duration
+
+	// GraceDays is the number of missed days allowed without breaking a streak
+	// Missed days do not count towards the streak length
+	GraceDays int
+}
+
+// AttendanceOption configures attendance tracking
+type AttendanceOption func(*AttendanceConfig)
+
+// WithGraceDays allows up to n missed days between check-ins without breaking a streak
+func WithGraceDays(n int) AttendanceOption {
+	return func(config *AttendanceConfig) {
+		if n < 0 {
+			n = 0
+		}
+		config.GraceDays = n
+	}
+}
+
+// MonthBitmap is the compact persistence format for one month of attendance
+// Bit d-1 of Days is set when the player checked in on day d of the month
+type MonthBitmap struct {
+	Year  int
+	Month time.Month
+	Days  uint32
+}
+
+// Key returns a sortable storage key such as "2024-01"
+func (m MonthBitmap) Key() string {
+	return fmt.Sprintf("%04d-%02d", m.Year, int(m.Month))
+}
+
+// Count returns the number of days checked in during the month
+func (m MonthBitmap) Count() int {
+	return bits.OnesCount32(m.Days)
+}
+
+// Has reports whether day (1-31) is checked in
+func (m MonthBitmap) Has(day int) bool {
+	if day < 1 || day > 31 {
+		return false
+	}
+	return m.Days&(1<<uint(day-1)) != 0
+}
+
+// CheckInResult describes the outcome of a check-in
+type CheckInResult struct {
+	// Day is the start of the game day the check-in was counted for
+	Day time.Time
+
+	// New is false when the player had already checked in on that day
+	New bool
+
+	// CurrentStreak is the streak as of the check-in
+	CurrentStreak int
+
+	// LongestStreak is the longest streak recorded so far
+	LongestStreak int
+
+	// MonthDays is the number of days checked in during the day's month
+	MonthDays int
+}
+
+// Attendance tracks daily check-ins using the same day boundaries as Elapsed
+// Attendance is not safe for concurrent use; guard it per player
+//
+// Example usage:
+//
+//	attendance := timex.NewAttendance(timex.Option().KST9AM(), timex.WithGraceDays(1))
+//
+//	result := attendance.CheckIn(now)
+//	if result.New {
+//	    grantDailyReward(result.CurrentStreak, result.MonthDays)
+//	}
+//
+//	// Persist per month, e.g. as Redis hash fields
+//	for _, month := range attendance.Months() {
+//	    store.Set(month.Key(), month.Days)
+//	}
+type Attendance struct {
+	config AttendanceConfig
+	months map[monthKey]uint32
+}
+
+type monthKey struct {
+	year  int
+	month time.Month
+}
+
+// NewAttendance creates an attendance tracker
+// schedule supplies the timezone and daily reset offset; its period is ignored
+func NewAttendance(schedule ElapsedOption, options ...AttendanceOption) *Attendance {
+	config := AttendanceConfig{
+		Timezone:         schedule.config.Timezone,
+		DailyResetOffset: schedule.config.DailyResetOffset,
+	}
+	if config.Timezone == nil {
+		config.Timezone = time.UTC
+	}
+
+	for _, option := range options {
+		option(&config)
+	}
+
+	return &Attendance{
+		config: config,
+		months: make(map[monthKey]uint32),
+	}
+}
+
+// CheckIn records a check-in at t
+// Check-ins may arrive out of order; streaks are computed from the recorded days
+func (a *Attendance) CheckIn(t time.Time) CheckInResult {
+	dayStart := a.dayStart(t)
+	year, month, day := dayStart.Date()
+	key := monthKey{year: year, month: month}
+	bit := uint32(1) << uint(day-1)
+
+	isNew := a.months[key]&bit == 0
+	a.months[key] |= bit
+
+	return CheckInResult{
+		Day:           dayStart,
+		New:           isNew,
+		CurrentStreak: a.CurrentStreak(t),
+		LongestStreak: a.LongestStreak(),
+		MonthDays:     bits.OnesCount32(a.months[key]),
+	}
+}
+
+// CheckedIn reports whether the player checked in on the game day containing t
+func (a *Attendance) CheckedIn(t time.Time) bool {
+	year, month, day := a.dayStart(t).Date()
+	return a.months[monthKey{year: year, month: month}]&(1<<uint(day-1)) != 0
+}
+
+// IsNewDay reports whether a check-in at t would be the first of its game day
+func (a *Attendance) IsNewDay(t time.Time) bool {
+	return !a.CheckedIn(t)
+}
+
+// CurrentStreak returns the streak still alive at now
+// A streak survives while the days missed since the last check-in do not exceed
+// the grace days; the game day containing now is not counted as missed
+func (a *Attendance) CurrentStreak(now time.Time) int {
+	days := a.sortedDays()
+	if len(days) == 0 {
+		return 0
+	}
+
+	today := a.dayNumber(now)
+	idx := sort.Search(len(days), func(i int) bool { return days[i] > today })
+	if idx == 0 {
+		return 0
+	}
+
+	last := days[idx-1]
+	if today-last-1 > int64(a.config.GraceDays) {
+		return 0
+	}
+
+	streak := 1
+	for i := idx - 1; i > 0; i-- {
+		if days[i]-days[i-1]-1 > int64(a.config.GraceDays) {
+			break
+		}
+		streak++
+	}
+	return streak
+}
+
+// LongestStreak returns the longest streak ever recorded
+func (a *Attendance) LongestStreak() int {
+	days := a.sortedDays()
+	if len(days) == 0 {
+		return 0
+	}
+
+	longest, streak := 1, 1
+	for i := 1; i < len(days); i++ {
+		if days[i]-days[i-1]-1 > int64(a.config.GraceDays) {
+			streak = 1
+			continue
+		}
+		streak++
+		if streak > longest {
+			longest = streak
+		}
+	}
+	return longest
+}
+
+// DaysThisMonth returns the number of check-ins in the month of the game day containing t
+func (a *Attendance) DaysThisMonth(t time.Time) int {
+	year, month, _ := a.dayStart(t).Date()
+	return bits.OnesCount32(a.months[monthKey{year: year, month: month}])
+}
+
+// TotalDays returns the number of days checked in across all months
+func (a *Attendance) TotalDays() int {
+	total := 0
+	for _, days := range a.months {
+		total += bits.OnesCount32(days)
+	}
+	return total
+}
+
+// Month returns the bitmap for a single month
+func (a *Attendance) Month(year int, month time.Month) MonthBitmap {
+	return MonthBitmap{Year: year, Month: month, Days: a.months[monthKey{year: year, month: month}]}
+}
+
+// Months returns all non-empty months in chronological order
+func (a *Attendance) Months() []MonthBitmap {
+	months := make([]MonthBitmap, 0, len(a.months))
+	for key, days := range a.months {
+		if days == 0 {
+			continue
+		}
+		months = append(months, MonthBitmap{Year: key.year, Month: key.month, Days: days})
+	}
+
+	sort.Slice(months, func(i, j int) bool {
+		if months[i].Year != months[j].Year {
+			return months[i].Year < months[j].Year
+		}
+		return months[i].Month < months[j].Month
+	})
+	return months
+}
+
+// Restore merges persisted month bitmaps into the tracker
+// Returns ErrInvalidAttendance for out-of-range months or days
+func (a *Attendance) Restore(months ...MonthBitmap) error {
+	for _, m := range months {
+		if m.Month < time.January || m.Month > time.December {
+			return fmt.Errorf("%w: month %d", ErrInvalidAttendance, m.Month)
+		}
+		daysInMonth := time.Date(m.Year, m.Month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		if m.Days>>uint(daysInMonth) != 0 {
+			return fmt.Errorf("%w: %s has days beyond %d", ErrInvalidAttendance, m.Key(), daysInMonth)
+		}
+	}
+
+	for _, m := range months {
+		a.months[monthKey{year: m.Year, month: m.Month}] |= m.Days
+	}
+	return nil
+}
+
+// MarshalBinary encodes all months as a version byte followed by
+// 7 bytes per month (year uint16, month uint8, days uint32, big endian)
+func (a *Attendance) MarshalBinary() ([]byte, error) {
+	months := a.Months()
+	data := make([]byte, 1, 1+len(months)*attendanceRecordSize)
+	data[0] = attendanceFormatVersion
+
+	for _, m := range months {
+		if m.Year < 0 || m.Year > 0xFFFF {
+			return nil, fmt.Errorf("%w: year %d out of range", ErrInvalidAttendance, m.Year)
+		}
+		var record [attendanceRecordSize]byte
+		binary.BigEndian.PutUint16(record[0:2], uint16(m.Year))
+		record[2] = byte(m.Month)
+		binary.BigEndian.PutUint32(record[3:7], m.Days)
+		data = append(data, record[:]...)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary replaces the recorded days with data produced by MarshalBinary
+// The tracker's timezone, reset offset and grace days are kept
+func (a *Attendance) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || data[0] != attendanceFormatVersion {
+		return fmt.Errorf("%w: unsupported format", ErrInvalidAttendance)
+	}
+	if (len(data)-1)%attendanceRecordSize != 0 {
+		return fmt.Errorf("%w: truncated data", ErrInvalidAttendance)
+	}
+
+	var months []MonthBitmap
+	for offset := 1; offset < len(data); offset += attendanceRecordSize {
+		record := data[offset : offset+attendanceRecordSize]
+		months = append(months, MonthBitmap{
+			Year:  int(binary.BigEndian.Uint16(record[0:2])),
+			Month: time.Month(record[2]),
+			Days:  binary.BigEndian.Uint32(record[3:7]),
+		})
+	}
+
+	restored := &Attendance{config: a.config, months: make(map[monthKey]uint32)}
+	if err := restored.Restore(months...); err != nil {
+		return err
+	}
+	a.months = restored.months
+	return nil
+}
+
+// dayStart returns the start of the game day containing t
+func (a *Attendance) dayStart(t time.Time) time.Time {
+	return getDayStart(t, a.config.DailyResetOffset, a.config.Timezone)
+}
+
+// dayNumber returns a sequential number for the game day containing t
+func (a *Attendance) dayNumber(t time.Time) int64 {
+	year, month, day := a.dayStart(t).Date()
+	return dateNumber(year, month, day)
+}
+
+// sortedDays returns all checked-in days as sequential day numbers
+func (a *Attendance) sortedDays() []int64 {
+	var days []int64
+	for key, bitmap := range a.months {
+		for bitmap != 0 {
+			day := bits.TrailingZeros32(bitmap) + 1
+			days = append(days, dateNumber(key.year, key.month, day))
+			bitmap &= bitmap - 1
+		}
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i] < days[j] })
+	return days
+}
+
+// dateNumber maps a calendar date to a sequential day number
+// Uses UTC so the number is independent of DST
+func dateNumber(year int, month time.Month, day int) int64 {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / (24 * 60 * 60)
+}
@@ -0,0 +1,117 @@
+package timex
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttendance_CheckInUsesResetOffset(t *testing.T) {
+	kst := MustLoadLocation("Asia/Seoul")
+	attendance := NewAttendance(Option().KST9AM())
+
+	first := attendance.CheckIn(time.Date(2024, 3, 1, 10, 0, 0, 0, kst))
+	assert.True(t, first.New)
+	assert.Equal(t, 1, first.CurrentStreak)
+	assert.True(t, first.Day.Equal(time.Date(2024, 3, 1, 9, 0, 0, 0, kst)))
+
+	// 08:59 the next morning still belongs to March 1st
+	again := attendance.CheckIn(time.Date(2024, 3, 2, 8, 59, 0, 0, kst))
+	assert.False(t, again.New)
+	assert.Equal(t, 1, again.CurrentStreak)
+
+	assert.True(t, attendance.IsNewDay(time.Date(2024, 3, 2, 9, 0, 0, 0, kst)))
+	next := attendance.CheckIn(time.Date(2024, 3, 2, 9, 0, 0, 0, kst))
+	assert.True(t, next.New)
+	assert.Equal(t, 2, next.CurrentStreak)
+	assert.Equal(t, 2, next.MonthDays)
+}
+
+func TestAttendance_Streaks(t *testing.T) {
+	attendance := NewAttendance(Option().Day())
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 12, 0, 0, 0, time.UTC) }
+
+	for _, d := range []int{1, 2, 3, 4, 7, 8} {
+		attendance.CheckIn(day(d))
+	}
+
+	assert.Equal(t, 4, attendance.LongestStreak())
+	assert.Equal(t, 2, attendance.CurrentStreak(day(8)))
+	assert.Equal(t, 2, attendance.CurrentStreak(day(9)), "today is not missed yet")
+	assert.Equal(t, 0, attendance.CurrentStreak(day(10)))
+	assert.Equal(t, 4, attendance.CurrentStreak(day(5)), "streak as of an earlier day")
+}
+
+func TestAttendance_GraceDays(t *testing.T) {
+	attendance := NewAttendance(Option().Day(), WithGraceDays(1))
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 12, 0, 0, 0, time.UTC) }
+
+	for _, d := range []int{1, 2, 4, 5, 8} {
+		attendance.CheckIn(day(d))
+	}
+
+	assert.Equal(t, 4, attendance.LongestStreak(), "one missed day is forgiven")
+	assert.Equal(t, 1, attendance.CurrentStreak(day(8)), "two missed days break the streak")
+	assert.Equal(t, 1, attendance.CurrentStreak(day(10)))
+	assert.Equal(t, 0, attendance.CurrentStreak(day(11)))
+}
+
+func TestAttendance_MonthBoundaryAndOutOfOrder(t *testing.T) {
+	attendance := NewAttendance(Option().Day())
+
+	attendance.CheckIn(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	attendance.CheckIn(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC))
+	attendance.CheckIn(time.Date(2024, 1, 30, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, 3, attendance.CurrentStreak(time.Date(2024, 2, 1, 23, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 2, attendance.DaysThisMonth(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 1, attendance.DaysThisMonth(time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 3, attendance.TotalDays())
+}
+
+func TestAttendance_Persistence(t *testing.T) {
+	attendance := NewAttendance(Option().Day())
+	for _, d := range []time.Time{
+		time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+	} {
+		attendance.CheckIn(d)
+	}
+
+	months := attendance.Months()
+	require.Len(t, months, 2)
+	assert.Equal(t, "2023-12", months[0].Key())
+	assert.True(t, months[0].Has(31))
+	assert.Equal(t, uint32(0b101), months[1].Days)
+
+	restored := NewAttendance(Option().Day())
+	require.NoError(t, restored.Restore(months...))
+	assert.Equal(t, months, restored.Months())
+
+	data, err := attendance.MarshalBinary()
+	require.NoError(t, err)
+	assert.Len(t, data, 1+2*attendanceRecordSize)
+
+	decoded := NewAttendance(Option().Day())
+	require.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, months, decoded.Months())
+	assert.Equal(t, attendance.LongestStreak(), decoded.LongestStreak())
+}
+
+func TestAttendance_RestoreValidation(t *testing.T) {
+	attendance := NewAttendance(Option().Day())
+
+	err := attendance.Restore(MonthBitmap{Year: 2024, Month: 13, Days: 1})
+	assert.ErrorIs(t, err, ErrInvalidAttendance)
+
+	// February 2024 has 29 days, so bit 30 is invalid
+	err = attendance.Restore(MonthBitmap{Year: 2024, Month: time.February, Days: 1 << 29})
+	assert.ErrorIs(t, err, ErrInvalidAttendance)
+
+	assert.ErrorIs(t, attendance.UnmarshalBinary(nil), ErrInvalidAttendance)
+	assert.ErrorIs(t, attendance.UnmarshalBinary([]byte{attendanceFormatVersion, 1, 2}), ErrInvalidAttendance)
+	assert.Empty(t, attendance.Months())
+}
@@ -0,0 +1,165 @@
+package timex
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrCalendarStalled is returned when a calendar boundary does not advance past the previous one
+var ErrCalendarStalled = errors.New("calendar range did not advance")
+
+// CalendarUnit defines calendar-based units for CalendarRange
+type CalendarUnit int
+
+const (
+	// CalendarDay splits at the daily reset (midnight plus DailyResetOffset)
+	CalendarDay CalendarUnit = iota
+	// CalendarWeek splits at ISO week boundaries (Monday 00:00)
+	CalendarWeek
+	// CalendarMonth splits at the first day of each calendar month (00:00)
+	CalendarMonth
+)
+
+// CalendarRange splits a time interval along calendar boundaries in a timezone
+//
+// Unlike Range, which steps by a fixed duration, each range covers one calendar
+// period, so days around a DST transition are 23 or 25 hours long and months
+// have their real length. The boundaries are the same ones Elapsed uses for
+// WithDay, WithWeek and WithMonth with the same timezone and reset offset.
+//
+// The first and last ranges are clipped to start and end; use WithTrimFirst,
+// WithTrimLast or WithTrim to drop them when they do not cover a whole period.
+// The split mode options are ignored.
+//
+// Parameters:
+//   - start: The start time of the range
+//   - end: The end time of the range
+//   - unit: The calendar unit to split by
+//   - options: Timezone, reset offset and trimming options
+//
+// Returns:
+//   - []TimeRange: Ranges expressed in the configured timezone
+//   - error: ErrCalendarStalled if a period boundary fails to advance
+//
+// Example usage:
+//
+//	kst, _ := time.LoadLocation("Asia/Seoul")
+//	start := time.Date(2024, 1, 1, 14, 0, 0, 0, kst)
+//	end := time.Date(2024, 1, 3, 12, 0, 0, 0, kst)
+//
+//	// Game days starting at 09:00 KST
+//	days, err := timex.CalendarRange(start, end, timex.CalendarDay,
+//	    timex.WithRangeTimezone(kst), timex.WithRangeResetOffset(9*time.Hour))
+//	// Result: [01 14:00-02 09:00, 02 09:00-03 09:00, 03 09:00-03 12:00]
+func CalendarRange(start, end time.Time, unit CalendarUnit, options ...RangeOption) ([]TimeRange, error) {
+	if !start.Before(end) {
+		return nil, nil
+	}
+
+	config := &RangeConfig{
+		SplitMode: SplitAligned,
+		Timezone:  time.UTC,
+	}
+
+	for _, option := range options {
+		option(config)
+	}
+
+	if config.Timezone == nil {
+		config.Timezone = time.UTC
+	}
+
+	start = start.In(config.Timezone)
+	end = end.In(config.Timezone)
+
+	periodStart := calendarPeriodStart(start, unit, config)
+	if periodStart.IsZero() {
+		return nil, nil
+	}
+
+	var ranges []TimeRange
+	for current := periodStart; current.Before(end); {
+		next := nextCalendarPeriodStart(current, unit, config)
+		if !next.After(current) {
+			return nil, fmt.Errorf("%w: boundary after %s is %s", ErrCalendarStalled, current, next)
+		}
+
+		rangeStart := current
+		if rangeStart.Before(start) {
+			rangeStart = start
+		}
+		rangeEnd := next
+		if rangeEnd.After(end) {
+			rangeEnd = end
+		}
+
+		ranges = append(ranges, TimeRange{
+			Start: rangeStart,
+			End:   rangeEnd,
+		})
+
+		current = next
+	}
+
+	if config.TrimFirst && len(ranges) > 0 && !periodStart.Equal(start) {
+		ranges = ranges[1:]
+	}
+
+	if config.TrimLast && len(ranges) > 0 && !calendarPeriodStart(end, unit, config).Equal(end) {
+		ranges = ranges[:len(ranges)-1]
+	}
+
+	return ranges, nil
+}
+
+// CalendarPeriodStart returns the start of the calendar period containing t
+// Uses the same timezone and reset offset options as CalendarRange
+//
+// Example usage:
+//
+//	// Start of the current ISO week in KST
+//	weekStart := timex.CalendarPeriodStart(now, timex.CalendarWeek, timex.WithRangeTimezone(kst))
+func CalendarPeriodStart(t time.Time, unit CalendarUnit, options ...RangeOption) time.Time {
+	config := &RangeConfig{Timezone: time.UTC}
+	for _, option := range options {
+		option(config)
+	}
+	if config.Timezone == nil {
+		config.Timezone = time.UTC
+	}
+	return calendarPeriodStart(t.In(config.Timezone), unit, config)
+}
+
+// calendarPeriodStart finds the boundary at or before t for the given unit
+func calendarPeriodStart(t time.Time, unit CalendarUnit, config *RangeConfig) time.Time {
+	switch unit {
+	case CalendarDay:
+		return getDayStart(t, config.DailyResetOffset, config.Timezone)
+	case CalendarWeek:
+		return getWeekStart(t.In(config.Timezone), config.Timezone)
+	case CalendarMonth:
+		return getMonthStart(t, config.Timezone)
+	default:
+		return time.Time{}
+	}
+}
+
+// nextCalendarPeriodStart returns the boundary following a period start
+// Computed from calendar dates rather than durations to stay correct across DST
+func nextCalendarPeriodStart(periodStart time.Time, unit CalendarUnit, config *RangeConfig) time.Time {
+	year, month, day := periodStart.Date()
+
+	switch unit {
+	case CalendarDay:
+		offset := config.DailyResetOffset
+		if offset < 0 {
+			offset = 0
+		}
+		return dateWithOffset(year, month, day+1, offset, config.Timezone)
+	case CalendarWeek:
+		return time.Date(year, month, day+7, 0, 0, 0, 0, config.Timezone)
+	default:
+		return time.Date(year, month+1, 1, 0, 0, 0, 0, config.Timezone)
+	}
+}
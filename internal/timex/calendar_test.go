@@ -0,0 +1,182 @@
+package timex
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendarRange_DayWithResetOffset(t *testing.T) {
+	kst, err := time.LoadLocation("Asia/Seoul")
+	require.NoError(t, err)
+
+	start := time.Date(2024, 1, 1, 14, 0, 0, 0, kst)
+	end := time.Date(2024, 1, 3, 12, 0, 0, 0, kst)
+
+	ranges, err := CalendarRange(start, end, CalendarDay,
+		WithRangeTimezone(kst), WithRangeResetOffset(9*time.Hour))
+	require.NoError(t, err)
+
+	require.Len(t, ranges, 3)
+	assert.True(t, ranges[0].Start.Equal(start))
+	assert.True(t, ranges[0].End.Equal(time.Date(2024, 1, 2, 9, 0, 0, 0, kst)))
+	assert.True(t, ranges[1].Start.Equal(time.Date(2024, 1, 2, 9, 0, 0, 0, kst)))
+	assert.True(t, ranges[1].End.Equal(time.Date(2024, 1, 3, 9, 0, 0, 0, kst)))
+	assert.True(t, ranges[2].Start.Equal(time.Date(2024, 1, 3, 9, 0, 0, 0, kst)))
+	assert.True(t, ranges[2].End.Equal(end))
+
+	trimmed, err := CalendarRange(start, end, CalendarDay,
+		WithRangeTimezone(kst), WithRangeResetOffset(9*time.Hour), WithTrim())
+	require.NoError(t, err)
+	require.Len(t, trimmed, 1)
+	assert.Equal(t, ranges[1], trimmed[0])
+}
+
+func TestCalendarRange_DSTDays(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	// DST starts 2024-03-10 and ends 2024-11-03 in New York
+	t.Run("spring forward", func(t *testing.T) {
+		start := time.Date(2024, 3, 9, 0, 0, 0, 0, ny)
+		end := time.Date(2024, 3, 12, 0, 0, 0, 0, ny)
+
+		ranges, err := CalendarRange(start, end, CalendarDay, WithRangeTimezone(ny))
+		require.NoError(t, err)
+
+		require.Len(t, ranges, 3)
+		assert.Equal(t, 24*time.Hour, ranges[0].End.Sub(ranges[0].Start))
+		assert.Equal(t, 23*time.Hour, ranges[1].End.Sub(ranges[1].Start))
+		assert.Equal(t, 24*time.Hour, ranges[2].End.Sub(ranges[2].Start))
+	})
+
+	t.Run("fall back with reset offset", func(t *testing.T) {
+		start := time.Date(2024, 11, 2, 9, 0, 0, 0, ny)
+		end := time.Date(2024, 11, 4, 9, 0, 0, 0, ny)
+
+		ranges, err := CalendarRange(start, end, CalendarDay,
+			WithRangeTimezone(ny), WithRangeResetOffset(9*time.Hour))
+		require.NoError(t, err)
+
+		require.Len(t, ranges, 2)
+		assert.Equal(t, 25*time.Hour, ranges[0].End.Sub(ranges[0].Start))
+		assert.Equal(t, 9, ranges[0].End.Hour(), "reset stays at 09:00 wall clock")
+		assert.Equal(t, 24*time.Hour, ranges[1].End.Sub(ranges[1].Start))
+	})
+}
+
+func TestCalendarRange_Week(t *testing.T) {
+	// 2024-01-03 is a Wednesday
+	start := time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 22, 0, 0, 0, 0, time.UTC)
+
+	ranges, err := CalendarRange(start, end, CalendarWeek)
+	require.NoError(t, err)
+
+	require.Len(t, ranges, 3)
+	assert.Equal(t, start, ranges[0].Start)
+	assert.Equal(t, time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), ranges[0].End)
+	assert.Equal(t, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), ranges[1].End)
+	assert.Equal(t, end, ranges[2].End)
+
+	trimmed, err := CalendarRange(start, end, CalendarWeek, WithTrimFirst())
+	require.NoError(t, err)
+	require.Len(t, trimmed, 2, "end falls on a Monday so the last week is whole")
+	for _, r := range trimmed {
+		assert.Equal(t, time.Monday, r.Start.Weekday())
+	}
+}
+
+func TestCalendarRange_Month(t *testing.T) {
+	kst, err := time.LoadLocation("Asia/Seoul")
+	require.NoError(t, err)
+
+	start := time.Date(2024, 1, 15, 0, 0, 0, 0, kst)
+	end := time.Date(2024, 4, 10, 0, 0, 0, 0, kst)
+
+	ranges, err := CalendarRange(start, end, CalendarMonth, WithRangeTimezone(kst), WithTrim())
+	require.NoError(t, err)
+
+	require.Len(t, ranges, 2)
+	assert.True(t, ranges[0].Start.Equal(time.Date(2024, 2, 1, 0, 0, 0, 0, kst)))
+	assert.True(t, ranges[0].End.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, kst)))
+	assert.True(t, ranges[1].End.Equal(time.Date(2024, 4, 1, 0, 0, 0, 0, kst)))
+
+	// Boundaries are expressed in the configured timezone
+	assert.Equal(t, kst, ranges[0].Start.Location())
+}
+
+func TestCalendarRange_InvalidInput(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, end := range []time.Time{now, now.Add(-time.Hour)} {
+		ranges, err := CalendarRange(now, end, CalendarDay)
+		require.NoError(t, err)
+		assert.Nil(t, ranges)
+	}
+
+	ranges, err := CalendarRange(now, now.Add(time.Hour), CalendarUnit(99))
+	require.NoError(t, err)
+	assert.Nil(t, ranges)
+}
+
+func TestDateWithOffset_SplitsIntoClockFields(t *testing.T) {
+	// Passing the offset to time.Date as nanoseconds overflows int on 32-bit platforms
+	offset := 9*time.Hour + 30*time.Minute + 15*time.Second + 5
+	got := dateWithOffset(2024, 1, 31, offset, time.UTC)
+	assert.Equal(t, time.Date(2024, 1, 31, 9, 30, 15, 5, time.UTC), got)
+
+	// Offsets past a day roll over into the next date
+	assert.Equal(t, time.Date(2024, 2, 1, 2, 0, 0, 0, time.UTC), dateWithOffset(2024, 1, 31, 26*time.Hour, time.UTC))
+}
+
+func TestCalendarRange_MatchesElapsed(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	start := time.Date(2024, 2, 20, 0, 0, 0, 0, ny)
+	end := time.Date(2024, 4, 20, 0, 0, 0, 0, ny)
+
+	cases := []struct {
+		name   string
+		unit   CalendarUnit
+		option ElapsedOption
+	}{
+		{"day", CalendarDay, Option().Day().Timezone(ny).DailyResetOffset(5 * time.Hour)},
+		{"week", CalendarWeek, Option().Week().Timezone(ny)},
+		{"month", CalendarMonth, Option().Month().Timezone(ny)},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ranges, err := CalendarRange(start, end, tc.unit, WithElapsedBoundaries(tc.option))
+			require.NoError(t, err)
+			require.NotEmpty(t, ranges)
+
+			for i, r := range ranges {
+				last := r.End.Add(-time.Nanosecond)
+				assert.False(t, Elapsed(r.Start, last, tc.option), "range %d should be a single period", i)
+				if i > 0 {
+					assert.True(t, Elapsed(ranges[i-1].End.Add(-time.Nanosecond), r.Start, tc.option),
+						"boundary %d should be a reset", i)
+				}
+			}
+		})
+	}
+}
+
+func TestCalendarPeriodStart(t *testing.T) {
+	kst, err := time.LoadLocation("Asia/Seoul")
+	require.NoError(t, err)
+
+	// Saturday before the 09:00 reset belongs to Friday's game day
+	now := time.Date(2024, 1, 6, 8, 0, 0, 0, kst)
+
+	dayStart := CalendarPeriodStart(now, CalendarDay, WithRangeTimezone(kst), WithRangeResetOffset(9*time.Hour))
+	assert.True(t, dayStart.Equal(time.Date(2024, 1, 5, 9, 0, 0, 0, kst)))
+
+	weekStart := CalendarPeriodStart(now, CalendarWeek, WithRangeTimezone(kst))
+	assert.True(t, weekStart.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, kst)))
+}
@@ -1,20 +1,31 @@
 package timex
 
-import "time"
+import (
+	"time"
+
+	"github.com/homveloper/dukdakit/internal/clock"
+)
 
 // Elapsed checks if time has elapsed based on the given options
 func Elapsed(baseTime, lastUpdate time.Time, options ...ElapsedOption) bool {
-	config := defaultElapsedConfig()
-	for _, opt := range options {
-		opt.apply(&config)
-	}
-
-	return checkElapsed(baseTime, lastUpdate, config)
+	return checkElapsed(baseTime, lastUpdate, buildElapsedConfig(options))
 }
 
 // ElapsedSince checks if time has elapsed since baseTime using current time
+// The current time is read from the configured clock (system clock by default)
+// and shifted by the process-wide time offset in time travel builds
 func ElapsedSince(baseTime time.Time, options ...ElapsedOption) bool {
-	return Elapsed(baseTime, time.Now(), options...)
+	config := buildElapsedConfig(options)
+	return checkElapsed(baseTime, clock.OrReal(config.Clock).Now().Add(TimeOffset()), config)
+}
+
+// buildElapsedConfig applies options on top of the default configuration
+func buildElapsedConfig(options []ElapsedOption) ElapsedConfig {
+	config := defaultElapsedConfig()
+	for _, opt := range options {
+		opt.apply(&config)
+	}
+	return config
 }
 
 // Core elapsed checking logic
@@ -36,31 +47,12 @@ func checkElapsed(baseTime, lastUpdate time.Time, config ElapsedConfig) bool {
 }
 
 func checkDayElapsed(baseTime, lastUpdate time.Time, config ElapsedConfig) bool {
-	base := baseTime.In(config.Timezone)
-	last := lastUpdate.In(config.Timezone)
+	// A day starts at midnight plus the daily reset offset;
+	// times before the reset belong to the previous day
+	baseDayStart := getDayStart(baseTime, config.DailyResetOffset, config.Timezone)
+	lastDayStart := getDayStart(lastUpdate, config.DailyResetOffset, config.Timezone)
 
-	// Handle custom daily reset time
-	if config.DailyResetOffset > 0 {
-		// Get the daily reset time for both dates
-		baseResetTime := getDailyResetTimeWithOffset(base, config.DailyResetOffset, config.Timezone)
-		lastResetTime := getDailyResetTimeWithOffset(last, config.DailyResetOffset, config.Timezone)
-
-		// If time is before reset time, use previous day's reset
-		if base.Before(baseResetTime) {
-			baseResetTime = baseResetTime.AddDate(0, 0, -1)
-		}
-		if last.Before(lastResetTime) {
-			lastResetTime = lastResetTime.AddDate(0, 0, -1)
-		}
-
-		return !baseResetTime.Equal(lastResetTime)
-	}
-
-	// Standard midnight-based day check
-	baseDay := time.Date(base.Year(), base.Month(), base.Day(), 0, 0, 0, 0, config.Timezone)
-	lastDay := time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, config.Timezone)
-
-	return !baseDay.Equal(lastDay)
+	return !baseDayStart.Equal(lastDayStart)
 }
 
 func checkWeekElapsed(baseTime, lastUpdate time.Time, config ElapsedConfig) bool {
@@ -74,10 +66,10 @@ func checkWeekElapsed(baseTime, lastUpdate time.Time, config ElapsedConfig) bool
 }
 
 func checkMonthElapsed(baseTime, lastUpdate time.Time, config ElapsedConfig) bool {
-	base := baseTime.In(config.Timezone)
-	last := lastUpdate.In(config.Timezone)
+	baseMonthStart := getMonthStart(baseTime, config.Timezone)
+	lastMonthStart := getMonthStart(lastUpdate, config.Timezone)
 
-	return base.Year() != last.Year() || base.Month() != last.Month()
+	return !baseMonthStart.Equal(lastMonthStart)
 }
 
 func checkDurationElapsed(baseTime, lastUpdate time.Time, config ElapsedConfig) bool {
@@ -97,10 +89,47 @@ func checkWeekdayElapsed(baseTime, lastUpdate time.Time, config ElapsedConfig) b
 }
 
 // Helper functions
+
+// getDailyResetTimeWithOffset returns the reset time on t's calendar day
+// The offset is applied to the wall clock, so a 09:00 reset stays at 09:00
+// on days that are 23 or 25 hours long because of DST transitions
 func getDailyResetTimeWithOffset(t time.Time, offset time.Duration, timezone *time.Location) time.Time {
-	// Start of day + offset
-	startOfDay := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, timezone)
-	return startOfDay.Add(offset)
+	return dateWithOffset(t.Year(), t.Month(), t.Day(), offset, timezone)
+}
+
+// dateWithOffset returns the wall clock time offset after midnight on the given day
+// The offset is split into clock fields because passing it to time.Date as
+// nanoseconds overflows int on 32-bit platforms
+func dateWithOffset(year int, month time.Month, day int, offset time.Duration, timezone *time.Location) time.Time {
+	hours := offset / time.Hour
+	offset -= hours * time.Hour
+	minutes := offset / time.Minute
+	offset -= minutes * time.Minute
+	seconds := offset / time.Second
+	offset -= seconds * time.Second
+	return time.Date(year, month, day, int(hours), int(minutes), int(seconds), int(offset), timezone)
+}
+
+// getDayStart returns the reset time that started the day containing t
+// Negative offsets are treated as midnight
+func getDayStart(t time.Time, offset time.Duration, timezone *time.Location) time.Time {
+	if offset < 0 {
+		offset = 0
+	}
+
+	local := t.In(timezone)
+	reset := getDailyResetTimeWithOffset(local, offset, timezone)
+	if local.Before(reset) {
+		year, month, day := local.Date()
+		reset = dateWithOffset(year, month, day-1, offset, timezone)
+	}
+	return reset
+}
+
+// getMonthStart returns midnight on the first day of t's month
+func getMonthStart(t time.Time, timezone *time.Location) time.Time {
+	local := t.In(timezone)
+	return time.Date(local.Year(), local.Month(), 1, 0, 0, 0, 0, timezone)
 }
 
 func getWeekStart(t time.Time, timezone *time.Location) time.Time {
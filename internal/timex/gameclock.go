@@ -0,0 +1,406 @@
+package timex
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/homveloper/dukdakit/internal/clock"
+)
+
+// ErrInvalidGameRatio is returned when a game clock ratio is not a positive finite number
+var ErrInvalidGameRatio = errors.New("game clock ratio must be positive and finite")
+
+// GameClockConfig holds configuration for a GameClock
+type GameClockConfig struct {
+	// Ratio is the number of game seconds per real second
+	// For example: 12 means one game day lasts 2 real hours
+	Ratio float64
+
+	// RealEpoch is the real time at which game time equals GameEpoch
+	// Servers sharing a world must use the same epoch (default: creation time)
+	RealEpoch time.Time
+
+	// GameEpoch is the game time at RealEpoch (default: RealEpoch)
+	GameEpoch time.Time
+
+	// Clock supplies real time (default: system clock)
+	Clock clock.Clock
+}
+
+// GameClockOption configures a GameClock
+type GameClockOption func(*GameClockConfig)
+
+// WithGameRatio sets the number of game seconds per real second
+func WithGameRatio(ratio float64) GameClockOption {
+	return func(config *GameClockConfig) {
+		config.Ratio = ratio
+	}
+}
+
+// WithGameDayLength sets the ratio so that one game day lasts realDuration
+// For example: WithGameDayLength(2*time.Hour) for a 2 hour day/night cycle
+func WithGameDayLength(realDuration time.Duration) GameClockOption {
+	return func(config *GameClockConfig) {
+		if realDuration <= 0 {
+			config.Ratio = 0
+			return
+		}
+		config.Ratio = float64(24*time.Hour) / float64(realDuration)
+	}
+}
+
+// WithGameEpoch anchors game time: at realTime the game clock reads gameTime
+func WithGameEpoch(realTime, gameTime time.Time) GameClockOption {
+	return func(config *GameClockConfig) {
+		config.RealEpoch = realTime
+		config.GameEpoch = gameTime
+	}
+}
+
+// WithGameClockSource sets the clock that supplies real time
+func WithGameClockSource(c clock.Clock) GameClockOption {
+	return func(config *GameClockConfig) {
+		config.Clock = c
+	}
+}
+
+// GameClock maps real time to accelerated game time
+// Game time is expressed as time.Time so that Range, CalendarRange and Elapsed
+// work on game timestamps directly. A GameClock is safe for concurrent use.
+//
+// Example usage:
+//
+//	world, _ := timex.NewGameClock(
+//	    timex.WithGameDayLength(2*time.Hour),
+//	    timex.WithGameEpoch(launchTime, time.Date(1000, 1, 1, 6, 0, 0, 0, time.UTC)),
+//	)
+//
+//	gameNow := world.Now()
+//	if gameNow.Hour() >= 20 || gameNow.Hour() < 6 {
+//	    // Night time in the world
+//	}
+//
+//	// Game-day reset checks
+//	if timex.ElapsedSince(lastGameVisit, timex.Option().Day().Clock(world.Clock())) {
+//	    // A game day passed
+//	}
+type GameClock struct {
+	mu    sync.RWMutex
+	clock clock.Clock
+	ratio float64
+
+	// Game time equals anchorGame at real time anchorReal
+	// Re-anchored on pause, resume and ratio changes
+	anchorReal time.Time
+	anchorGame time.Time
+	paused     bool
+}
+
+// NewGameClock creates a game clock
+// Returns ErrInvalidGameRatio when the ratio is not positive and finite
+func NewGameClock(options ...GameClockOption) (*GameClock, error) {
+	config := GameClockConfig{
+		Ratio: 1,
+		Clock: clock.Real(),
+	}
+
+	for _, option := range options {
+		option(&config)
+	}
+
+	if !validGameRatio(config.Ratio) {
+		return nil, ErrInvalidGameRatio
+	}
+
+	realClock := clock.OrReal(config.Clock)
+	if config.RealEpoch.IsZero() {
+		config.RealEpoch = realClock.Now()
+	}
+	if config.GameEpoch.IsZero() {
+		config.GameEpoch = config.RealEpoch
+	}
+
+	return &GameClock{
+		clock:      realClock,
+		ratio:      config.Ratio,
+		anchorReal: config.RealEpoch,
+		anchorGame: config.GameEpoch,
+	}, nil
+}
+
+// Now returns the current game time
+func (g *GameClock) Now() time.Time {
+	return g.GameTime(g.clock.Now())
+}
+
+// GameTime converts a real timestamp to game time under the current ratio
+// While paused every real time maps to the game time at which the clock stopped
+func (g *GameClock) GameTime(real time.Time) time.Time {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.gameTimeLocked(real)
+}
+
+// RealTime converts a game timestamp to the real time at which it occurs
+// Returns false while paused, because future game times have no real time yet
+//
+// Example usage:
+//
+//	// Real time of the next in-game dawn for push notifications
+//	dawn := timex.CalendarPeriodStart(world.Now(), timex.CalendarDay).Add(30 * time.Hour)
+//	if at, ok := world.RealTime(dawn); ok {
+//	    scheduler.At(at, notifyDawn)
+//	}
+func (g *GameClock) RealTime(game time.Time) (time.Time, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if g.paused {
+		return time.Time{}, false
+	}
+	return g.anchorReal.Add(scaleSpan(game, g.anchorGame, 1/g.ratio)), true
+}
+
+// RealRanges converts game-time ranges, such as those from Range or CalendarRange,
+// to real-time ranges. Returns false while paused
+func (g *GameClock) RealRanges(ranges []TimeRange) ([]TimeRange, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if g.paused {
+		return nil, false
+	}
+
+	converted := make([]TimeRange, len(ranges))
+	for i, r := range ranges {
+		converted[i] = TimeRange{
+			Start: g.anchorReal.Add(scaleSpan(r.Start, g.anchorGame, 1/g.ratio)),
+			End:   g.anchorReal.Add(scaleSpan(r.End, g.anchorGame, 1/g.ratio)),
+		}
+	}
+	return converted, true
+}
+
+// GameDuration converts a real duration to game duration under the current ratio
+func (g *GameClock) GameDuration(real time.Duration) time.Duration {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return scaleDuration(real, g.ratio)
+}
+
+// RealDuration converts a game duration to real duration under the current ratio
+func (g *GameClock) RealDuration(game time.Duration) time.Duration {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return scaleDuration(game, 1/g.ratio)
+}
+
+// Ratio returns the number of game seconds per real second
+func (g *GameClock) Ratio() float64 {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.ratio
+}
+
+// SetRatio changes the speed of game time from now on
+// Game time already passed is unaffected
+func (g *GameClock) SetRatio(ratio float64) error {
+	if !validGameRatio(ratio) {
+		return ErrInvalidGameRatio
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.reanchorLocked()
+	g.ratio = ratio
+	return nil
+}
+
+// Pause stops game time; Now keeps returning the game time at the pause
+func (g *GameClock) Pause() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.paused {
+		return
+	}
+	g.reanchorLocked()
+	g.paused = true
+}
+
+// Resume restarts game time from where it was paused
+func (g *GameClock) Resume() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if !g.paused {
+		return
+	}
+	g.anchorReal = g.clock.Now()
+	g.paused = false
+}
+
+// Paused reports whether game time is stopped
+func (g *GameClock) Paused() bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.paused
+}
+
+// Clock returns a clock.Clock reading game time
+// Pass it to ElapsedOption.Clock for ElapsedSince checks in game time.
+// Durations given to After and NewTimer are game durations, converted to real
+// time with the ratio at the time of the call; pausing does not delay them
+func (g *GameClock) Clock() clock.Clock {
+	return gameClockSource{game: g}
+}
+
+func (g *GameClock) gameTimeLocked(real time.Time) time.Time {
+	if g.paused {
+		return g.anchorGame
+	}
+	return addScaled(g.anchorGame, real.Sub(g.anchorReal), g.ratio)
+}
+
+// reanchorLocked moves the anchor to the current real time
+func (g *GameClock) reanchorLocked() {
+	now := g.clock.Now()
+	g.anchorGame = g.gameTimeLocked(now)
+	g.anchorReal = now
+}
+
+// maxGameSeconds bounds game time offsets so Unix seconds never overflow (about 146 billion years)
+const maxGameSeconds = 1 << 62
+
+// validGameRatio reports whether ratio is a usable number of game seconds per real second
+func validGameRatio(ratio float64) bool {
+	return ratio > 0 && !math.IsInf(ratio, 0) && !math.IsNaN(ratio)
+}
+
+// scaleDuration multiplies d by factor, saturating at the time.Duration limits
+func scaleDuration(d time.Duration, factor float64) time.Duration {
+	return saturateDuration(float64(d) * factor)
+}
+
+// addScaled returns from + d×factor
+// The product is split into seconds and nanoseconds because fast clocks outgrow
+// time.Duration quickly: at ratio 1440 a real elapsed time of 74 days is 292 game years
+func addScaled(from time.Time, d time.Duration, factor float64) time.Time {
+	seconds := float64(d/time.Second) * factor
+	whole := math.Max(math.Min(math.Trunc(seconds), maxGameSeconds), -maxGameSeconds)
+	nanos := (seconds-math.Trunc(seconds))*float64(time.Second) + float64(d%time.Second)*factor
+	return time.Unix(from.Unix()+int64(whole), int64(from.Nanosecond())+int64(nanos)).In(from.Location())
+}
+
+// scaleSpan returns (to - from)×factor, reading the span in seconds so game
+// spans longer than time.Duration still convert to real durations
+func scaleSpan(to, from time.Time, factor float64) time.Duration {
+	seconds := float64(to.Unix()-from.Unix()) * factor
+	nanos := float64(to.Nanosecond()-from.Nanosecond()) * factor
+	return saturateDuration(seconds*float64(time.Second) + nanos)
+}
+
+// saturateDuration converts nanoseconds to a Duration, clamping instead of overflowing
+func saturateDuration(nanos float64) time.Duration {
+	switch {
+	case nanos >= math.MaxInt64:
+		return math.MaxInt64
+	case nanos <= math.MinInt64:
+		return math.MinInt64
+	}
+	return time.Duration(nanos)
+}
+
+// gameClockSource adapts GameClock to clock.Clock
+type gameClockSource struct {
+	game *GameClock
+}
+
+func (c gameClockSource) Now() time.Time                  { return c.game.Now() }
+func (c gameClockSource) Since(t time.Time) time.Duration { return c.Now().Sub(t) }
+func (c gameClockSource) After(d time.Duration) <-chan time.Time {
+	return c.NewTimer(d).C()
+}
+func (c gameClockSource) NewTimer(d time.Duration) clock.Timer {
+	return c.game.clock.NewTimer(c.game.RealDuration(d))
+}
+
+// ============================================================================
+// Per-shard game clocks
+// ============================================================================
+
+// GameClockRegistry holds one GameClock per world shard
+// All shards share the same options but pause and resume independently
+//
+// Example usage:
+//
+//	worlds := timex.NewGameClockRegistry(timex.WithGameDayLength(2*time.Hour),
+//	    timex.WithGameEpoch(launchTime, launchTime))
+//
+//	shard, _ := worlds.Shard("asia-3")
+//	worlds.Pause("asia-3") // maintenance on a single shard
+type GameClockRegistry struct {
+	mu      sync.Mutex
+	options []GameClockOption
+	shards  map[string]*GameClock
+}
+
+// NewGameClockRegistry creates a registry whose shards use the given options
+func NewGameClockRegistry(options ...GameClockOption) *GameClockRegistry {
+	return &GameClockRegistry{
+		options: options,
+		shards:  make(map[string]*GameClock),
+	}
+}
+
+// Shard returns the clock for shardID, creating it on first use
+func (r *GameClockRegistry) Shard(shardID string) (*GameClock, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if shard, ok := r.shards[shardID]; ok {
+		return shard, nil
+	}
+
+	shard, err := NewGameClock(r.options...)
+	if err != nil {
+		return nil, err
+	}
+	r.shards[shardID] = shard
+	return shard, nil
+}
+
+// Pause stops game time on one shard
+func (r *GameClockRegistry) Pause(shardID string) error {
+	shard, err := r.Shard(shardID)
+	if err != nil {
+		return err
+	}
+	shard.Pause()
+	return nil
+}
+
+// Resume restarts game time on one shard
+func (r *GameClockRegistry) Resume(shardID string) error {
+	shard, err := r.Shard(shardID)
+	if err != nil {
+		return err
+	}
+	shard.Resume()
+	return nil
+}
+
+// Shards returns the IDs of all created shards
+func (r *GameClockRegistry) Shards() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ids := make([]string, 0, len(r.shards))
+	for id := range r.shards {
+		ids = append(ids, id)
+	}
+	return ids
+}
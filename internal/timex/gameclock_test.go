@@ -0,0 +1,184 @@
+package timex
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/homveloper/dukdakit/internal/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	gameLaunch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	gameDawn   = time.Date(1000, 1, 1, 6, 0, 0, 0, time.UTC)
+)
+
+func newTestGameClock(t *testing.T, fake *clock.FakeClock) *GameClock {
+	t.Helper()
+	game, err := NewGameClock(
+		WithGameDayLength(2*time.Hour),
+		WithGameEpoch(gameLaunch, gameDawn),
+		WithGameClockSource(fake),
+	)
+	require.NoError(t, err)
+	return game
+}
+
+func TestGameClock_Ratio(t *testing.T) {
+	fake := clock.NewFake(gameLaunch)
+	game := newTestGameClock(t, fake)
+
+	assert.Equal(t, 12.0, game.Ratio())
+	assert.Equal(t, gameDawn, game.Now())
+
+	fake.Advance(2 * time.Hour)
+	assert.Equal(t, gameDawn.AddDate(0, 0, 1), game.Now())
+
+	assert.Equal(t, time.Hour, game.GameDuration(5*time.Minute))
+	assert.Equal(t, 5*time.Minute, game.RealDuration(time.Hour))
+}
+
+func TestGameClock_InvalidRatio(t *testing.T) {
+	_, err := NewGameClock(WithGameRatio(0))
+	assert.ErrorIs(t, err, ErrInvalidGameRatio)
+
+	_, err = NewGameClock(WithGameDayLength(-time.Hour))
+	assert.ErrorIs(t, err, ErrInvalidGameRatio)
+
+	_, err = NewGameClock(WithGameRatio(math.Inf(1)))
+	assert.ErrorIs(t, err, ErrInvalidGameRatio)
+
+	_, err = NewGameClock(WithGameRatio(math.NaN()))
+	assert.ErrorIs(t, err, ErrInvalidGameRatio)
+}
+
+func TestGameClock_LongUptime(t *testing.T) {
+	// At ratio 1440 the game offset outgrows time.Duration after about 74 real days
+	fake := clock.NewFake(gameLaunch)
+	game, err := NewGameClock(
+		WithGameDayLength(time.Minute),
+		WithGameEpoch(gameLaunch, gameDawn),
+		WithGameClockSource(fake),
+	)
+	require.NoError(t, err)
+
+	fake.Advance(100 * 24 * time.Hour)
+	assert.Equal(t, gameDawn.AddDate(0, 0, 144000), game.Now())
+
+	fake.Advance(30 * time.Second)
+	gameNow := game.Now()
+	assert.Equal(t, gameDawn.AddDate(0, 0, 144000).Add(12*time.Hour), gameNow)
+
+	real, ok := game.RealTime(gameNow)
+	require.True(t, ok)
+	assert.Equal(t, fake.Now(), real)
+
+	assert.Equal(t, time.Duration(math.MaxInt64), game.GameDuration(365*24*time.Hour))
+}
+
+func TestGameClock_PauseResume(t *testing.T) {
+	fake := clock.NewFake(gameLaunch)
+	game := newTestGameClock(t, fake)
+
+	fake.Advance(10 * time.Minute)
+	game.Pause()
+	stopped := game.Now()
+	assert.Equal(t, gameDawn.Add(2*time.Hour), stopped)
+
+	fake.Advance(time.Hour)
+	assert.Equal(t, stopped, game.Now())
+	_, ok := game.RealTime(stopped.Add(time.Hour))
+	assert.False(t, ok)
+
+	game.Resume()
+	fake.Advance(5 * time.Minute)
+	assert.Equal(t, stopped.Add(time.Hour), game.Now())
+
+	at, ok := game.RealTime(stopped.Add(2 * time.Hour))
+	require.True(t, ok)
+	assert.Equal(t, fake.Now().Add(5*time.Minute), at)
+}
+
+func TestGameClock_SetRatioKeepsElapsedGameTime(t *testing.T) {
+	fake := clock.NewFake(gameLaunch)
+	game := newTestGameClock(t, fake)
+
+	fake.Advance(time.Hour)
+	require.NoError(t, game.SetRatio(1))
+	fake.Advance(time.Hour)
+
+	assert.Equal(t, gameDawn.Add(12*time.Hour+time.Hour), game.Now())
+	assert.ErrorIs(t, game.SetRatio(-1), ErrInvalidGameRatio)
+	assert.ErrorIs(t, game.SetRatio(math.Inf(1)), ErrInvalidGameRatio)
+}
+
+func TestGameClock_ScheduleInGameTime(t *testing.T) {
+	fake := clock.NewFake(gameLaunch)
+	game := newTestGameClock(t, fake)
+
+	// Three game days split by calendar day map to 2 hour real windows
+	gameDays, err := CalendarRange(gameDawn, gameDawn.AddDate(0, 0, 3), CalendarDay, WithRangeResetOffset(6*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, gameDays, 3)
+
+	realDays, ok := game.RealRanges(gameDays)
+	require.True(t, ok)
+	for i, r := range realDays {
+		assert.Equal(t, gameLaunch.Add(time.Duration(i)*2*time.Hour), r.Start)
+		assert.Equal(t, 2*time.Hour, r.Duration())
+	}
+}
+
+func TestGameClock_ElapsedSinceInGameTime(t *testing.T) {
+	fake := clock.NewFake(gameLaunch)
+	game := newTestGameClock(t, fake)
+	option := Option().Day().Clock(game.Clock())
+
+	visit := game.Now() // 06:00 game time
+	fake.Advance(80 * time.Minute)
+	assert.False(t, ElapsedSince(visit, option), "game clock reads 22:00")
+
+	fake.Advance(20 * time.Minute)
+	assert.True(t, ElapsedSince(visit, option), "game midnight passed")
+}
+
+func TestGameClock_TimerUsesRealDuration(t *testing.T) {
+	fake := clock.NewFake(gameLaunch)
+	game := newTestGameClock(t, fake)
+
+	done := game.Clock().After(time.Hour) // one game hour = 5 real minutes
+	fake.Advance(5 * time.Minute)
+
+	select {
+	case <-done:
+	default:
+		t.Fatal("game timer did not fire after 5 real minutes")
+	}
+}
+
+func TestGameClockRegistry_ShardsPauseIndependently(t *testing.T) {
+	fake := clock.NewFake(gameLaunch)
+	registry := NewGameClockRegistry(
+		WithGameDayLength(2*time.Hour),
+		WithGameEpoch(gameLaunch, gameDawn),
+		WithGameClockSource(fake),
+	)
+
+	require.NoError(t, registry.Pause("asia-1"))
+	fake.Advance(time.Hour)
+
+	paused, err := registry.Shard("asia-1")
+	require.NoError(t, err)
+	running, err := registry.Shard("asia-2")
+	require.NoError(t, err)
+
+	assert.True(t, paused.Paused())
+	assert.Equal(t, gameDawn, paused.Now())
+	assert.Equal(t, gameDawn.Add(12*time.Hour), running.Now())
+	assert.ElementsMatch(t, []string{"asia-1", "asia-2"}, registry.Shards())
+
+	_, err = NewGameClockRegistry(WithGameRatio(-1)).Shard("broken")
+	assert.ErrorIs(t, err, ErrInvalidGameRatio)
+}
@@ -50,8 +50,7 @@ func (ob *OptionBuilder) Weekday(weekday time.Weekday) ElapsedOption {
 // KST9AM returns a preset option for KST 9:00 AM daily reset
 func (ob *OptionBuilder) KST9AM() ElapsedOption {
 	config := defaultElapsedConfig()
-	kst, _ := time.LoadLocation("Asia/Seoul")
-	config.Timezone = kst
+	config.Timezone = MustLoadLocation("Asia/Seoul")
 	config.DailyResetOffset = 9 * time.Hour
 	return ElapsedOption{config: config}
 }
@@ -59,8 +58,7 @@ func (ob *OptionBuilder) KST9AM() ElapsedOption {
 // KST11AM returns a preset option for KST 11:00 AM daily reset
 func (ob *OptionBuilder) KST11AM() ElapsedOption {
 	config := defaultElapsedConfig()
-	kst, _ := time.LoadLocation("Asia/Seoul")
-	config.Timezone = kst
+	config.Timezone = MustLoadLocation("Asia/Seoul")
 	config.DailyResetOffset = 11 * time.Hour
 	return ElapsedOption{config: config}
 }
@@ -1,6 +1,10 @@
 package timex
 
-import "time"
+import (
+	"time"
+
+	"github.com/homveloper/dukdakit/internal/clock"
+)
 
 // ElapsedOption configures how elapsed time is calculated using builder pattern
 type ElapsedOption struct {
@@ -29,6 +33,9 @@ type ElapsedConfig struct {
 
 	// Target weekday for PeriodWeekday
 	TargetWeekday time.Weekday
+
+	// Clock supplies the current time for ElapsedSince (default: system clock)
+	Clock clock.Clock
 }
 
 // PeriodType defines different types of time periods
@@ -50,6 +57,7 @@ func defaultElapsedConfig() ElapsedConfig {
 		Duration:         24 * time.Hour,
 		DailyResetOffset: 0, // Midnight
 		TargetWeekday:    time.Monday,
+		Clock:            clock.Real(),
 	}
 }
 
@@ -67,3 +75,10 @@ func (opt ElapsedOption) DailyResetOffset(offset time.Duration) ElapsedOption {
 	opt.config.DailyResetOffset = offset
 	return opt
 }
+
+// Clock sets the clock used by ElapsedSince to read the current time
+// Pass a fake clock in tests to check resets without waiting
+func (opt ElapsedOption) Clock(c clock.Clock) ElapsedOption {
+	opt.config.Clock = c
+	return opt
+}
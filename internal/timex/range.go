@@ -18,6 +18,13 @@ type RangeConfig struct {
 
 	// TrimLast removes partial duration at the end
 	TrimLast bool
+
+	// Timezone used for calendar boundaries in CalendarRange (default: UTC)
+	Timezone *time.Location
+
+	// DailyResetOffset moves day boundaries in CalendarRange away from midnight
+	// For example: 9*time.Hour means days start at 09:00
+	DailyResetOffset time.Duration
 }
 
 // SplitMode defines how time ranges are split
@@ -72,6 +79,35 @@ func WithTrim() RangeOption {
 	}
 }
 
+// WithRangeTimezone sets the timezone used for calendar boundaries
+func WithRangeTimezone(tz *time.Location) RangeOption {
+	return func(config *RangeConfig) {
+		config.Timezone = tz
+	}
+}
+
+// WithRangeResetOffset sets the daily reset offset used for day boundaries
+// For example: WithRangeResetOffset(9*time.Hour) for days starting at 09:00
+func WithRangeResetOffset(offset time.Duration) RangeOption {
+	return func(config *RangeConfig) {
+		config.DailyResetOffset = offset
+	}
+}
+
+// WithElapsedBoundaries copies timezone and daily reset offset from an ElapsedOption
+// so that calendar ranges split on exactly the boundaries Elapsed reports
+//
+// Example usage:
+//
+//	reset := timex.Option().KST9AM()
+//	days, err := timex.CalendarRange(start, end, timex.CalendarDay, timex.WithElapsedBoundaries(reset))
+func WithElapsedBoundaries(opt ElapsedOption) RangeOption {
+	return func(config *RangeConfig) {
+		config.Timezone = opt.config.Timezone
+		config.DailyResetOffset = opt.config.DailyResetOffset
+	}
+}
+
 // Range splits a time interval into smaller ranges based on the given duration
 //
 // Parameters:
@@ -0,0 +1,223 @@
+package timex
+
+import (
+	"sort"
+	"time"
+)
+
+// TimeRange operations treat a range as the half-open interval [Start, End)
+// A range whose End is not after its Start is empty
+
+// Duration returns the length of the range, or zero for an empty range
+func (r TimeRange) Duration() time.Duration {
+	if r.IsEmpty() {
+		return 0
+	}
+	return r.End.Sub(r.Start)
+}
+
+// IsEmpty reports whether the range contains no instant
+func (r TimeRange) IsEmpty() bool {
+	return !r.Start.Before(r.End)
+}
+
+// Contains reports whether t falls within [Start, End)
+func (r TimeRange) Contains(t time.Time) bool {
+	return !t.Before(r.Start) && t.Before(r.End)
+}
+
+// ContainsRange reports whether other lies entirely within r
+// An empty range is contained in any range
+func (r TimeRange) ContainsRange(other TimeRange) bool {
+	if other.IsEmpty() {
+		return true
+	}
+	return !other.Start.Before(r.Start) && !other.End.After(r.End)
+}
+
+// Overlaps reports whether r and other share at least one instant
+// Adjacent ranges such as [09:00, 10:00) and [10:00, 11:00) do not overlap
+func (r TimeRange) Overlaps(other TimeRange) bool {
+	return r.Start.Before(other.End) && other.Start.Before(r.End) && !r.IsEmpty() && !other.IsEmpty()
+}
+
+// Intersect returns the overlapping part of r and other
+// Returns false if the ranges do not overlap
+//
+// Example usage:
+//
+//	event := timex.TimeRange{Start: eventStart, End: eventEnd}
+//	if overlap, ok := event.Intersect(maintenance); ok {
+//	    owed := overlap.Duration() // compensation time for players
+//	}
+func (r TimeRange) Intersect(other TimeRange) (TimeRange, bool) {
+	if !r.Overlaps(other) {
+		return TimeRange{}, false
+	}
+	return TimeRange{
+		Start: laterOf(r.Start, other.Start),
+		End:   earlierOf(r.End, other.End),
+	}, true
+}
+
+// Union returns r and other combined
+// Overlapping or adjacent ranges merge into one; otherwise both are returned in order
+func (r TimeRange) Union(other TimeRange) []TimeRange {
+	return MergeRanges([]TimeRange{r, other})
+}
+
+// Subtract removes other from r
+// Returns zero, one or two ranges depending on where other falls
+//
+// Example usage:
+//
+//	// Event window minus a maintenance window in the middle
+//	windows := event.Subtract(maintenance)
+//	// Result: [eventStart-maintStart, maintEnd-eventEnd]
+func (r TimeRange) Subtract(other TimeRange) []TimeRange {
+	if r.IsEmpty() {
+		return nil
+	}
+	if !r.Overlaps(other) {
+		return []TimeRange{r}
+	}
+
+	var ranges []TimeRange
+	if r.Start.Before(other.Start) {
+		ranges = append(ranges, TimeRange{Start: r.Start, End: other.Start})
+	}
+	if other.End.Before(r.End) {
+		ranges = append(ranges, TimeRange{Start: other.End, End: r.End})
+	}
+	return ranges
+}
+
+// Clamp returns t limited to the range
+// Times before Start return Start and times at or after End return End
+func (r TimeRange) Clamp(t time.Time) time.Time {
+	if t.Before(r.Start) {
+		return r.Start
+	}
+	if t.After(r.End) {
+		return r.End
+	}
+	return t
+}
+
+// ClampTo returns the part of r that lies within bounds
+// Equivalent to Intersect; returns false if nothing remains
+func (r TimeRange) ClampTo(bounds TimeRange) (TimeRange, bool) {
+	return r.Intersect(bounds)
+}
+
+// MergeRanges sorts ranges and merges overlapping or adjacent ones
+// Empty ranges are dropped; the input slice is not modified
+func MergeRanges(ranges []TimeRange) []TimeRange {
+	sorted := make([]TimeRange, 0, len(ranges))
+	for _, r := range ranges {
+		if !r.IsEmpty() {
+			sorted = append(sorted, r)
+		}
+	}
+	if len(sorted) == 0 {
+		return nil
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(sorted[j].Start)
+	})
+
+	merged := []TimeRange{sorted[0]}
+	for _, r := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		if r.Start.After(last.End) {
+			merged = append(merged, r)
+			continue
+		}
+		if r.End.After(last.End) {
+			last.End = r.End
+		}
+	}
+	return merged
+}
+
+// IntersectRanges returns the instants covered by both lists, merged and sorted
+func IntersectRanges(a, b []TimeRange) []TimeRange {
+	a = MergeRanges(a)
+	b = MergeRanges(b)
+
+	var ranges []TimeRange
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if overlap, ok := a[i].Intersect(b[j]); ok {
+			ranges = append(ranges, overlap)
+		}
+		// Advance whichever range ends first
+		if a[i].End.Before(b[j].End) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return ranges
+}
+
+// SubtractRanges removes every range in remove from the ranges in from
+//
+// Example usage:
+//
+//	// Event windows with all scheduled downtime removed
+//	playable := timex.SubtractRanges(eventWindows, maintenanceWindows)
+func SubtractRanges(from, remove []TimeRange) []TimeRange {
+	remove = MergeRanges(remove)
+
+	var ranges []TimeRange
+	for _, r := range MergeRanges(from) {
+		pieces := []TimeRange{r}
+		for _, hole := range remove {
+			if !hole.Start.Before(r.End) {
+				break
+			}
+			var next []TimeRange
+			for _, piece := range pieces {
+				next = append(next, piece.Subtract(hole)...)
+			}
+			pieces = next
+		}
+		ranges = append(ranges, pieces...)
+	}
+	return ranges
+}
+
+// Gaps returns the parts of within not covered by any of the ranges
+//
+// Example usage:
+//
+//	// Times during the season with no event running
+//	idle := timex.Gaps(events, timex.TimeRange{Start: seasonStart, End: seasonEnd})
+func Gaps(ranges []TimeRange, within TimeRange) []TimeRange {
+	return SubtractRanges([]TimeRange{within}, ranges)
+}
+
+// TotalDuration returns the time covered by ranges, counting overlaps once
+func TotalDuration(ranges []TimeRange) time.Duration {
+	var total time.Duration
+	for _, r := range MergeRanges(ranges) {
+		total += r.Duration()
+	}
+	return total
+}
+
+func laterOf(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earlierOf(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,116 @@
+package timex
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var opsBase = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// hours builds [base+from h, base+to h) for compact test tables
+func hours(from, to int) TimeRange {
+	return TimeRange{
+		Start: opsBase.Add(time.Duration(from) * time.Hour),
+		End:   opsBase.Add(time.Duration(to) * time.Hour),
+	}
+}
+
+func TestTimeRange_ContainsAndOverlaps(t *testing.T) {
+	r := hours(9, 12)
+
+	assert.True(t, r.Contains(opsBase.Add(9*time.Hour)))
+	assert.False(t, r.Contains(opsBase.Add(12*time.Hour)), "end is exclusive")
+	assert.True(t, r.ContainsRange(hours(10, 12)))
+	assert.False(t, r.ContainsRange(hours(10, 13)))
+
+	assert.True(t, r.Overlaps(hours(11, 14)))
+	assert.False(t, r.Overlaps(hours(12, 14)), "adjacent ranges do not overlap")
+	assert.False(t, r.Overlaps(hours(10, 10)), "empty ranges never overlap")
+
+	assert.Equal(t, 3*time.Hour, r.Duration())
+	assert.Equal(t, time.Duration(0), hours(5, 3).Duration())
+}
+
+func TestTimeRange_Intersect(t *testing.T) {
+	overlap, ok := hours(9, 12).Intersect(hours(11, 15))
+	require.True(t, ok)
+	assert.Equal(t, hours(11, 12), overlap)
+
+	_, ok = hours(9, 12).Intersect(hours(12, 15))
+	assert.False(t, ok)
+}
+
+func TestTimeRange_Union(t *testing.T) {
+	assert.Equal(t, []TimeRange{hours(9, 15)}, hours(9, 12).Union(hours(11, 15)))
+	assert.Equal(t, []TimeRange{hours(9, 15)}, hours(12, 15).Union(hours(9, 12)), "adjacent ranges merge")
+	assert.Equal(t, []TimeRange{hours(1, 2), hours(3, 4)}, hours(3, 4).Union(hours(1, 2)))
+}
+
+func TestTimeRange_Subtract(t *testing.T) {
+	event := hours(0, 10)
+
+	assert.Equal(t, []TimeRange{hours(0, 4), hours(6, 10)}, event.Subtract(hours(4, 6)))
+	assert.Equal(t, []TimeRange{hours(3, 10)}, event.Subtract(hours(-1, 3)))
+	assert.Equal(t, []TimeRange{hours(0, 8)}, event.Subtract(hours(8, 12)))
+	assert.Empty(t, event.Subtract(hours(-1, 11)))
+	assert.Equal(t, []TimeRange{event}, event.Subtract(hours(10, 12)))
+}
+
+func TestTimeRange_Clamp(t *testing.T) {
+	r := hours(9, 12)
+
+	assert.Equal(t, r.Start, r.Clamp(opsBase))
+	assert.Equal(t, r.End, r.Clamp(opsBase.Add(20*time.Hour)))
+	assert.Equal(t, opsBase.Add(10*time.Hour), r.Clamp(opsBase.Add(10*time.Hour)))
+
+	clamped, ok := hours(5, 10).ClampTo(r)
+	require.True(t, ok)
+	assert.Equal(t, hours(9, 10), clamped)
+}
+
+func TestMergeRanges(t *testing.T) {
+	input := []TimeRange{hours(8, 9), hours(1, 3), hours(2, 5), hours(5, 6), hours(7, 7)}
+
+	merged := MergeRanges(input)
+
+	assert.Equal(t, []TimeRange{hours(1, 6), hours(8, 9)}, merged)
+	assert.Equal(t, hours(8, 9), input[0], "input is not modified")
+	assert.Nil(t, MergeRanges(nil))
+}
+
+func TestIntersectRanges(t *testing.T) {
+	a := []TimeRange{hours(0, 5), hours(10, 15)}
+	b := []TimeRange{hours(3, 12), hours(14, 20)}
+
+	assert.Equal(t, []TimeRange{hours(3, 5), hours(10, 12), hours(14, 15)}, IntersectRanges(a, b))
+}
+
+func TestSubtractRanges_MaintenanceWindows(t *testing.T) {
+	events := []TimeRange{hours(0, 24), hours(48, 72)}
+	maintenance := []TimeRange{hours(2, 4), hours(20, 50), hours(60, 61)}
+
+	playable := SubtractRanges(events, maintenance)
+
+	assert.Equal(t, []TimeRange{hours(0, 2), hours(4, 20), hours(50, 60), hours(61, 72)}, playable)
+
+	// Compensation owed is the downtime that fell inside events
+	owed := TotalDuration(IntersectRanges(events, maintenance))
+	assert.Equal(t, 2*time.Hour+4*time.Hour+2*time.Hour+time.Hour, owed)
+}
+
+func TestGaps(t *testing.T) {
+	events := []TimeRange{hours(2, 4), hours(3, 6), hours(8, 9)}
+
+	gaps := Gaps(events, hours(0, 10))
+
+	assert.Equal(t, []TimeRange{hours(0, 2), hours(6, 8), hours(9, 10)}, gaps)
+	assert.Empty(t, Gaps([]TimeRange{hours(0, 10)}, hours(2, 8)))
+}
+
+func TestTotalDuration(t *testing.T) {
+	assert.Equal(t, 5*time.Hour, TotalDuration([]TimeRange{hours(0, 3), hours(2, 5)}))
+	assert.Equal(t, time.Duration(0), TotalDuration(nil))
+}
@@ -0,0 +1,121 @@
+package timex
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/homveloper/dukdakit/internal/clock"
+)
+
+// ErrTimeTravelDisabled is returned when a time offset is requested in a build
+// that was not compiled with the timetravel build tag
+var ErrTimeTravelDisabled = errors.New("time travel is disabled in this build (compile with -tags timetravel)")
+
+// globalOffset holds the process-wide time offset in nanoseconds
+var globalOffset atomic.Int64
+
+// timeOffsetKey is the context key for per-context time offsets
+type timeOffsetKey struct{}
+
+// TimeTravelEnabled reports whether this binary was built with time travel support
+//
+// Time travel is meant for QA environments only. Regular builds compile it out,
+// so a misconfigured admin hook cannot shift time on production servers.
+func TimeTravelEnabled() bool {
+	return timeTravelEnabled
+}
+
+// SetTimeOffset shifts the process-wide current time used by timex by offset
+//
+// Example usage (QA build only):
+//
+//	// Pretend a week has passed to test the weekly reset
+//	if err := timex.SetTimeOffset(7 * 24 * time.Hour); err != nil {
+//	    return err // ErrTimeTravelDisabled in production builds
+//	}
+func SetTimeOffset(offset time.Duration) error {
+	if !timeTravelEnabled {
+		return ErrTimeTravelDisabled
+	}
+	globalOffset.Store(int64(offset))
+	return nil
+}
+
+// TravelTo sets the process-wide offset so that the current time becomes target
+// The offset is computed against the system clock at the time of the call
+func TravelTo(target time.Time) error {
+	return SetTimeOffset(target.Sub(time.Now()))
+}
+
+// ResetTimeOffset clears the process-wide offset
+func ResetTimeOffset() {
+	globalOffset.Store(0)
+}
+
+// TimeOffset returns the current process-wide offset
+// Always zero in builds without time travel support
+func TimeOffset() time.Duration {
+	if !timeTravelEnabled {
+		return 0
+	}
+	return time.Duration(globalOffset.Load())
+}
+
+// ContextWithTimeOffset returns a context carrying its own time offset
+// A context offset replaces the process-wide offset for calls that take the context,
+// which lets a single QA request or test player travel without affecting others
+func ContextWithTimeOffset(ctx context.Context, offset time.Duration) (context.Context, error) {
+	if !timeTravelEnabled {
+		return ctx, ErrTimeTravelDisabled
+	}
+	return context.WithValue(ctx, timeOffsetKey{}, offset), nil
+}
+
+// TimeOffsetFromContext returns the offset in effect for ctx
+// Falls back to the process-wide offset when ctx carries none
+func TimeOffsetFromContext(ctx context.Context) time.Duration {
+	if !timeTravelEnabled {
+		return 0
+	}
+	if ctx != nil {
+		if offset, ok := ctx.Value(timeOffsetKey{}).(time.Duration); ok {
+			return offset
+		}
+	}
+	return TimeOffset()
+}
+
+// Now returns the current time including the process-wide offset
+func Now() time.Time {
+	return time.Now().Add(TimeOffset())
+}
+
+// NowContext returns the current time including the offset in effect for ctx
+func NowContext(ctx context.Context) time.Time {
+	return time.Now().Add(TimeOffsetFromContext(ctx))
+}
+
+// ElapsedSinceContext is ElapsedSince honouring a per-context time offset
+func ElapsedSinceContext(ctx context.Context, baseTime time.Time, options ...ElapsedOption) bool {
+	config := buildElapsedConfig(options)
+	now := clock.OrReal(config.Clock).Now().Add(TimeOffsetFromContext(ctx))
+	return checkElapsed(baseTime, now, config)
+}
+
+// OffsetClock wraps base so that Now and Since include the process-wide offset
+// Timers are not shifted; an offset moves the wall clock, not the passage of time
+func OffsetClock(base clock.Clock) clock.Clock {
+	return offsetClock{base: clock.OrReal(base)}
+}
+
+// offsetClock applies the process-wide offset on top of another clock
+type offsetClock struct {
+	base clock.Clock
+}
+
+func (c offsetClock) Now() time.Time                         { return c.base.Now().Add(TimeOffset()) }
+func (c offsetClock) Since(t time.Time) time.Duration        { return c.Now().Sub(t) }
+func (c offsetClock) After(d time.Duration) <-chan time.Time { return c.base.After(d) }
+func (c offsetClock) NewTimer(d time.Duration) clock.Timer   { return c.base.NewTimer(d) }
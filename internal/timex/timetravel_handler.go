@@ -0,0 +1,113 @@
+package timex
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// TimeTravelStatus is the JSON body returned by the time travel admin hook
+type TimeTravelStatus struct {
+	Enabled       bool      `json:"enabled"`
+	Offset        string    `json:"offset"`
+	OffsetSeconds float64   `json:"offset_seconds"`
+	Now           time.Time `json:"now"`
+	Error         string    `json:"error,omitempty"`
+}
+
+// TimeTravelHandler returns an admin HTTP hook for changing the time offset at runtime
+//
+// Mount it behind your admin authentication:
+//   - GET    returns the current status
+//   - POST   with offset=<duration> (e.g. "72h") or at=<RFC3339 time> sets the offset
+//   - DELETE resets the offset to zero
+//
+// In builds without the timetravel tag every mutating request fails with
+// 403 Forbidden, so the hook is safe to leave mounted in production.
+//
+// Example usage:
+//
+//	adminMux.Handle("/admin/time", timex.TimeTravelHandler())
+//
+//	// curl -X POST 'http://qa-server/admin/time?offset=168h'
+func TimeTravelHandler() http.Handler {
+	return http.HandlerFunc(serveTimeTravel)
+}
+
+func serveTimeTravel(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		writeTimeTravelStatus(w, http.StatusOK, nil)
+
+	case http.MethodPost, http.MethodPut:
+		if err := applyTimeTravelRequest(r); err != nil {
+			status := http.StatusBadRequest
+			if errors.Is(err, ErrTimeTravelDisabled) {
+				status = http.StatusForbidden
+			}
+			writeTimeTravelStatus(w, status, err)
+			return
+		}
+		writeTimeTravelStatus(w, http.StatusOK, nil)
+
+	case http.MethodDelete:
+		if !timeTravelEnabled {
+			writeTimeTravelStatus(w, http.StatusForbidden, ErrTimeTravelDisabled)
+			return
+		}
+		ResetTimeOffset()
+		writeTimeTravelStatus(w, http.StatusOK, nil)
+
+	default:
+		w.Header().Set("Allow", "GET, POST, PUT, DELETE")
+		writeTimeTravelStatus(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+	}
+}
+
+// applyTimeTravelRequest parses offset or at from the request and applies it
+func applyTimeTravelRequest(r *http.Request) error {
+	if !timeTravelEnabled {
+		return ErrTimeTravelDisabled
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return fmt.Errorf("invalid form: %w", err)
+	}
+
+	if raw := r.Form.Get("offset"); raw != "" {
+		offset, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("invalid offset %q: %w", raw, err)
+		}
+		return SetTimeOffset(offset)
+	}
+
+	if raw := r.Form.Get("at"); raw != "" {
+		target, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return fmt.Errorf("invalid at %q: %w", raw, err)
+		}
+		return TravelTo(target)
+	}
+
+	return errors.New("either offset or at must be provided")
+}
+
+func writeTimeTravelStatus(w http.ResponseWriter, status int, err error) {
+	offset := TimeOffset()
+	body := TimeTravelStatus{
+		Enabled:       timeTravelEnabled,
+		Offset:        offset.String(),
+		OffsetSeconds: offset.Seconds(),
+		Now:           Now(),
+	}
+	if err != nil {
+		body.Error = err.Error()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,6 @@
+//go:build !timetravel
+
+package timex
+
+// timeTravelEnabled is false in regular builds so the offset can never be set
+const timeTravelEnabled = false
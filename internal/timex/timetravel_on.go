@@ -0,0 +1,6 @@
+//go:build timetravel
+
+package timex
+
+// timeTravelEnabled is true only in builds compiled with -tags timetravel
+const timeTravelEnabled = true
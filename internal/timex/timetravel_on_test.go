@@ -0,0 +1,69 @@
+//go:build timetravel
+
+package timex
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/homveloper/dukdakit/internal/clock"
+)
+
+func TestTimeTravel_OffsetAffectsElapsedSince(t *testing.T) {
+	t.Cleanup(ResetTimeOffset)
+
+	monday := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	fake := clock.NewFake(monday.Add(time.Hour))
+	opt := Option().Week().Clock(fake)
+
+	assert.False(t, ElapsedSince(monday, opt))
+
+	require.NoError(t, SetTimeOffset(7*24*time.Hour))
+	assert.Equal(t, 7*24*time.Hour, TimeOffset())
+	assert.True(t, ElapsedSince(monday, opt), "Expected weekly reset after travelling a week")
+
+	ResetTimeOffset()
+	assert.False(t, ElapsedSince(monday, opt))
+}
+
+func TestTimeTravel_ContextOffsetOverridesGlobal(t *testing.T) {
+	t.Cleanup(ResetTimeOffset)
+
+	require.NoError(t, SetTimeOffset(time.Hour))
+
+	ctx, err := ContextWithTimeOffset(context.Background(), 48*time.Hour)
+	require.NoError(t, err)
+
+	assert.Equal(t, 48*time.Hour, TimeOffsetFromContext(ctx))
+	assert.Equal(t, time.Hour, TimeOffsetFromContext(context.Background()))
+
+	day := time.Now()
+	assert.True(t, ElapsedSinceContext(ctx, day))
+	assert.WithinDuration(t, time.Now().Add(48*time.Hour), NowContext(ctx), time.Second)
+}
+
+func TestTimeTravelHandler_SetAndReset(t *testing.T) {
+	t.Cleanup(ResetTimeOffset)
+	handler := TimeTravelHandler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/admin/time?offset=72h", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 72*time.Hour, TimeOffset())
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/admin/time?offset=soon", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, 72*time.Hour, TimeOffset())
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/admin/time", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, time.Duration(0), TimeOffset())
+}
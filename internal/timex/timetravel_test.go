@@ -0,0 +1,43 @@
+//go:build !timetravel
+
+package timex
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeTravel_DisabledByDefault(t *testing.T) {
+	assert.False(t, TimeTravelEnabled())
+
+	err := SetTimeOffset(24 * time.Hour)
+	assert.ErrorIs(t, err, ErrTimeTravelDisabled)
+	assert.Equal(t, time.Duration(0), TimeOffset())
+
+	err = TravelTo(time.Now().Add(7 * 24 * time.Hour))
+	assert.ErrorIs(t, err, ErrTimeTravelDisabled)
+
+	ctx, err := ContextWithTimeOffset(context.Background(), time.Hour)
+	assert.ErrorIs(t, err, ErrTimeTravelDisabled)
+	assert.Equal(t, time.Duration(0), TimeOffsetFromContext(ctx))
+}
+
+func TestTimeTravelHandler_RejectsChangesWhenDisabled(t *testing.T) {
+	handler := TimeTravelHandler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/admin/time?offset=168h", nil))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, time.Duration(0), TimeOffset())
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/time", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"enabled":false`)
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/homveloper/dukdakit/internal/clock"
 )
 
 func TestElapsed_BasicDay(t *testing.T) {
@@ -30,6 +32,23 @@ func TestElapsedSince_BasicUsage(t *testing.T) {
 	assert.False(t, ElapsedSince(now), "Expected current time to not be elapsed")
 }
 
+func TestElapsedSince_WithFakeClock(t *testing.T) {
+	kst, err := time.LoadLocation("Asia/Seoul")
+	require.NoError(t, err)
+
+	lastClaim := time.Date(2024, 1, 15, 10, 0, 0, 0, kst)
+	fake := clock.NewFake(lastClaim)
+	opt := Option().Day().Timezone(kst).DailyResetOffset(9 * time.Hour).Clock(fake)
+
+	// Just before next day's 09:00 reset
+	fake.Set(time.Date(2024, 1, 16, 8, 59, 59, 0, kst))
+	assert.False(t, ElapsedSince(lastClaim, opt), "Expected no reset before 09:00")
+
+	// Crossing the reset boundary
+	fake.Advance(time.Second)
+	assert.True(t, ElapsedSince(lastClaim, opt), "Expected reset at 09:00")
+}
+
 func TestElapsed_CustomResetTime(t *testing.T) {
 	// Create specific times in KST
 	kst, err := time.LoadLocation("Asia/Seoul")
@@ -0,0 +1,231 @@
+package timex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrInvalidTimezone is returned when a timezone name cannot be loaded
+var ErrInvalidTimezone = errors.New("invalid timezone")
+
+// fixedZoneFallbacks are used when the system has no tzdata for zones without DST
+// Zones that observe DST have no fallback because a fixed offset would be wrong half the year
+var fixedZoneFallbacks = map[string]*time.Location{
+	"Asia/Seoul": time.FixedZone("KST", 9*60*60),
+	"Asia/Tokyo": time.FixedZone("JST", 9*60*60),
+}
+
+// LocationCache loads IANA timezones once and reuses them
+// time.LoadLocation reads and parses tzdata on every call, which adds up
+// when resolving a timezone per player per request
+type LocationCache struct {
+	mu        sync.RWMutex
+	locations map[string]*time.Location
+}
+
+// NewLocationCache creates an empty location cache
+func NewLocationCache() *LocationCache {
+	return &LocationCache{locations: make(map[string]*time.Location)}
+}
+
+// Load returns the location for an IANA name such as "Asia/Seoul"
+// Returns an error wrapping ErrInvalidTimezone for empty or unknown names;
+// failed loads are not cached so a later tzdata install is picked up
+func (c *LocationCache) Load(name string) (*time.Location, error) {
+	if name == "" {
+		return nil, fmt.Errorf("%w: empty name", ErrInvalidTimezone)
+	}
+
+	c.mu.RLock()
+	loc, ok := c.locations[name]
+	c.mu.RUnlock()
+	if ok {
+		return loc, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		fallback, ok := fixedZoneFallbacks[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q: %v", ErrInvalidTimezone, name, err)
+		}
+		loc = fallback
+	}
+
+	c.mu.Lock()
+	c.locations[name] = loc
+	c.mu.Unlock()
+
+	return loc, nil
+}
+
+// Len returns the number of cached locations
+func (c *LocationCache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.locations)
+}
+
+// defaultLocationCache backs LoadLocation and MustLoadLocation
+var defaultLocationCache = NewLocationCache()
+
+// LoadLocation loads an IANA timezone through the shared cache
+//
+// Example usage:
+//
+//	loc, err := timex.LoadLocation(player.Timezone)
+//	if err != nil {
+//	    return err // errors.Is(err, timex.ErrInvalidTimezone)
+//	}
+func LoadLocation(name string) (*time.Location, error) {
+	return defaultLocationCache.Load(name)
+}
+
+// MustLoadLocation is like LoadLocation but panics if the timezone cannot be loaded
+// Intended for well-known zones at initialisation time
+func MustLoadLocation(name string) *time.Location {
+	loc, err := LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+// ============================================================================
+// Per-player timezones
+// ============================================================================
+
+// TimezoneProvider resolves the timezone of a player
+// Implementations typically read the player's profile or region setting
+type TimezoneProvider interface {
+	// PlayerTimezone returns the location used for the player's resets
+	PlayerTimezone(ctx context.Context, playerID string) (*time.Location, error)
+}
+
+// TimezoneProviderFunc adapts a function to TimezoneProvider
+type TimezoneProviderFunc func(ctx context.Context, playerID string) (*time.Location, error)
+
+// PlayerTimezone calls f(ctx, playerID)
+func (f TimezoneProviderFunc) PlayerTimezone(ctx context.Context, playerID string) (*time.Location, error) {
+	return f(ctx, playerID)
+}
+
+// TimezoneNameProvider adapts a lookup of IANA names to TimezoneProvider
+// Names are loaded through the shared location cache and validated
+//
+// Example usage:
+//
+//	provider := timex.TimezoneNameProvider(func(ctx context.Context, playerID string) (string, error) {
+//	    profile, err := profiles.Get(ctx, playerID)
+//	    if err != nil {
+//	        return "", err
+//	    }
+//	    return profile.Timezone, nil // e.g. "Europe/Berlin"
+//	})
+func TimezoneNameProvider(lookup func(ctx context.Context, playerID string) (string, error)) TimezoneProvider {
+	return TimezoneProviderFunc(func(ctx context.Context, playerID string) (*time.Location, error) {
+		name, err := lookup(ctx, playerID)
+		if err != nil {
+			return nil, err
+		}
+		return LoadLocation(name)
+	})
+}
+
+// PlayerResetConfig holds configuration for PlayerResets
+type PlayerResetConfig struct {
+	// FallbackTimezone is used when the provider fails or returns no location
+	// When nil, provider errors are returned to the caller
+	FallbackTimezone *time.Location
+}
+
+// PlayerResetOption configures PlayerResets
+type PlayerResetOption func(*PlayerResetConfig)
+
+// WithFallbackTimezone uses loc for players whose timezone cannot be resolved
+func WithFallbackTimezone(loc *time.Location) PlayerResetOption {
+	return func(config *PlayerResetConfig) {
+		config.FallbackTimezone = loc
+	}
+}
+
+// PlayerResets applies a reset schedule in each player's own timezone
+//
+// Example usage:
+//
+//	// Daily reset at 04:00 local time for every player
+//	resets := timex.NewPlayerResets(provider,
+//	    timex.Option().Day().DailyResetOffset(4*time.Hour),
+//	    timex.WithFallbackTimezone(time.UTC))
+//
+//	if elapsed, err := resets.ElapsedSince(ctx, playerID, lastClaim); err == nil && elapsed {
+//	    // Player's local day has rolled over
+//	}
+type PlayerResets struct {
+	provider TimezoneProvider
+	schedule ElapsedOption
+	config   PlayerResetConfig
+}
+
+// NewPlayerResets creates a per-player reset checker
+// schedule defines the period and reset offset; its timezone is replaced per player
+func NewPlayerResets(provider TimezoneProvider, schedule ElapsedOption, options ...PlayerResetOption) *PlayerResets {
+	config := PlayerResetConfig{}
+	for _, option := range options {
+		option(&config)
+	}
+
+	return &PlayerResets{
+		provider: provider,
+		schedule: schedule,
+		config:   config,
+	}
+}
+
+// Timezone resolves the player's timezone, applying the fallback if configured
+func (p *PlayerResets) Timezone(ctx context.Context, playerID string) (*time.Location, error) {
+	loc, err := p.provider.PlayerTimezone(ctx, playerID)
+	if err == nil && loc != nil {
+		return loc, nil
+	}
+
+	if p.config.FallbackTimezone != nil {
+		return p.config.FallbackTimezone, nil
+	}
+	if err == nil {
+		err = fmt.Errorf("%w: no timezone for player %q", ErrInvalidTimezone, playerID)
+	}
+	return nil, err
+}
+
+// Option returns the reset schedule in the player's timezone
+// The result can be passed to Elapsed, ElapsedSince or WithElapsedBoundaries
+func (p *PlayerResets) Option(ctx context.Context, playerID string) (ElapsedOption, error) {
+	loc, err := p.Timezone(ctx, playerID)
+	if err != nil {
+		return ElapsedOption{}, err
+	}
+	return p.schedule.Timezone(loc), nil
+}
+
+// Elapsed checks whether the player's reset passed between baseTime and lastUpdate
+func (p *PlayerResets) Elapsed(ctx context.Context, playerID string, baseTime, lastUpdate time.Time) (bool, error) {
+	option, err := p.Option(ctx, playerID)
+	if err != nil {
+		return false, err
+	}
+	return Elapsed(baseTime, lastUpdate, option), nil
+}
+
+// ElapsedSince checks whether the player's reset passed since baseTime
+// Honours the schedule's clock and any time offset carried by ctx
+func (p *PlayerResets) ElapsedSince(ctx context.Context, playerID string, baseTime time.Time) (bool, error) {
+	option, err := p.Option(ctx, playerID)
+	if err != nil {
+		return false, err
+	}
+	return ElapsedSinceContext(ctx, baseTime, option), nil
+}
@@ -0,0 +1,110 @@
+package timex
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/homveloper/dukdakit/internal/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocationCache_Load(t *testing.T) {
+	cache := NewLocationCache()
+
+	first, err := cache.Load("Europe/Berlin")
+	require.NoError(t, err)
+	second, err := cache.Load("Europe/Berlin")
+	require.NoError(t, err)
+
+	assert.Same(t, first, second)
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestLocationCache_InvalidNames(t *testing.T) {
+	cache := NewLocationCache()
+
+	_, err := cache.Load("")
+	assert.ErrorIs(t, err, ErrInvalidTimezone)
+
+	_, err = cache.Load("Mars/Olympus_Mons")
+	assert.ErrorIs(t, err, ErrInvalidTimezone)
+	assert.Contains(t, err.Error(), "Mars/Olympus_Mons")
+
+	assert.Equal(t, 0, cache.Len(), "failed loads are not cached")
+}
+
+func TestMustLoadLocation(t *testing.T) {
+	assert.NotNil(t, MustLoadLocation("Asia/Seoul"))
+	assert.Panics(t, func() { MustLoadLocation("Not/AZone") })
+}
+
+func playerZones(zones map[string]string) TimezoneProvider {
+	return TimezoneNameProvider(func(ctx context.Context, playerID string) (string, error) {
+		name, ok := zones[playerID]
+		if !ok {
+			return "", errors.New("player not found")
+		}
+		return name, nil
+	})
+}
+
+func TestPlayerResets_LocalReset(t *testing.T) {
+	provider := playerZones(map[string]string{
+		"seoul":  "Asia/Seoul",
+		"berlin": "Europe/Berlin",
+	})
+	resets := NewPlayerResets(provider, Option().Day().DailyResetOffset(4*time.Hour))
+	ctx := context.Background()
+
+	// 2024-01-15 19:30 UTC is 04:30 KST on the 16th and 20:30 in Berlin on the 15th
+	base := time.Date(2024, 1, 15, 18, 30, 0, 0, time.UTC)
+	last := time.Date(2024, 1, 15, 19, 30, 0, 0, time.UTC)
+
+	elapsed, err := resets.Elapsed(ctx, "seoul", base, last)
+	require.NoError(t, err)
+	assert.True(t, elapsed, "04:00 KST reset passed")
+
+	elapsed, err = resets.Elapsed(ctx, "berlin", base, last)
+	require.NoError(t, err)
+	assert.False(t, elapsed, "Berlin reset is still hours away")
+}
+
+func TestPlayerResets_Errors(t *testing.T) {
+	provider := playerZones(map[string]string{"typo": "Asia/Seol"})
+	ctx := context.Background()
+	now := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	strict := NewPlayerResets(provider, Option().Day())
+
+	_, err := strict.Elapsed(ctx, "typo", now, now)
+	assert.ErrorIs(t, err, ErrInvalidTimezone)
+
+	_, err = strict.Elapsed(ctx, "missing", now, now)
+	assert.EqualError(t, err, "player not found")
+
+	nilProvider := NewPlayerResets(TimezoneProviderFunc(func(context.Context, string) (*time.Location, error) {
+		return nil, nil
+	}), Option().Day())
+	_, err = nilProvider.Option(ctx, "anyone")
+	assert.ErrorIs(t, err, ErrInvalidTimezone)
+
+	lenient := NewPlayerResets(provider, Option().Day(), WithFallbackTimezone(time.UTC))
+	loc, err := lenient.Timezone(ctx, "typo")
+	require.NoError(t, err)
+	assert.Equal(t, time.UTC, loc)
+}
+
+func TestPlayerResets_ElapsedSinceUsesScheduleClock(t *testing.T) {
+	provider := playerZones(map[string]string{"la": "America/Los_Angeles"})
+	la := MustLoadLocation("America/Los_Angeles")
+
+	fake := clock.NewFake(time.Date(2024, 7, 2, 4, 0, 0, 0, la))
+	resets := NewPlayerResets(provider, Option().Day().DailyResetOffset(4*time.Hour).Clock(fake))
+
+	elapsed, err := resets.ElapsedSince(context.Background(), "la", time.Date(2024, 7, 2, 3, 59, 0, 0, la))
+	require.NoError(t, err)
+	assert.True(t, elapsed)
+}
@@ -1,7 +1,10 @@
 package dukdakit
 
 import (
+	"cmp"
 	"context"
+	"net/http"
+	"time"
 
 	"github.com/homveloper/dukdakit/internal/pagit"
 )
@@ -70,8 +73,9 @@ func PaginateCursor[T any, C comparable](
 // - Static datasets like game items, achievements
 // - Use cases requiring page numbers and total counts
 //
-// Returns either OffsetResult (with total count) or OffsetResultWithoutTotal
-// depending on whether the provider supports counting.
+// Returns a typed OffsetPage; its TotalCount is nil when the provider cannot count.
+// OffsetConfig.Count picks a CountStrategy (exact, cached, estimated, first page
+// only or none) and the page reports the one used in CountSource.
 //
 // Example usage:
 //
@@ -84,30 +88,228 @@ func PaginateCursor[T any, C comparable](
 //	    PageSize: 10,
 //	}
 //	
-//	result, err := dukdakit.PaginateOffset(ctx, provider, config)
+//	page, err := dukdakit.PaginateOffset(ctx, provider, config)
+//	for _, product := range page.Data { ... }
 //
 //	// Database provider example
 //	dbProvider := &ItemDBProvider{db: gameDB}
-//	result, err = dukdakit.PaginateOffset(ctx, dbProvider, config)
+//	page, err = dukdakit.PaginateOffset(ctx, dbProvider, config)
 func PaginateOffset[T any](
 	ctx context.Context,
 	provider pagit.DataProvider[T],
 	config pagit.OffsetConfig,
-) (any, error) {
+) (pagit.OffsetPage[T], error) {
 	return pagit.PaginateOffset(ctx, provider, config)
 }
 
 // NewSliceProvider creates a provider for in-memory slice data (offset-based)
-func NewSliceProvider[T any](data []T) *pagit.SliceProvider[T] {
-	return pagit.NewSliceProvider(data)
+// Options filter and sort the data; the provider is safe for concurrent updates
+func NewSliceProvider[T any](data []T, options ...pagit.SliceOption[T]) *pagit.SliceProvider[T] {
+	return pagit.NewSliceProvider(data, options...)
 }
 
 // NewSliceCursorProvider creates a provider for in-memory slice data (cursor-based)
 func NewSliceCursorProvider[T any, C comparable](
 	data []T,
 	extractor pagit.CursorExtractor[T, C],
+	options ...pagit.SliceOption[T],
 ) *pagit.SliceCursorProvider[T, C] {
-	return pagit.NewSliceCursorProvider(data, extractor)
+	return pagit.NewSliceCursorProvider(data, extractor, options...)
+}
+
+// NewSliceKeysetProvider creates a cursor provider for in-memory data ordered by a
+// composite keyset, e.g. leaderboards sorted by (score DESC, updated_at ASC, id ASC)
+// Returns ErrEmptyKeyset when keyset has no fields
+//
+// Example usage:
+//
+//	keyset, err := dukdakit.NewKeyset(
+//	    dukdakit.OrderedKey("score", dukdakit.SortDesc, func(c RankCursor) int64 { return c.Score }),
+//	    dukdakit.TimeKey("updated_at", dukdakit.SortAsc, func(c RankCursor) time.Time { return c.UpdatedAt }),
+//	    dukdakit.OrderedKey("id", dukdakit.SortAsc, func(c RankCursor) string { return c.ID }),
+//	)
+//	provider, err := dukdakit.NewSliceKeysetProvider(entries, rankCursorOf, keyset)
+func NewSliceKeysetProvider[T any, C comparable](
+	data []T,
+	extractor pagit.CursorExtractor[T, C],
+	keyset pagit.Keyset[C],
+	options ...pagit.SliceOption[T],
+) (*pagit.SliceCursorProvider[T, C], error) {
+	return pagit.NewSliceKeysetProvider(data, extractor, keyset, options...)
+}
+
+// NewKeyset creates a composite cursor keyset from fields in order of significance
+// The last field should be unique (e.g. the primary key); returns ErrEmptyKeyset without fields
+func NewKeyset[C any](fields ...pagit.KeyField[C]) (pagit.Keyset[C], error) {
+	return pagit.NewKeyset(fields...)
+}
+
+// OrderedKey creates a keyset field for an ordered cursor field (numbers, strings)
+func OrderedKey[C any, V cmp.Ordered](column string, direction SortDirection, get func(C) V) pagit.KeyField[C] {
+	return pagit.OrderedKey(column, direction, get)
+}
+
+// TimeKey creates a keyset field for a time.Time cursor field
+func TimeKey[C any](column string, direction SortDirection, get func(C) time.Time) pagit.KeyField[C] {
+	return pagit.TimeKey(column, direction, get)
+}
+
+// WithSliceFilter keeps only slice items matching predicate
+func WithSliceFilter[T any](predicate func(T) bool) pagit.SliceOption[T] {
+	return pagit.WithSliceFilter(predicate)
+}
+
+// WithSliceSort orders slice items by several keys; later keys break ties
+//
+// Example usage:
+//
+//	provider := dukdakit.NewSliceProvider(members,
+//	    dukdakit.WithSliceFilter(func(m Member) bool { return m.Online }),
+//	    dukdakit.WithSliceSort(
+//	        dukdakit.SortBy(func(m Member) int { return m.Level }, dukdakit.SortDesc),
+//	        dukdakit.SortBy(func(m Member) string { return m.Name }, dukdakit.SortAsc),
+//	    ),
+//	)
+func WithSliceSort[T any](keys ...func(a, b T) int) pagit.SliceOption[T] {
+	return pagit.WithSliceSort(keys...)
+}
+
+// SortBy returns a sort key comparing an ordered field in the given direction
+func SortBy[T any, V cmp.Ordered](get func(T) V, direction SortDirection) func(a, b T) int {
+	return pagit.SortBy(get, direction)
+}
+
+// NewOffsetIterator walks every page of an offset provider lazily
+//
+// Example usage:
+//
+//	it := dukdakit.NewOffsetIterator[Player](provider,
+//	    dukdakit.Pagit.WithIteratorPageSize(500),
+//	    dukdakit.Pagit.WithPrefetch(),
+//	    dukdakit.Pagit.WithProgress(func(p dukdakit.Progress) { log.Printf("%d items", p.Items) }),
+//	)
+//	err := it.ForEach(ctx, func(p Player) error { return grantSeasonReward(ctx, p) })
+//
+//	// Go 1.23+
+//	for player, err := range it.All(ctx) { ... }
+func NewOffsetIterator[T any](provider pagit.DataProvider[T], options ...pagit.IteratorOption) *pagit.PageIterator[T] {
+	return pagit.NewOffsetIterator(provider, options...)
+}
+
+// NewCursorIterator walks every page of a cursor provider lazily, moving forward
+func NewCursorIterator[T any, C comparable](
+	provider pagit.CursorDataProvider[T, C],
+	extractor pagit.CursorExtractor[T, C],
+	options ...pagit.IteratorOption,
+) *pagit.PageIterator[T] {
+	return pagit.NewCursorIterator(provider, extractor, options...)
+}
+
+// WithIteratorPageSize sets how many items an iterator fetches per page
+func (p *PagitCategory) WithIteratorPageSize(pageSize int) pagit.IteratorOption {
+	return pagit.WithIteratorPageSize(pageSize)
+}
+
+// WithPrefetch fetches the next page in the background while the current one is consumed
+func (p *PagitCategory) WithPrefetch() pagit.IteratorOption {
+	return pagit.WithPrefetch()
+}
+
+// WithIteratorTotal sets the expected item count reported in Progress
+func (p *PagitCategory) WithIteratorTotal(total int64) pagit.IteratorOption {
+	return pagit.WithIteratorTotal(total)
+}
+
+// WithProgress calls fn after every fetched page
+func (p *PagitCategory) WithProgress(fn func(Progress)) pagit.IteratorOption {
+	return pagit.WithProgress(fn)
+}
+
+// NewCursorCodec creates a codec that turns cursors into opaque, optionally signed tokens
+//
+// Example usage:
+//
+//	codec := dukdakit.NewCursorCodec[int64](dukdakit.Pagit.WithTokenSecret(secret))
+//	config := pagit.CursorConfig[int64]{PageSize: 20, Token: req.Cursor, Codec: codec}
+//	result, err := dukdakit.PaginateCursor(ctx, provider, config, extractor)
+//	// send result.NextToken / result.PrevToken to the client
+func NewCursorCodec[C comparable](options ...pagit.TokenOption) *pagit.CursorCodec[C] {
+	return pagit.NewCursorCodec[C](options...)
+}
+
+// WithTokenSecret signs cursor tokens with HMAC-SHA256
+// previous secrets are still accepted when verifying, to allow key rotation
+func (p *PagitCategory) WithTokenSecret(secret []byte, previous ...[]byte) pagit.TokenOption {
+	return pagit.WithTokenSecret(secret, previous...)
+}
+
+// FilterHash returns a short stable hash of a filter, used to bind cursor tokens to it
+func (p *PagitCategory) FilterHash(filter any) (string, error) {
+	return pagit.FilterHash(filter)
+}
+
+// ParseOffsetConfig reads page and page_size from an HTTP request query
+// Invalid values wrap ErrInvalidPage or ErrInvalidPageSize; answer them with 400
+func (p *PagitCategory) ParseOffsetConfig(r *http.Request, options ...pagit.HTTPOption) (OffsetConfig, error) {
+	return pagit.ParseOffsetConfig(r, options...)
+}
+
+// WithMaxPageSize lowers the largest page size HTTP clients may request
+func (p *PagitCategory) WithMaxPageSize(size int) pagit.HTTPOption {
+	return pagit.WithMaxPageSize(size)
+}
+
+// WithDefaultPageSize sets the page size used when an HTTP request has none
+func (p *PagitCategory) WithDefaultPageSize(size int) pagit.HTTPOption {
+	return pagit.WithDefaultPageSize(size)
+}
+
+// ParseCursorConfig reads an opaque cursor token and page_size from an HTTP request query
+//
+// Example usage:
+//
+//	config, err := dukdakit.ParseCursorConfig(r, codec, dukdakit.Pagit.WithMaxPageSize(100))
+//	if err != nil {
+//	    http.Error(w, err.Error(), http.StatusBadRequest)
+//	    return
+//	}
+//	result, err := dukdakit.PaginateCursor(r.Context(), provider, config, extractor)
+//	dukdakit.WriteCursorPage(w, r, result)
+func ParseCursorConfig[C comparable](r *http.Request, codec *pagit.CursorCodec[C], options ...pagit.HTTPOption) (pagit.CursorConfig[C], error) {
+	return pagit.ParseCursorConfig(r, codec, options...)
+}
+
+// WriteOffsetPage writes an offset page as a JSON envelope with RFC 8288 Link headers
+func WriteOffsetPage[T any](w http.ResponseWriter, r *http.Request, page pagit.OffsetPage[T], options ...pagit.HTTPOption) error {
+	return pagit.WriteOffsetPage(w, r, page, options...)
+}
+
+// WriteCursorPage writes a cursor page as a JSON envelope with RFC 8288 Link headers
+func WriteCursorPage[T any, C comparable](w http.ResponseWriter, r *http.Request, result pagit.CursorResult[T, C], options ...pagit.HTTPOption) error {
+	return pagit.WriteCursorPage(w, r, result, options...)
+}
+
+// NewCountCache creates a TTL cache for total counts, used with CountCached
+//
+// Example usage:
+//
+//	counts := dukdakit.Pagit.NewCountCache(dukdakit.Pagit.WithCountTTL(30 * time.Second))
+//
+//	// CountKey keeps totals per filter; without it the cache is skipped
+//	key, err := dukdakit.Pagit.FilterHash(filter)
+//	config := dukdakit.OffsetConfig{Page: 2, PageSize: 50, Count: dukdakit.CountCached, CountCache: counts, CountKey: key}
+func (p *PagitCategory) NewCountCache(options ...pagit.CountCacheOption) *pagit.CountCache {
+	return pagit.NewCountCache(options...)
+}
+
+// WithCountTTL sets how long a cached count is reused
+func (p *PagitCategory) WithCountTTL(ttl time.Duration) pagit.CountCacheOption {
+	return pagit.WithCountTTL(ttl)
+}
+
+// WithCountCacheSize caps the number of cached keys, evicting the least recently used
+func (p *PagitCategory) WithCountCacheSize(maxEntries int) pagit.CountCacheOption {
+	return pagit.WithCountCacheSize(maxEntries)
 }
 
 // Type aliases for easier usage (non-generic types only for Go 1.21 compatibility)
@@ -117,9 +319,36 @@ type (
 	
 	// CountProvider provides total count for offset-based pagination
 	CountProvider = pagit.CountProvider
+
+	// EstimatedCountProvider provides a cheap approximate total count
+	EstimatedCountProvider = pagit.EstimatedCountProvider
 	
 	// CursorDirection specifies the direction of cursor pagination
 	CursorDirection = pagit.CursorDirection
+
+	// TokenOption configures cursor token encoding
+	TokenOption = pagit.TokenOption
+
+	// SortDirection specifies the sort direction of a keyset field
+	SortDirection = pagit.SortDirection
+
+	// IteratorOption configures page iterators
+	IteratorOption = pagit.IteratorOption
+
+	// Progress reports how far a page iterator has walked
+	Progress = pagit.Progress
+
+	// CountStrategy selects how offset pagination obtains the total count
+	CountStrategy = pagit.CountStrategy
+
+	// CountCache keeps total counts for a TTL
+	CountCache = pagit.CountCache
+
+	// HTTPOption configures the HTTP request parsing and response helpers
+	HTTPOption = pagit.HTTPOption
+
+	// PaginationMeta describes a page inside an HTTP JSON envelope
+	PaginationMeta = pagit.PaginationMeta
 )
 
 // Constants for cursor direction
@@ -128,9 +357,27 @@ const (
 	CursorBackward = pagit.CursorBackward
 )
 
+// Constants for keyset sort direction
+const (
+	SortAsc  = pagit.SortAsc
+	SortDesc = pagit.SortDesc
+)
+
+// Constants for count strategies
+const (
+	CountExact     = pagit.CountExact
+	CountCached    = pagit.CountCached
+	CountEstimated = pagit.CountEstimated
+	CountFirstPage = pagit.CountFirstPage
+	CountNone      = pagit.CountNone
+)
+
 // Default constants
 const (
 	DefaultCursorPageSize = pagit.DefaultCursorPageSize
 	DefaultOffsetPageSize = pagit.DefaultOffsetPageSize
 	MaxPageSize          = pagit.MaxPageSize
-)
\ No newline at end of file
+)
+
+// ErrEmptyKeyset is returned when a keyset provider is created without keyset fields
+var ErrEmptyKeyset = pagit.ErrEmptyKeyset
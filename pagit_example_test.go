@@ -0,0 +1,68 @@
+package dukdakit_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/homveloper/dukdakit"
+)
+
+type rankEntry struct {
+	ID        string
+	Score     int64
+	UpdatedAt time.Time
+}
+
+type rankCursor struct {
+	Score     int64
+	UpdatedAt time.Time
+	ID        string
+}
+
+// Builds a composite keyset with the root package only, as code outside the module must
+func ExampleNewSliceKeysetProvider() {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	entries := []rankEntry{
+		{ID: "c", Score: 90, UpdatedAt: base.Add(time.Minute)},
+		{ID: "a", Score: 120, UpdatedAt: base},
+		{ID: "b", Score: 90, UpdatedAt: base},
+		{ID: "d", Score: 90, UpdatedAt: base},
+	}
+
+	// score DESC, updated_at ASC, id ASC
+	keyset, err := dukdakit.NewKeyset(
+		dukdakit.OrderedKey("score", dukdakit.SortDesc, func(c rankCursor) int64 { return c.Score }),
+		dukdakit.TimeKey("updated_at", dukdakit.SortAsc, func(c rankCursor) time.Time { return c.UpdatedAt }),
+		dukdakit.OrderedKey("id", dukdakit.SortAsc, func(c rankCursor) string { return c.ID }),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	extractor := func(e rankEntry) rankCursor { return rankCursor{e.Score, e.UpdatedAt, e.ID} }
+	provider, err := dukdakit.NewSliceKeysetProvider(entries, extractor, keyset)
+	if err != nil {
+		panic(err)
+	}
+
+	it := dukdakit.NewCursorIterator(provider, extractor, dukdakit.Pagit.WithIteratorPageSize(2))
+	err = it.ForEach(context.Background(), func(e rankEntry) error {
+		fmt.Println(e.ID, e.Score)
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	_, err = dukdakit.NewKeyset[rankCursor]()
+	fmt.Println(errors.Is(err, dukdakit.ErrEmptyKeyset))
+
+	// Output:
+	// a 120
+	// b 90
+	// d 90
+	// c 90
+	// true
+}
@@ -1,8 +1,10 @@
 package dukdakit
 
 import (
+	"net/http"
 	"time"
 
+	"github.com/homveloper/dukdakit/internal/clock"
 	"github.com/homveloper/dukdakit/internal/timex"
 )
 
@@ -113,24 +115,117 @@ func (t *TimexCategory) Option() *OptionBuilder {
 // ElapsedOption type for backward compatibility and direct usage
 type ElapsedOption = timex.ElapsedOption
 
+// Clock abstracts the current time; accepted by timex, retry and distributed options
+type Clock = clock.Clock
+
+// FakeClock is a manually advanced Clock for tests
+type FakeClock = clock.FakeClock
+
+// RealClock returns a Clock backed by the system time
+func (t *TimexCategory) RealClock() Clock {
+	return clock.Real()
+}
+
+// NewFakeClock returns a FakeClock starting at the given time
+//
+// Example usage:
+//
+//	fake := dukdakit.Timex.NewFakeClock(time.Date(2024, 1, 15, 8, 0, 0, 0, kst))
+//	opt := dukdakit.Timex.Option().KST9AM().Clock(fake)
+//
+//	fake.Advance(time.Hour) // 09:00 KST
+//	if dukdakit.Timex.ElapsedSince(lastClaim, opt) {
+//	    // Daily reset observed without waiting
+//	}
+func (t *TimexCategory) NewFakeClock(now time.Time) *FakeClock {
+	return clock.NewFake(now)
+}
+
+// Now returns the current time including the QA time travel offset
+// Identical to time.Now() in builds without the timetravel tag
+func (t *TimexCategory) Now() time.Time {
+	return timex.Now()
+}
+
+// SetTimeOffset shifts the time seen by all timex checks (QA builds only)
+// Returns timex.ErrTimeTravelDisabled unless compiled with -tags timetravel
+//
+// Example usage:
+//
+//	// Jump a week ahead to verify the weekly reset
+//	if err := dukdakit.Timex.SetTimeOffset(7 * 24 * time.Hour); err != nil {
+//	    log.Printf("time travel unavailable: %v", err)
+//	}
+func (t *TimexCategory) SetTimeOffset(offset time.Duration) error {
+	return timex.SetTimeOffset(offset)
+}
+
+// ResetTimeOffset clears the QA time travel offset
+func (t *TimexCategory) ResetTimeOffset() {
+	timex.ResetTimeOffset()
+}
+
+// TimeTravelHandler returns the admin HTTP hook for adjusting the time offset at runtime
+// Mutating requests are rejected with 403 in builds without the timetravel tag
+func (t *TimexCategory) TimeTravelHandler() http.Handler {
+	return timex.TimeTravelHandler()
+}
+
 // Timezone helpers
+// Locations are loaded once through a shared cache. When the system has no
+// tzdata they fall back to a fixed offset; KST and JST never observe DST, but
+// the PST and EST fallbacks ignore daylight saving time, so LoadPST and LoadEST
+// report the error instead
+
+// KST returns the Asia/Seoul location
 func (t *TimexCategory) KST() *time.Location {
-	loc, _ := time.LoadLocation("Asia/Seoul")
-	return loc
+	return fixedLocation("Asia/Seoul", "KST", 9*time.Hour)
 }
 
+// JST returns the Asia/Tokyo location
 func (t *TimexCategory) JST() *time.Location {
-	loc, _ := time.LoadLocation("Asia/Tokyo")
-	return loc
+	return fixedLocation("Asia/Tokyo", "JST", 9*time.Hour)
 }
 
+// PST returns the America/Los_Angeles location, or UTC-8 without tzdata
+//
+// Deprecated: the fallback ignores daylight saving time; use LoadPST
 func (t *TimexCategory) PST() *time.Location {
-	loc, _ := time.LoadLocation("America/Los_Angeles")
-	return loc
+	return fixedLocation("America/Los_Angeles", "PST", -8*time.Hour)
 }
 
+// EST returns the America/New_York location, or UTC-5 without tzdata
+//
+// Deprecated: the fallback ignores daylight saving time; use LoadEST
 func (t *TimexCategory) EST() *time.Location {
-	loc, _ := time.LoadLocation("America/New_York")
+	return fixedLocation("America/New_York", "EST", -5*time.Hour)
+}
+
+// LoadPST loads the America/Los_Angeles location
+// Returns an error wrapping ErrInvalidTimezone when the system has no tzdata
+//
+// Example usage:
+//
+//	pst, err := dukdakit.Timex.LoadPST()
+//	if err != nil {
+//	    return err // import _ "time/tzdata" to embed the database
+//	}
+func (t *TimexCategory) LoadPST() (*time.Location, error) {
+	return timex.LoadLocation("America/Los_Angeles")
+}
+
+// LoadEST loads the America/New_York location
+// Returns an error wrapping ErrInvalidTimezone when the system has no tzdata
+func (t *TimexCategory) LoadEST() (*time.Location, error) {
+	return timex.LoadLocation("America/New_York")
+}
+
+// fixedLocation loads a zone, using a fixed offset if loading fails
+func fixedLocation(name, abbreviation string, offset time.Duration) *time.Location {
+	loc, err := timex.LoadLocation(name)
+	if err != nil {
+		return time.FixedZone(abbreviation, int(offset/time.Second))
+	}
 	return loc
 }
 
@@ -155,17 +250,215 @@ func (t *TimexCategory) UTC() *time.Location {
 //	// Result: [15:00:00-16:00:00, 16:00:00-17:00:00]
 //
 //	// Exact splitting from start time
-//	ranges = dukdakit.Timex.Range(start, end, time.Hour, timex.WithExactSplit())
+//	ranges = dukdakit.Timex.Range(start, end, time.Hour, dukdakit.Timex.WithExactSplit())
 //	// Result: [14:35:20-15:35:20, 15:35:20-16:35:20, 16:35:20-17:25:40]
 //
 //	// With trimming options to remove partial ranges
-//	ranges = dukdakit.Timex.Range(start, end, time.Hour, timex.WithTrim())
+//	ranges = dukdakit.Timex.Range(start, end, time.Hour, dukdakit.Timex.WithTrim())
 //
 //	// For game mechanics like splitting event durations, cooldown periods, etc.
 //	eventStart := time.Now()
 //	eventEnd := eventStart.Add(3 * time.Hour)
 //	hourlyRewards := dukdakit.Timex.Range(eventStart, eventEnd, time.Hour)
 //	// Creates hourly reward intervals for the event
-func (t *TimexCategory) Range(start, end time.Time, duration time.Duration, options ...timex.RangeOption) []timex.TimeRange {
+func (t *TimexCategory) Range(start, end time.Time, duration time.Duration, options ...RangeOption) []timex.TimeRange {
 	return timex.Range(start, end, duration, options...)
 }
+
+// RangeOption configures Range, CalendarRange and CalendarPeriodStart
+type RangeOption = timex.RangeOption
+
+// WithExactSplit splits from the exact start time instead of natural boundaries
+func (t *TimexCategory) WithExactSplit() RangeOption {
+	return timex.WithExactSplit()
+}
+
+// WithTrim drops partial ranges at either end
+func (t *TimexCategory) WithTrim() RangeOption {
+	return timex.WithTrim()
+}
+
+// WithRangeTimezone sets the timezone used for boundary alignment
+func (t *TimexCategory) WithRangeTimezone(tz *time.Location) RangeOption {
+	return timex.WithRangeTimezone(tz)
+}
+
+// WithRangeResetOffset sets the daily reset offset used for day boundaries
+func (t *TimexCategory) WithRangeResetOffset(offset time.Duration) RangeOption {
+	return timex.WithRangeResetOffset(offset)
+}
+
+// WithElapsedBoundaries aligns calendar boundaries with an Elapsed reset schedule
+func (t *TimexCategory) WithElapsedBoundaries(opt ElapsedOption) RangeOption {
+	return timex.WithElapsedBoundaries(opt)
+}
+
+// CalendarUnit selects the calendar period used by CalendarRange
+type CalendarUnit = timex.CalendarUnit
+
+// Calendar units for CalendarRange
+const (
+	CalendarDay   = timex.CalendarDay
+	CalendarWeek  = timex.CalendarWeek
+	CalendarMonth = timex.CalendarMonth
+)
+
+// CalendarRange splits a time interval along day, ISO week or month boundaries
+//
+// Boundaries are computed in the configured timezone on the wall clock, so they
+// stay correct across DST transitions and match what Elapsed reports for the
+// same timezone and daily reset offset.
+//
+// Example usage:
+//
+//	// Daily login reward windows starting at 09:00 KST
+//	days, err := dukdakit.Timex.CalendarRange(seasonStart, seasonEnd, dukdakit.CalendarDay,
+//	    dukdakit.Timex.WithElapsedBoundaries(dukdakit.Timex.Option().KST9AM()))
+//
+//	// Whole calendar months of a season in JST
+//	months, err := dukdakit.Timex.CalendarRange(seasonStart, seasonEnd, dukdakit.CalendarMonth,
+//	    dukdakit.Timex.WithRangeTimezone(dukdakit.Timex.JST()), dukdakit.Timex.WithTrim())
+func (t *TimexCategory) CalendarRange(start, end time.Time, unit CalendarUnit, options ...RangeOption) ([]timex.TimeRange, error) {
+	return timex.CalendarRange(start, end, unit, options...)
+}
+
+// CalendarPeriodStart returns the start of the day, ISO week or month containing tm
+func (t *TimexCategory) CalendarPeriodStart(tm time.Time, unit CalendarUnit, options ...RangeOption) time.Time {
+	return timex.CalendarPeriodStart(tm, unit, options...)
+}
+
+// TimeRange is a half-open time interval [Start, End) with set operations
+// such as Intersect, Union, Subtract, Contains and Clamp
+type TimeRange = timex.TimeRange
+
+// MergeRanges sorts ranges and merges overlapping or adjacent ones
+func (t *TimexCategory) MergeRanges(ranges []TimeRange) []TimeRange {
+	return timex.MergeRanges(ranges)
+}
+
+// IntersectRanges returns the time covered by both lists of ranges
+//
+// Example usage:
+//
+//	// Downtime that hit running events, owed back to players
+//	owed := dukdakit.Timex.TotalDuration(dukdakit.Timex.IntersectRanges(events, downtime))
+func (t *TimexCategory) IntersectRanges(a, b []TimeRange) []TimeRange {
+	return timex.IntersectRanges(a, b)
+}
+
+// SubtractRanges removes every range in remove from the ranges in from
+//
+// Example usage:
+//
+//	// Event windows with maintenance removed
+//	playable := dukdakit.Timex.SubtractRanges(events, maintenance)
+func (t *TimexCategory) SubtractRanges(from, remove []TimeRange) []TimeRange {
+	return timex.SubtractRanges(from, remove)
+}
+
+// Gaps returns the parts of within not covered by any of the ranges
+func (t *TimexCategory) Gaps(ranges []TimeRange, within TimeRange) []TimeRange {
+	return timex.Gaps(ranges, within)
+}
+
+// TotalDuration returns the time covered by ranges, counting overlaps once
+func (t *TimexCategory) TotalDuration(ranges []TimeRange) time.Duration {
+	return timex.TotalDuration(ranges)
+}
+
+// LoadLocation loads an IANA timezone such as "Europe/Berlin" through a shared cache
+// Returns an error wrapping ErrInvalidTimezone for empty or unknown names
+//
+// Example usage:
+//
+//	loc, err := dukdakit.Timex.LoadLocation(player.Timezone)
+//	if err != nil {
+//	    return err
+//	}
+func (t *TimexCategory) LoadLocation(name string) (*time.Location, error) {
+	return timex.LoadLocation(name)
+}
+
+// ErrInvalidTimezone is returned when a timezone name cannot be loaded
+var ErrInvalidTimezone = timex.ErrInvalidTimezone
+
+// TimezoneProvider resolves the timezone of a player
+type TimezoneProvider = timex.TimezoneProvider
+
+// PlayerResetOption configures NewPlayerResets
+type PlayerResetOption = timex.PlayerResetOption
+
+// WithFallbackTimezone sets the timezone used when a player's timezone cannot be resolved
+func (t *TimexCategory) WithFallbackTimezone(loc *time.Location) PlayerResetOption {
+	return timex.WithFallbackTimezone(loc)
+}
+
+// PlayerResets applies a reset schedule in each player's own timezone
+type PlayerResets = timex.PlayerResets
+
+// NewPlayerResets creates a per-player reset checker
+//
+// Example usage:
+//
+//	// Every player resets at 04:00 in their own timezone
+//	resets := dukdakit.Timex.NewPlayerResets(provider,
+//	    dukdakit.Timex.Option().Day().DailyResetOffset(4*time.Hour),
+//	    dukdakit.Timex.WithFallbackTimezone(time.UTC))
+//
+//	elapsed, err := resets.ElapsedSince(ctx, playerID, lastDailyClaim)
+func (t *TimexCategory) NewPlayerResets(provider TimezoneProvider, schedule ElapsedOption, options ...PlayerResetOption) *PlayerResets {
+	return timex.NewPlayerResets(provider, schedule, options...)
+}
+
+// Attendance tracks daily check-ins, streaks and monthly attendance
+type Attendance = timex.Attendance
+
+// AttendanceOption configures NewAttendance
+type AttendanceOption = timex.AttendanceOption
+
+// WithGraceDays allows up to n missed days between check-ins without breaking a streak
+func (t *TimexCategory) WithGraceDays(n int) AttendanceOption {
+	return timex.WithGraceDays(n)
+}
+
+// MonthBitmap is the per-month persistence format of Attendance
+type MonthBitmap = timex.MonthBitmap
+
+// NewAttendance creates an attendance tracker counting days like Elapsed does
+//
+// Example usage:
+//
+//	attendance := dukdakit.Timex.NewAttendance(dukdakit.Timex.Option().KST9AM(), dukdakit.Timex.WithGraceDays(1))
+//	if result := attendance.CheckIn(now); result.New {
+//	    grantAttendanceReward(result.CurrentStreak)
+//	}
+func (t *TimexCategory) NewAttendance(schedule ElapsedOption, options ...AttendanceOption) *Attendance {
+	return timex.NewAttendance(schedule, options...)
+}
+
+// GameClock maps real time to accelerated game time
+type GameClock = timex.GameClock
+
+// GameClockOption configures NewGameClock
+type GameClockOption = timex.GameClockOption
+
+// WithGameDayLength sets how much real time one game day takes
+func (t *TimexCategory) WithGameDayLength(realDuration time.Duration) GameClockOption {
+	return timex.WithGameDayLength(realDuration)
+}
+
+// WithGameEpoch anchors game time: at realTime the game clock reads gameTime
+func (t *TimexCategory) WithGameEpoch(realTime, gameTime time.Time) GameClockOption {
+	return timex.WithGameEpoch(realTime, gameTime)
+}
+
+// NewGameClock creates a game clock with a ratio, epoch and optional real clock
+//
+// Example usage:
+//
+//	// One game day every 2 real hours
+//	world, err := dukdakit.Timex.NewGameClock(dukdakit.Timex.WithGameDayLength(2*time.Hour),
+//	    dukdakit.Timex.WithGameEpoch(launchTime, launchTime))
+func (t *TimexCategory) NewGameClock(options ...GameClockOption) (*GameClock, error) {
+	return timex.NewGameClock(options...)
+}